@@ -98,12 +98,14 @@ val services = mapOf(
     "emr" to ServiceSpec("EMR", vpcLock = true),
     "emrcontainers" to ServiceSpec("EMR Containers"),
     "emrserverless" to ServiceSpec("EMR Serverless"),
+    "entityresolution" to ServiceSpec("Entity Resolution"),
     "events" to ServiceSpec("EventBridge"),
     "evidently" to ServiceSpec("CloudWatch Evidently"),
     "finspace" to ServiceSpec("FinSpace"),
     "firehose" to ServiceSpec("Kinesis Firehose"),
     "fis" to ServiceSpec("FIS (Fault Injection Simulator)"),
     "fms" to ServiceSpec("FMS (Firewall Manager)", regionOverride = "us-east-1"),
+    "frauddetector" to ServiceSpec("Fraud Detector"),
     "fsx" to ServiceSpec("FSx", vpcLock = true),
     "gamelift" to ServiceSpec("GameLift"),
     "glacier" to ServiceSpec("S3 Glacier"),
@@ -91,6 +91,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.31.3
 	github.com/aws/aws-sdk-go-v2/service/emr v1.39.11
 	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.21.2
+	github.com/aws/aws-sdk-go-v2/service/entityresolution v1.9.2
 	github.com/aws/aws-sdk-go-v2/service/eventbridge v1.31.5
 	github.com/aws/aws-sdk-go-v2/service/evidently v1.19.10
 	github.com/aws/aws-sdk-go-v2/service/finspace v1.24.7
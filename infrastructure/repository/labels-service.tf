@@ -123,6 +123,7 @@ variable "service_labels" {
     "emr",
     "emrcontainers",
     "emrserverless",
+    "entityresolution",
     "events",
     "evidently",
     "finspace",
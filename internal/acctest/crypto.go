@@ -22,6 +22,7 @@ import (
 
 const (
 	PEMBlockTypeCertificate        = `CERTIFICATE`
+	PEMBlockTypeX509CRL            = `X509 CRL`
 	PEMBlockTypeCertificateRequest = `CERTIFICATE REQUEST`
 	PEMBlockTypeECPrivateKey       = `EC PRIVATE KEY`
 	PEMBlockTypeRSAPrivateKey      = `RSA PRIVATE KEY`
@@ -355,6 +356,49 @@ func TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t *testing.
 	return string(pem.EncodeToMemory(certificateBlock))
 }
 
+// TLSX509RevocationListPEM generates an empty x509 certificate revocation list PEM string
+// signed by the specified CA certificate and key.
+// Wrap with TLSPEMEscapeNewlines() to allow simple fmt.Sprintf()
+// configurations such as: crl_data = "%[1]s"
+func TLSX509RevocationListPEM(t *testing.T, caKeyPem, caCertificatePem string) string {
+	t.Helper()
+
+	keyBlock, _ := pem.Decode([]byte(caKeyPem))
+
+	key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificateBlock, _ := pem.Decode([]byte(caCertificatePem))
+
+	certificate, err := x509.ParseCertificate(certificateBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revocationList := &x509.RevocationList{
+		NextUpdate: time.Now().Add(hoursForCertificateValidity * time.Hour),
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now(),
+	}
+
+	revocationListBytes, err := x509.CreateRevocationList(rand.Reader, revocationList, certificate, key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revocationListBlock := &pem.Block{
+		Bytes: revocationListBytes,
+		Type:  PEMBlockTypeX509CRL,
+	}
+
+	return string(pem.EncodeToMemory(revocationListBlock))
+}
+
 // TLSRSAX509SelfSignedCertificatePEM generates a x509 certificate PEM string.
 // Wrap with TLSPEMEscapeNewlines() to allow simple fmt.Sprintf()
 // configurations such as: private_key_pem = "%[1]s"
@@ -22,26 +22,30 @@ import (
 	efs_sdkv1 "github.com/aws/aws-sdk-go/service/efs"
 	opsworks_sdkv1 "github.com/aws/aws-sdk-go/service/opsworks"
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
+	route53recoverycluster_sdkv1 "github.com/aws/aws-sdk-go/service/route53recoverycluster"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/experiments"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
+	AccountID              string
+	DefaultTagsConfig      *tftags.DefaultConfig
+	DeleteProtectionConfig *tftags.DeleteProtectionConfig
+	IgnoreTagsConfig       *tftags.IgnoreConfig
+	Partition              string
+	Region                 string
+	ServicePackages        map[string]ServicePackage
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
 	conns                     map[string]any
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
+	experiments               experiments.Set   // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
@@ -118,6 +122,12 @@ func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sd
 	return rds_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
 }
 
+// Route53RecoveryClusterConnForEndpoint returns an AWS SDK For Go v1 Route 53 Recovery Cluster API client for the specified cluster endpoint.
+// Route 53 Recovery Cluster is a data plane API that is only reachable through a cluster's regional endpoints.
+func (c *AWSClient) Route53RecoveryClusterConnForEndpoint(_ context.Context, endpoint, region string) *route53recoverycluster_sdkv1.Route53RecoveryCluster {
+	return route53recoverycluster_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithEndpoint(endpoint).WithRegion(region))
+}
+
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
 // This client differs from the standard S3 API client only in us-east-1 if the global S3 endpoint is used.
 // In that case the returned client uses the regional S3 endpoint.
@@ -158,6 +168,11 @@ func (c *AWSClient) HTTPClient(context.Context) *http.Client {
 	return c.httpClient
 }
 
+// ExperimentEnabled returns whether the specified experiment is enabled in the provider configuration.
+func (c *AWSClient) ExperimentEnabled(_ context.Context, experiment experiments.Experiment) bool {
+	return c.experiments.Enabled(experiment)
+}
+
 // RegisterLogger places the configured logger into Context so it can be used via `tflog`.
 func (c *AWSClient) RegisterLogger(ctx context.Context) context.Context {
 	return baselogging.RegisterLogger(ctx, c.logger)
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experiments"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
@@ -33,10 +34,12 @@ type Config struct {
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DeleteProtectionConfig         *tftags.DeleteProtectionConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
 	Endpoints                      map[string]string
+	Experiments                    experiments.Set
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
@@ -211,7 +214,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.DeleteProtectionConfig = c.DeleteProtectionConfig
 	client.dnsSuffix = dnsSuffix
+	client.experiments = c.Experiments
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package experiments
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Experiment is the name of an opt-in provider behavior that is not yet enabled by default.
+type Experiment string
+
+// registry holds all known experiments and a short description of each.
+// New experiments are registered here; an experiment is removed once its
+// behavior becomes the default or is abandoned.
+var registry = map[Experiment]string{}
+
+// Register adds an experiment to the registry.
+// It is intended to be called from package init functions.
+func Register(experiment Experiment, description string) {
+	if _, ok := registry[experiment]; ok {
+		panic(fmt.Sprintf("duplicate experiment: %s", experiment))
+	}
+
+	registry[experiment] = description
+}
+
+// Known returns the names of all registered experiments, sorted.
+func Known() []string {
+	names := make([]string, 0, len(registry))
+
+	for k := range registry {
+		names = append(names, string(k))
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// Validate returns an error if the specified experiment is not registered.
+func Validate(name string) error {
+	if _, ok := registry[Experiment(name)]; !ok {
+		if known := Known(); len(known) > 0 {
+			return fmt.Errorf("unknown experiment %q, expected one of: %s", name, strings.Join(known, ", "))
+		}
+
+		return fmt.Errorf("unknown experiment %q, no experiments are currently available", name)
+	}
+
+	return nil
+}
+
+// Set is the set of experiments enabled in a provider configuration.
+type Set map[Experiment]struct{}
+
+// New returns a Set containing the specified experiments.
+// Unregistered experiments are logged and ignored.
+func New(ctx context.Context, names []string) Set {
+	s := make(Set, len(names))
+
+	for _, name := range names {
+		if err := Validate(name); err != nil {
+			tflog.Warn(ctx, "ignoring experiment", map[string]any{
+				"tf_aws.experiment": name,
+				"error":             err.Error(),
+			})
+			continue
+		}
+
+		s[Experiment(name)] = struct{}{}
+	}
+
+	return s
+}
+
+// Enabled returns whether the specified experiment is enabled.
+// A nil Set has no experiments enabled.
+func (s Set) Enabled(experiment Experiment) bool {
+	_, ok := s[experiment]
+	return ok
+}
+
+// List returns the names of the enabled experiments, sorted.
+func (s Set) List() []string {
+	names := make([]string, 0, len(s))
+
+	for k := range s {
+		names = append(names, string(k))
+	}
+
+	slices.Sort(names)
+
+	return names
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package experiments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSet(t *testing.T) { //nolint:paralleltest // Modifies the package-level registry.
+	const (
+		experimentA Experiment = "test_experiment_a"
+		experimentB Experiment = "test_experiment_b"
+	)
+
+	Register(experimentA, "Test experiment A.")
+	Register(experimentB, "Test experiment B.")
+	t.Cleanup(func() {
+		delete(registry, experimentA)
+		delete(registry, experimentB)
+	})
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		names        []string
+		wantEnabledA bool
+		wantEnabledB bool
+		wantList     []string
+	}{
+		"none": {
+			wantList: []string{},
+		},
+		"one": {
+			names:        []string{string(experimentA)},
+			wantEnabledA: true,
+			wantList:     []string{string(experimentA)},
+		},
+		"both": {
+			names:        []string{string(experimentB), string(experimentA)},
+			wantEnabledA: true,
+			wantEnabledB: true,
+			wantList:     []string{string(experimentA), string(experimentB)},
+		},
+		"unknown": {
+			names:        []string{"not_an_experiment", string(experimentB)},
+			wantEnabledB: true,
+			wantList:     []string{string(experimentB)},
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // Modifies the package-level registry.
+		t.Run(name, func(t *testing.T) {
+			s := New(ctx, testCase.names)
+
+			if got, want := s.Enabled(experimentA), testCase.wantEnabledA; got != want {
+				t.Errorf("Enabled(%s) = %t, want %t", experimentA, got, want)
+			}
+			if got, want := s.Enabled(experimentB), testCase.wantEnabledB; got != want {
+				t.Errorf("Enabled(%s) = %t, want %t", experimentB, got, want)
+			}
+			if diff := cmp.Diff(s.List(), testCase.wantList); diff != "" {
+				t.Errorf("unexpected List diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNilSet(t *testing.T) {
+	t.Parallel()
+
+	var s Set
+
+	if s.Enabled("anything") {
+		t.Error("expected nil Set to have no experiments enabled")
+	}
+}
+
+func TestValidate(t *testing.T) { //nolint:paralleltest // Modifies the package-level registry.
+	const experiment Experiment = "test_experiment"
+
+	if err := Validate(string(experiment)); err == nil {
+		t.Errorf("expected error for unregistered experiment %q", experiment)
+	}
+
+	Register(experiment, "Test experiment.")
+	t.Cleanup(func() {
+		delete(registry, experiment)
+	})
+
+	if err := Validate(string(experiment)); err != nil {
+		t.Errorf("unexpected error for registered experiment %q: %s", experiment, err)
+	}
+}
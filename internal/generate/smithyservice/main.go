@@ -0,0 +1,823 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/smithyservice/smithy"
+)
+
+var (
+	dir          = flag.String("Dir", "", "output directory, defaults to internal/service/<Package>")
+	force        = flag.Bool("Force", false, "whether to overwrite existing scaffolded (non-generated) files")
+	modelFile    = flag.String("Model", "", "path to the service's Smithy JSON AST model")
+	pkg          = flag.String("Package", "", "name of the provider service package, e.g. widgets")
+	resourceList = flag.String("Resources", "", "comma-separated names of Smithy resources to scaffold, defaults to all")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go -Model <model.json> -Package <package> [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+var (
+	//go:embed templates/client.gtpl
+	clientTmpl string
+	//go:embed templates/client_gen.gtpl
+	clientGenTmpl string
+	//go:embed templates/generate.gtpl
+	generateTmpl string
+	//go:embed templates/resource.gtpl
+	resourceTmpl string
+	//go:embed templates/sweep.gtpl
+	sweepTmpl string
+	//go:embed templates/tags_gen.gtpl
+	tagsGenTmpl string
+	//go:embed templates/types_gen.gtpl
+	typesGenTmpl string
+)
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	if *modelFile == "" || *pkg == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *dir == "" {
+		*dir = filepath.Join("internal", "service", *pkg)
+	}
+
+	model, err := smithy.LoadFile(*modelFile)
+
+	if err != nil {
+		g.Fatalf("%s", err)
+	}
+
+	serviceID, err := model.Service()
+
+	if err != nil {
+		g.Fatalf("reading %s: %s", *modelFile, err)
+	}
+
+	var only []string
+	if *resourceList != "" {
+		only = strings.Split(*resourceList, ",")
+	}
+
+	s := newServiceDatum(g, model, serviceID, *pkg, only)
+
+	g.Infof("Scaffolding %s service package in %s", s.ServiceName, *dir)
+
+	// Generated files are always (re)written.
+	generated := []struct {
+		filename, templateName, templateBody string
+		skip                                 bool
+	}{
+		{"client_gen.go", "client_gen", clientGenTmpl, false},
+		{"types_gen.go", "types_gen", typesGenTmpl, false},
+		{"tags_gen.go", "tags_gen", tagsGenTmpl, s.Tagging == nil},
+	}
+
+	for _, v := range generated {
+		if v.skip {
+			continue
+		}
+
+		writeTemplate(g, filepath.Join(*dir, v.filename), v.templateName, v.templateBody, s)
+	}
+
+	// Scaffolded files are only written once, as they are expected to be edited by hand.
+	scaffolded := []struct {
+		filename, templateName, templateBody string
+		data                                 any
+	}{
+		{"generate.go", "generate", generateTmpl, s},
+		{"client.go", "client", clientTmpl, s},
+	}
+
+	if s.hasSweepers() {
+		scaffolded = append(scaffolded, struct {
+			filename, templateName, templateBody string
+			data                                 any
+		}{"sweep.go", "sweep", sweepTmpl, s})
+	}
+
+	for _, r := range s.Resources {
+		scaffolded = append(scaffolded, struct {
+			filename, templateName, templateBody string
+			data                                 any
+		}{r.FileName, "resource", resourceTmpl, r})
+	}
+
+	for _, v := range scaffolded {
+		filename := filepath.Join(*dir, v.filename)
+
+		if _, err := os.Stat(filename); err == nil && !*force {
+			g.Warnf("Skipping existing file %s", filename)
+			continue
+		}
+
+		writeTemplate(g, filename, v.templateName, v.templateBody, v.data)
+	}
+}
+
+func writeTemplate(g *common.Generator, filename, templateName, templateBody string, data any) {
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.CreateDirectories(); err != nil {
+		g.Fatalf("%s", err)
+	}
+
+	if err := d.WriteTemplate(templateName, templateBody, data); err != nil {
+		g.Fatalf("generating %s: %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("writing %s: %s", filename, err)
+	}
+
+	g.Infof("Wrote %s", filename)
+}
+
+type serviceDatum struct {
+	ProviderPackage string
+	ServiceName     string // Human friendly service name, e.g. "Widgets".
+	ServiceVersion  string
+	Operations      []operationDatum
+	Structures      []structureDatum
+	Enums           []enumDatum
+	Resources       []resourceDatum
+	NotFoundError   string // Go type name of the service's "not found" error, if any.
+	Tagging         *taggingDatum
+	ImportTime      bool
+}
+
+func (s serviceDatum) hasSweepers() bool {
+	return slices.ContainsFunc(s.Resources, func(r resourceDatum) bool {
+		return r.Sweepable
+	})
+}
+
+type operationDatum struct {
+	Name       string
+	InputType  string
+	OutputType string
+}
+
+type structureDatum struct {
+	Name          string
+	Documentation string
+	Fields        []fieldDatum
+	IsError       bool
+}
+
+type fieldDatum struct {
+	Name     string
+	Type     string
+	Required bool
+}
+
+type attributeDatum struct {
+	Name          string // Terraform attribute name.
+	FieldName     string // Go field name in the resource model.
+	APIFieldName  string // Go field name in API structures.
+	Kind          string // "String", "Bool", "Int64" or "Float64".
+	Required      bool
+	Computed      bool
+	ForceNew      bool
+	FromCreate    bool // Whether the value is returned by the create operation.
+	Identifier    bool
+	ARN           bool
+	ArgName       string // Go function argument name, for identifiers.
+	NameConstant  string // names.Attr* constant, if any.
+	PlanModifiers string // Plan modifier package, e.g. "stringplanmodifier".
+}
+
+// Key returns the Go expression used as the attribute's schema key.
+func (a attributeDatum) Key() string {
+	if a.NameConstant != "" {
+		return "names." + a.NameConstant
+	}
+
+	return fmt.Sprintf("%q", a.Name)
+}
+
+type enumDatum struct {
+	Name   string
+	Values []enumValueDatum
+}
+
+type enumValueDatum struct {
+	Name  string
+	Value string
+}
+
+type resourceDatum struct {
+	ProviderPackage  string
+	ServiceName      string
+	Name             string // Go name, e.g. "WidgetGroup".
+	FriendlyName     string // e.g. "Widget Group".
+	FactoryName      string // e.g. "newWidgetGroupResource".
+	StructName       string // e.g. "widgetGroupResource".
+	ModelName        string // e.g. "widgetGroupResourceModel".
+	TypeName         string // e.g. "aws_widgets_widget_group".
+	FileName         string
+	FinderName       string
+	IDPartCountConst string
+	Identifiers      []attributeDatum
+	Attributes       []attributeDatum // All attributes, including identifiers, sorted by name.
+	UpdatableFields  []string
+	ARN              *attributeDatum
+	Create           operationDatum
+	Read             operationDatum
+	Update           *operationDatum
+	Delete           operationDatum
+	NotFoundError    string
+	Tagged           bool
+	TagsField        string
+	Sweepable        bool
+	List             operationDatum
+	ListItemsField   string
+	ListInputToken   string
+	ListOutputToken  string
+}
+
+// HasCreateOutputAttributes returns whether any attribute values are returned by the create operation.
+func (r resourceDatum) HasCreateOutputAttributes() bool {
+	return slices.ContainsFunc(r.Attributes, func(a attributeDatum) bool {
+		return a.FromCreate
+	})
+}
+
+func (r resourceDatum) PlanModifierPackages() []string {
+	var packages []string
+
+	for _, a := range r.Attributes {
+		if a.PlanModifiers != "" && !slices.Contains(packages, a.PlanModifiers) {
+			packages = append(packages, a.PlanModifiers)
+		}
+	}
+
+	slices.Sort(packages)
+
+	return packages
+}
+
+type taggingDatum struct {
+	IdentifierField    string // TagResource, UntagResource and ListTagsForResource input identifier field.
+	TagsField          string // TagResource input and ListTagsForResource output tags field.
+	TagKeysField       string // UntagResource input tag keys field.
+	Map                bool   // Whether tags are a map. Otherwise they are a list of key/value structures.
+	TagType            string // Go type of a tag structure, if tags are a list.
+	TagKeyField        string
+	TagValueField      string
+	ListTagsOutputType string
+}
+
+func newServiceDatum(g *common.Generator, model *smithy.Model, serviceID, providerPackage string, only []string) serviceDatum {
+	types := smithy.NewGoTypes(model, serviceID)
+	service, _ := model.Shape(serviceID)
+
+	s := serviceDatum{
+		ProviderPackage: providerPackage,
+		ServiceName:     model.SDKID(serviceID),
+		ServiceVersion:  service.Version,
+	}
+
+	structures := make(map[string]struct{})
+	enums := make(map[string]struct{})
+	var visit func(id string)
+
+	visit = func(id string) {
+		if smithy.IsUnit(id) {
+			return
+		}
+
+		if model.ShapeType(id) == smithy.ShapeTypeTimestamp {
+			s.ImportTime = true
+			return
+		}
+
+		shape, ok := model.Shape(id)
+		if !ok {
+			return
+		}
+
+		switch {
+		case types.IsEnum(id):
+			enums[id] = struct{}{}
+		case shape.Type == smithy.ShapeTypeStructure || shape.Type == smithy.ShapeTypeUnion:
+			if _, ok := structures[id]; ok {
+				return
+			}
+			structures[id] = struct{}{}
+			for _, member := range shape.Members {
+				visit(member.Target)
+			}
+		case shape.Type == smithy.ShapeTypeList || shape.Type == smithy.ShapeTypeSet:
+			if shape.Member != nil {
+				visit(shape.Member.Target)
+			}
+		case shape.Type == smithy.ShapeTypeMap:
+			if shape.Value != nil {
+				visit(shape.Value.Target)
+			}
+		}
+	}
+
+	operations := make(map[string]operationDatum)
+
+	for _, id := range model.Operations(serviceID) {
+		op, _ := model.Shape(id)
+		name := smithy.ShapeName(id)
+		datum := operationDatum{
+			Name:       name,
+			InputType:  name + "Input",
+			OutputType: name + "Output",
+		}
+
+		// Operations without input or output use empty structures, as the AWS SDK for Go v2 does.
+		if op.Input == nil || smithy.IsUnit(op.Input.Target) {
+			s.Structures = append(s.Structures, structureDatum{Name: datum.InputType})
+		} else {
+			datum.InputType = types.Name(op.Input.Target)
+			visit(op.Input.Target)
+		}
+		if op.Output == nil || smithy.IsUnit(op.Output.Target) {
+			s.Structures = append(s.Structures, structureDatum{Name: datum.OutputType})
+		} else {
+			datum.OutputType = types.Name(op.Output.Target)
+			visit(op.Output.Target)
+		}
+
+		for _, ref := range op.Errors {
+			visit(ref.Target)
+		}
+
+		operations[id] = datum
+		s.Operations = append(s.Operations, datum)
+	}
+
+	for id := range structures {
+		shape, _ := model.Shape(id)
+		datum := structureDatum{
+			Name:          types.Name(id),
+			Documentation: firstSentence(shape.Documentation()),
+			IsError:       shape.IsError(),
+		}
+
+		for name, member := range shape.Members {
+			datum.Fields = append(datum.Fields, fieldDatum{
+				Name:     smithy.GoName(name),
+				Type:     types.FieldType(member.Target),
+				Required: member.IsRequired(),
+			})
+		}
+
+		slices.SortFunc(datum.Fields, func(a, b fieldDatum) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		if datum.IsError && (shape.HTTPErrorCode() == http.StatusNotFound || datum.Name == "ResourceNotFoundException") {
+			s.NotFoundError = datum.Name
+		}
+
+		s.Structures = append(s.Structures, datum)
+	}
+
+	slices.SortFunc(s.Structures, func(a, b structureDatum) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for id := range enums {
+		shape, _ := model.Shape(id)
+		datum := enumDatum{
+			Name: types.Name(id),
+		}
+
+		for _, value := range shape.EnumValues() {
+			datum.Values = append(datum.Values, enumValueDatum{
+				Name:  datum.Name + enumValueName(value),
+				Value: value,
+			})
+		}
+
+		s.Enums = append(s.Enums, datum)
+	}
+
+	slices.SortFunc(s.Enums, func(a, b enumDatum) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	s.Tagging = newTaggingDatum(model, types, operations)
+
+	for _, r := range model.Resources(serviceID) {
+		if len(only) > 0 && !slices.Contains(only, r.Name) {
+			continue
+		}
+
+		if r.Create == "" || r.Read == "" || r.Delete == "" {
+			g.Warnf("Skipping resource %s: create, read and delete lifecycle operations are required", r.Name)
+			continue
+		}
+
+		if len(r.Identifiers) == 0 {
+			g.Warnf("Skipping resource %s: singleton resources are not supported", r.Name)
+			continue
+		}
+
+		s.Resources = append(s.Resources, newResourceDatum(model, serviceID, s, r, operations))
+	}
+
+	return s
+}
+
+func newTaggingDatum(model *smithy.Model, types *smithy.GoTypes, operations map[string]operationDatum) *taggingDatum {
+	find := func(name string) (smithy.Shape, bool) {
+		for id := range operations {
+			if smithy.ShapeName(id) == name {
+				op, _ := model.Shape(id)
+				if op.Input == nil {
+					return smithy.Shape{}, false
+				}
+				input, ok := model.Shape(op.Input.Target)
+				return input, ok
+			}
+		}
+		return smithy.Shape{}, false
+	}
+
+	tagResource, ok := find("TagResource")
+	if !ok {
+		return nil
+	}
+	untagResource, ok := find("UntagResource")
+	if !ok {
+		return nil
+	}
+	if _, ok := find("ListTagsForResource"); !ok {
+		return nil
+	}
+
+	t := &taggingDatum{
+		ListTagsOutputType: "ListTagsForResourceOutput",
+	}
+
+	for name, member := range tagResource.Members {
+		switch {
+		case strings.EqualFold(name, "tags"):
+			t.TagsField = smithy.GoName(name)
+			target, _ := model.Shape(member.Target)
+
+			switch target.Type {
+			case smithy.ShapeTypeMap:
+				t.Map = true
+			case smithy.ShapeTypeList:
+				if target.Member == nil {
+					return nil
+				}
+				t.TagType = types.Name(target.Member.Target)
+				tag, _ := model.Shape(target.Member.Target)
+				for name := range tag.Members {
+					switch {
+					case strings.EqualFold(name, "key"):
+						t.TagKeyField = smithy.GoName(name)
+					case strings.EqualFold(name, "value"):
+						t.TagValueField = smithy.GoName(name)
+					}
+				}
+				if t.TagKeyField == "" || t.TagValueField == "" {
+					return nil
+				}
+			default:
+				return nil
+			}
+		case member.IsRequired():
+			t.IdentifierField = smithy.GoName(name)
+		}
+	}
+
+	for name := range untagResource.Members {
+		if strings.EqualFold(name, "tagKeys") {
+			t.TagKeysField = smithy.GoName(name)
+		}
+	}
+
+	if t.IdentifierField == "" || t.TagsField == "" || t.TagKeysField == "" {
+		return nil
+	}
+
+	return t
+}
+
+func newResourceDatum(model *smithy.Model, serviceID string, s serviceDatum, r smithy.Resource, operations map[string]operationDatum) resourceDatum {
+	snake := snakeCase(r.Name)
+	name := smithy.GoName(r.Name)
+
+	datum := resourceDatum{
+		ProviderPackage: s.ProviderPackage,
+		ServiceName:     s.ServiceName,
+		Name:            name,
+		FriendlyName:    friendlyName(snake),
+		FactoryName:     "new" + name + "Resource",
+		StructName:      lowerFirst(name) + "Resource",
+		ModelName:       lowerFirst(name) + "ResourceModel",
+		TypeName:        fmt.Sprintf("aws_%s_%s", s.ProviderPackage, snake),
+		FileName:        snake + ".go",
+		Create:          operations[r.Create],
+		Read:            operations[r.Read],
+		Delete:          operations[r.Delete],
+		NotFoundError:   s.NotFoundError,
+	}
+
+	if r.Update != "" {
+		v := operations[r.Update]
+		datum.Update = &v
+	}
+
+	membersOf := func(operationID string, output bool) map[string]smithy.Member {
+		op, _ := model.Shape(operationID)
+		ref := op.Input
+		if output {
+			ref = op.Output
+		}
+		if ref == nil {
+			return nil
+		}
+		shape, _ := model.Shape(ref.Target)
+		return shape.Members
+	}
+
+	createInput := membersOf(r.Create, false)
+	createOutput := membersOf(r.Create, true)
+	planModifiers := map[string]string{
+		"Bool":    "boolplanmodifier",
+		"Float64": "float64planmodifier",
+		"Int64":   "int64planmodifier",
+		"String":  "stringplanmodifier",
+	}
+
+	for _, identifier := range r.Identifiers {
+		_, inRequest := createInput[identifier]
+		a := attributeDatum{
+			Name:          snakeCase(identifier),
+			FieldName:     goFieldName(identifier),
+			APIFieldName:  smithy.GoName(identifier),
+			Kind:          "String",
+			Required:      inRequest,
+			Computed:      !inRequest,
+			ForceNew:      inRequest,
+			FromCreate:    !inRequest,
+			Identifier:    true,
+			ArgName:       goArgName(identifier),
+			PlanModifiers: planModifiers["String"],
+		}
+		datum.Identifiers = append(datum.Identifiers, a)
+		datum.Attributes = append(datum.Attributes, a)
+	}
+
+	switch len(r.Identifiers) {
+	case 1:
+		datum.FinderName = fmt.Sprintf("find%sByID", name)
+	case 2: //nolint:mnd // two-part key
+		datum.FinderName = fmt.Sprintf("find%sByTwoPartKey", name)
+	case 3: //nolint:mnd // three-part key
+		datum.FinderName = fmt.Sprintf("find%sByThreePartKey", name)
+	default:
+		datum.FinderName = fmt.Sprintf("find%sByKey", name)
+	}
+	if len(r.Identifiers) > 1 {
+		datum.IDPartCountConst = lowerFirst(name) + "ResourceIDPartCount"
+	}
+
+	for memberName := range createOutput {
+		if !slices.Contains(r.Identifiers, memberName) && (strings.EqualFold(memberName, "arn") || strings.HasSuffix(memberName, "Arn")) {
+			datum.ARN = &attributeDatum{
+				Name:          "arn",
+				FieldName:     "ARN",
+				APIFieldName:  smithy.GoName(memberName),
+				Kind:          "String",
+				Computed:      true,
+				FromCreate:    true,
+				ARN:           true,
+				NameConstant:  "AttrARN",
+				PlanModifiers: planModifiers["String"],
+			}
+			datum.Attributes = append(datum.Attributes, *datum.ARN)
+			break
+		}
+	}
+
+	for memberName, member := range createInput {
+		if slices.Contains(r.Identifiers, memberName) {
+			continue
+		}
+
+		if s.Tagging != nil && smithy.GoName(memberName) == s.Tagging.TagsField {
+			// Tags are only supported if the resource has an ARN to tag.
+			datum.Tagged = datum.ARN != nil
+			datum.TagsField = s.Tagging.TagsField
+			continue
+		}
+
+		if kind := attributeKind(model, member.Target); kind != "" {
+			a := attributeDatum{
+				Name:         snakeCase(memberName),
+				FieldName:    goFieldName(memberName),
+				APIFieldName: smithy.GoName(memberName),
+				Kind:         kind,
+				Required:     member.IsRequired(),
+				ForceNew:     datum.Update == nil,
+			}
+			if a.ForceNew {
+				a.PlanModifiers = planModifiers[kind]
+			} else {
+				datum.UpdatableFields = append(datum.UpdatableFields, a.FieldName)
+			}
+			datum.Attributes = append(datum.Attributes, a)
+		}
+	}
+
+	slices.SortFunc(datum.Attributes, func(a, b attributeDatum) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	slices.Sort(datum.UpdatableFields)
+
+	// Only top-level resources with a paginated list operation are swept.
+	if r.List != "" && len(r.Identifiers) == 1 {
+		inputToken, outputToken, items, ok := model.Paginated(serviceID, r.List)
+
+		if ok && items != "" {
+			datum.Sweepable = true
+			datum.List = operations[r.List]
+			datum.ListItemsField = smithy.GoName(items)
+			datum.ListInputToken = smithy.GoName(inputToken)
+			datum.ListOutputToken = smithy.GoName(outputToken)
+		}
+	}
+
+	return datum
+}
+
+// attributeKind returns the Terraform Plugin Framework attribute kind for scalar shapes, or "".
+func attributeKind(model *smithy.Model, id string) string {
+	switch model.ShapeType(id) {
+	case smithy.ShapeTypeString:
+		return "String"
+	case smithy.ShapeTypeBoolean:
+		return "Bool"
+	case smithy.ShapeTypeByte, smithy.ShapeTypeInteger, smithy.ShapeTypeLong, smithy.ShapeTypeShort:
+		return "Int64"
+	case smithy.ShapeTypeDouble, smithy.ShapeTypeFloat:
+		return "Float64"
+	}
+
+	return ""
+}
+
+// snakeCase converts a camelCase or PascalCase name to snake_case, keeping acronyms together.
+func snakeCase(s string) string {
+	var sb strings.Builder
+	runes := []rune(s)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				sb.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// friendlyName converts a snake_case name to space separated words, e.g. "widget_group" -> "Widget Group".
+func friendlyName(s string) string {
+	words := strings.Split(s, "_")
+
+	for i, word := range words {
+		switch word {
+		case "arn", "id":
+			words[i] = strings.ToUpper(word)
+		default:
+			words[i] = smithy.GoName(word)
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	runes := []rune(s)
+	runes[0] = unicode.ToLower(runes[0])
+
+	return string(runes)
+}
+
+// goFieldName returns a Go field name for a member, e.g. "widgetId" -> "WidgetID".
+func goFieldName(s string) string {
+	s = smithy.GoName(s)
+
+	if strings.HasSuffix(s, "Id") {
+		s = strings.TrimSuffix(s, "Id") + "ID"
+	} else if strings.HasSuffix(s, "Arn") {
+		s = strings.TrimSuffix(s, "Arn") + "ARN"
+	}
+
+	return s
+}
+
+// goArgName returns a Go argument name for an identifier, e.g. "widgetId" -> "widgetID".
+func goArgName(s string) string {
+	s = lowerFirst(s)
+
+	if strings.HasSuffix(s, "Id") {
+		s = strings.TrimSuffix(s, "Id") + "ID"
+	} else if strings.HasSuffix(s, "Arn") {
+		s = strings.TrimSuffix(s, "Arn") + "ARN"
+	}
+
+	return s
+}
+
+// enumValueName returns the Go constant suffix for an enum value, e.g. "IN_PROGRESS" -> "InProgress".
+func enumValueName(s string) string {
+	var sb strings.Builder
+	upper := true
+	allCaps := strings.ToUpper(s) == s
+
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		} else if allCaps {
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+func firstSentence(s string) string {
+	s = stripTags(s)
+
+	if i := strings.Index(s, ". "); i >= 0 {
+		s = s[:i+1]
+	}
+
+	return strings.TrimSpace(s)
+}
+
+// stripTags removes the HTML markup used in AWS API model documentation.
+func stripTags(s string) string {
+	var sb strings.Builder
+	inTag := false
+
+	for _, r := range s {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>':
+			inTag = false
+		case !inTag:
+			if r == '\n' {
+				r = ' '
+			}
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
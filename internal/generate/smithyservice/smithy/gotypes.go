@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package smithy
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// GoTypes maps Smithy shapes to Go type names in the style of the AWS SDK for Go v2.
+// Operation input and output structures are renamed to <Operation>Input and <Operation>Output
+// unless they are shared with other operations or structures.
+type GoTypes struct {
+	model   *Model
+	renames map[string]string
+}
+
+func NewGoTypes(m *Model, serviceID string) *GoTypes {
+	t := &GoTypes{
+		model:   m,
+		renames: make(map[string]string),
+	}
+
+	// Only structures referenced exactly once, as an operation's input or output, are renamed.
+	references := make(map[string]int)
+	for _, shape := range m.Shapes {
+		for _, member := range shape.Members {
+			references[member.Target]++
+		}
+		for _, member := range []*Member{shape.Member, shape.Value} {
+			if member != nil {
+				references[member.Target]++
+			}
+		}
+	}
+
+	candidates := make(map[string]string)
+	for _, id := range m.Operations(serviceID) {
+		op := m.Shapes[id]
+		name := ShapeName(id)
+
+		if op.Input != nil && !IsUnit(op.Input.Target) {
+			references[op.Input.Target]++
+			candidates[op.Input.Target] = name + "Input"
+		}
+		if op.Output != nil && !IsUnit(op.Output.Target) {
+			references[op.Output.Target]++
+			candidates[op.Output.Target] = name + "Output"
+		}
+	}
+
+	for id, name := range candidates {
+		if references[id] == 1 {
+			t.renames[id] = name
+		}
+	}
+
+	return t
+}
+
+// Name returns the Go type name for a named shape.
+func (t *GoTypes) Name(id string) string {
+	if v, ok := t.renames[id]; ok {
+		return v
+	}
+
+	return GoName(ShapeName(id))
+}
+
+// FieldType returns the Go type of a structure field targeting the specified shape.
+func (t *GoTypes) FieldType(id string) string {
+	switch t.model.ShapeType(id) {
+	case ShapeTypeBlob, ShapeTypeDocument, ShapeTypeEnum, ShapeTypeIntEnum, ShapeTypeList, ShapeTypeMap, ShapeTypeSet:
+		return t.ElemType(id)
+	case ShapeTypeString:
+		if t.isStringEnum(id) {
+			return t.ElemType(id)
+		}
+	}
+
+	return "*" + t.ElemType(id)
+}
+
+// ElemType returns the Go type of a list element or map value targeting the specified shape.
+func (t *GoTypes) ElemType(id string) string {
+	shape := t.model.Shapes[id]
+
+	switch t.model.ShapeType(id) {
+	case ShapeTypeBigDecimal, ShapeTypeBigInteger:
+		return "string"
+	case ShapeTypeBlob:
+		return "[]byte"
+	case ShapeTypeBoolean:
+		return "bool"
+	case ShapeTypeByte:
+		return "int8"
+	case ShapeTypeDocument:
+		return "any"
+	case ShapeTypeDouble:
+		return "float64"
+	case ShapeTypeEnum, ShapeTypeStructure, ShapeTypeUnion:
+		return t.Name(id)
+	case ShapeTypeFloat:
+		return "float32"
+	case ShapeTypeInteger, ShapeTypeIntEnum:
+		return "int32"
+	case ShapeTypeList, ShapeTypeSet:
+		if shape.Member == nil {
+			return "[]any"
+		}
+		return "[]" + t.ElemType(shape.Member.Target)
+	case ShapeTypeLong:
+		return "int64"
+	case ShapeTypeMap:
+		if shape.Value == nil {
+			return "map[string]any"
+		}
+		return "map[string]" + t.ElemType(shape.Value.Target)
+	case ShapeTypeShort:
+		return "int16"
+	case ShapeTypeString:
+		if t.isStringEnum(id) {
+			return t.Name(id)
+		}
+		return "string"
+	case ShapeTypeTimestamp:
+		return "time.Time"
+	}
+
+	return "any"
+}
+
+// IsEnum returns whether the shape is a string enumeration.
+func (t *GoTypes) IsEnum(id string) bool {
+	return t.model.ShapeType(id) == ShapeTypeEnum || t.isStringEnum(id)
+}
+
+func (t *GoTypes) isStringEnum(id string) bool {
+	shape, ok := t.model.Shapes[id]
+
+	return ok && shape.Type == ShapeTypeString && len(shape.EnumValues()) > 0
+}
+
+// GoName returns an exported Go identifier for a Smithy member or shape name.
+func GoName(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	r, n := utf8.DecodeRuneInString(s)
+
+	return string(unicode.ToUpper(r)) + s[n:]
+}
@@ -0,0 +1,466 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package smithy reads the subset of a Smithy JSON AST model
+// (https://smithy.io/2.0/spec/json-ast.html) needed to scaffold a service package.
+package smithy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Shape types.
+const (
+	ShapeTypeBigDecimal = "bigDecimal"
+	ShapeTypeBigInteger = "bigInteger"
+	ShapeTypeBlob       = "blob"
+	ShapeTypeBoolean    = "boolean"
+	ShapeTypeByte       = "byte"
+	ShapeTypeDocument   = "document"
+	ShapeTypeDouble     = "double"
+	ShapeTypeEnum       = "enum"
+	ShapeTypeFloat      = "float"
+	ShapeTypeIntEnum    = "intEnum"
+	ShapeTypeInteger    = "integer"
+	ShapeTypeList       = "list"
+	ShapeTypeLong       = "long"
+	ShapeTypeMap        = "map"
+	ShapeTypeOperation  = "operation"
+	ShapeTypeResource   = "resource"
+	ShapeTypeService    = "service"
+	ShapeTypeSet        = "set"
+	ShapeTypeShort      = "short"
+	ShapeTypeString     = "string"
+	ShapeTypeStructure  = "structure"
+	ShapeTypeTimestamp  = "timestamp"
+	ShapeTypeUnion      = "union"
+)
+
+// Trait IDs.
+const (
+	TraitAWSService    = "aws.api#service"
+	TraitDocumentation = "smithy.api#documentation"
+	TraitEnum          = "smithy.api#enum"
+	TraitEnumValue     = "smithy.api#enumValue"
+	TraitError         = "smithy.api#error"
+	TraitHTTPError     = "smithy.api#httpError"
+	TraitPaginated     = "smithy.api#paginated"
+	TraitRequired      = "smithy.api#required"
+	TraitTitle         = "smithy.api#title"
+)
+
+const (
+	preludeNamespace = "smithy.api"
+	unitShapeID      = "smithy.api#Unit"
+)
+
+type Ref struct {
+	Target string `json:"target"`
+}
+
+type Member struct {
+	Target string                     `json:"target"`
+	Traits map[string]json.RawMessage `json:"traits,omitempty"`
+}
+
+type Shape struct {
+	Type   string                     `json:"type"`
+	Traits map[string]json.RawMessage `json:"traits,omitempty"`
+
+	// Aggregate shapes.
+	Members map[string]Member `json:"members,omitempty"`
+	Member  *Member           `json:"member,omitempty"`
+	Key     *Member           `json:"key,omitempty"`
+	Value   *Member           `json:"value,omitempty"`
+
+	// Service and resource shapes.
+	Version              string         `json:"version,omitempty"`
+	Operations           []Ref          `json:"operations,omitempty"`
+	CollectionOperations []Ref          `json:"collectionOperations,omitempty"`
+	Resources            []Ref          `json:"resources,omitempty"`
+	Identifiers          map[string]Ref `json:"identifiers,omitempty"`
+	Create               *Ref           `json:"create,omitempty"`
+	Put                  *Ref           `json:"put,omitempty"`
+	Read                 *Ref           `json:"read,omitempty"`
+	Update               *Ref           `json:"update,omitempty"`
+	Delete               *Ref           `json:"delete,omitempty"`
+	List                 *Ref           `json:"list,omitempty"`
+
+	// Operation shapes.
+	Input  *Ref  `json:"input,omitempty"`
+	Output *Ref  `json:"output,omitempty"`
+	Errors []Ref `json:"errors,omitempty"`
+}
+
+type Model struct {
+	Smithy string           `json:"smithy"`
+	Shapes map[string]Shape `json:"shapes"`
+}
+
+// Load reads a Smithy JSON AST model.
+func Load(r io.Reader) (*Model, error) {
+	var m Model
+
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, fmt.Errorf("decoding Smithy model: %w", err)
+	}
+
+	if m.Smithy == "" {
+		return nil, errors.New("decoding Smithy model: missing \"smithy\" version")
+	}
+
+	return &m, nil
+}
+
+// LoadFile reads a Smithy JSON AST model from the named file.
+func LoadFile(filename string) (*Model, error) {
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening Smithy model (%s): %w", filename, err)
+	}
+
+	defer f.Close()
+
+	return Load(f)
+}
+
+// Service returns the ID of the model's single service shape.
+func (m *Model) Service() (string, error) {
+	var ids []string
+
+	for id, shape := range m.Shapes {
+		if shape.Type == ShapeTypeService {
+			ids = append(ids, id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", errors.New("no service shape found")
+	case 1:
+		return ids[0], nil
+	default:
+		slices.Sort(ids)
+		return "", fmt.Errorf("multiple service shapes found: %s", strings.Join(ids, ", "))
+	}
+}
+
+// Shape returns the shape with the specified ID.
+func (m *Model) Shape(id string) (Shape, bool) {
+	shape, ok := m.Shapes[id]
+
+	return shape, ok
+}
+
+// SDKID returns the service's SDK ID, falling back to its title and then its shape name.
+func (m *Model) SDKID(serviceID string) string {
+	shape := m.Shapes[serviceID]
+
+	var v struct {
+		SDKID string `json:"sdkId"`
+	}
+	if raw, ok := shape.Traits[TraitAWSService]; ok && json.Unmarshal(raw, &v) == nil && v.SDKID != "" {
+		return v.SDKID
+	}
+
+	var title string
+	if raw, ok := shape.Traits[TraitTitle]; ok && json.Unmarshal(raw, &title) == nil && title != "" {
+		return title
+	}
+
+	return ShapeName(serviceID)
+}
+
+// Operations returns the IDs of all operations bound to the service, directly or via resources, sorted by name.
+func (m *Model) Operations(serviceID string) []string {
+	seen := make(map[string]struct{})
+	var walk func(id string)
+
+	walk = func(id string) {
+		shape, ok := m.Shapes[id]
+		if !ok {
+			return
+		}
+
+		add := func(ref *Ref) {
+			if ref != nil && ref.Target != "" {
+				seen[ref.Target] = struct{}{}
+			}
+		}
+
+		for _, ref := range shape.Operations {
+			add(&ref)
+		}
+		for _, ref := range shape.CollectionOperations {
+			add(&ref)
+		}
+		add(shape.Create)
+		add(shape.Put)
+		add(shape.Read)
+		add(shape.Update)
+		add(shape.Delete)
+		add(shape.List)
+
+		for _, ref := range shape.Resources {
+			walk(ref.Target)
+		}
+	}
+
+	walk(serviceID)
+
+	ids := make([]string, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b string) int {
+		return strings.Compare(ShapeName(a), ShapeName(b))
+	})
+
+	return ids
+}
+
+// Resource is a flattened view of a Smithy resource shape.
+type Resource struct {
+	ID          string
+	Name        string
+	Identifiers []string // Identifier member names, parent resources' identifiers first.
+	Create      string
+	Read        string
+	Update      string
+	Delete      string
+	List        string
+}
+
+// Resources returns all resources bound to the service, including nested resources, sorted by name.
+func (m *Model) Resources(serviceID string) []Resource {
+	var resources []Resource
+	seen := make(map[string]struct{})
+	var walk func(id string, parentIdentifiers []string)
+
+	walk = func(id string, parentIdentifiers []string) {
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+
+		shape, ok := m.Shapes[id]
+		if !ok {
+			return
+		}
+
+		if shape.Type == ShapeTypeResource {
+			r := Resource{
+				ID:   id,
+				Name: ShapeName(id),
+			}
+
+			var identifiers []string
+			for name := range shape.Identifiers {
+				if !slices.Contains(parentIdentifiers, name) {
+					identifiers = append(identifiers, name)
+				}
+			}
+			slices.Sort(identifiers)
+			for _, name := range parentIdentifiers {
+				if _, ok := shape.Identifiers[name]; ok {
+					r.Identifiers = append(r.Identifiers, name)
+				}
+			}
+			r.Identifiers = append(r.Identifiers, identifiers...)
+			parentIdentifiers = r.Identifiers
+
+			target := func(ref *Ref) string {
+				if ref == nil {
+					return ""
+				}
+				return ref.Target
+			}
+
+			r.Create = target(shape.Create)
+			if r.Create == "" {
+				r.Create = target(shape.Put)
+			}
+			r.Read = target(shape.Read)
+			r.Update = target(shape.Update)
+			r.Delete = target(shape.Delete)
+			r.List = target(shape.List)
+
+			resources = append(resources, r)
+		}
+
+		for _, ref := range shape.Resources {
+			walk(ref.Target, parentIdentifiers)
+		}
+	}
+
+	walk(serviceID, nil)
+
+	slices.SortFunc(resources, func(a, b Resource) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return resources
+}
+
+// IsError returns whether the shape is an error structure.
+func (s Shape) IsError() bool {
+	_, ok := s.Traits[TraitError]
+
+	return ok
+}
+
+// HTTPErrorCode returns the HTTP status code bound to an error structure, or 0.
+func (s Shape) HTTPErrorCode() int {
+	var v int
+
+	if raw, ok := s.Traits[TraitHTTPError]; ok {
+		_ = json.Unmarshal(raw, &v)
+	}
+
+	return v
+}
+
+// Documentation returns the shape's documentation trait value.
+func (s Shape) Documentation() string {
+	return documentation(s.Traits)
+}
+
+// EnumValues returns the values of an enum shape, or of a string shape with the Smithy 1.0 enum trait.
+func (s Shape) EnumValues() []string {
+	var values []string
+
+	switch s.Type {
+	case ShapeTypeEnum:
+		for name, member := range s.Members {
+			value := name
+			if raw, ok := member.Traits[TraitEnumValue]; ok {
+				_ = json.Unmarshal(raw, &value)
+			}
+			values = append(values, value)
+		}
+	case ShapeTypeString:
+		var defs []struct {
+			Value string `json:"value"`
+		}
+		if raw, ok := s.Traits[TraitEnum]; ok && json.Unmarshal(raw, &defs) == nil {
+			for _, def := range defs {
+				values = append(values, def.Value)
+			}
+		}
+	}
+
+	slices.Sort(values)
+
+	return values
+}
+
+// Paginated returns the input and output pagination tokens and the items member of a paginated operation.
+func (m *Model) Paginated(serviceID, operationID string) (inputToken, outputToken, items string, ok bool) {
+	type paginated struct {
+		InputToken  string `json:"inputToken"`
+		OutputToken string `json:"outputToken"`
+		Items       string `json:"items"`
+	}
+
+	var v paginated
+
+	// Service-level defaults are merged with the operation's trait.
+	if raw, exists := m.Shapes[serviceID].Traits[TraitPaginated]; exists {
+		_ = json.Unmarshal(raw, &v)
+	}
+
+	raw, exists := m.Shapes[operationID].Traits[TraitPaginated]
+	if !exists {
+		return "", "", "", false
+	}
+
+	var op paginated
+	if err := json.Unmarshal(raw, &op); err != nil {
+		return "", "", "", false
+	}
+	if op.InputToken != "" {
+		v.InputToken = op.InputToken
+	}
+	if op.OutputToken != "" {
+		v.OutputToken = op.OutputToken
+	}
+	if op.Items != "" {
+		v.Items = op.Items
+	}
+
+	return v.InputToken, v.OutputToken, v.Items, v.InputToken != "" && v.OutputToken != ""
+}
+
+// IsRequired returns whether the member is required.
+func (m Member) IsRequired() bool {
+	_, ok := m.Traits[TraitRequired]
+
+	return ok
+}
+
+// Documentation returns the member's documentation trait value.
+func (m Member) Documentation() string {
+	return documentation(m.Traits)
+}
+
+// ShapeName returns the name part of an absolute shape ID.
+func ShapeName(id string) string {
+	if i := strings.LastIndex(id, "#"); i >= 0 {
+		id = id[i+1:]
+	}
+	if i := strings.Index(id, "$"); i >= 0 {
+		id = id[:i]
+	}
+
+	return id
+}
+
+// IsUnit returns whether the shape ID refers to the prelude Unit shape.
+func IsUnit(id string) bool {
+	return id == "" || id == unitShapeID
+}
+
+// ShapeType returns the type of the shape with the specified ID, resolving prelude shapes.
+func (m *Model) ShapeType(id string) string {
+	if shape, ok := m.Shapes[id]; ok {
+		return shape.Type
+	}
+
+	if strings.HasPrefix(id, preludeNamespace+"#") {
+		name := ShapeName(id)
+		name = strings.TrimPrefix(name, "Primitive")
+
+		return lowerFirst(name)
+	}
+
+	return ""
+}
+
+func documentation(traits map[string]json.RawMessage) string {
+	var v string
+
+	if raw, ok := traits[TraitDocumentation]; ok {
+		_ = json.Unmarshal(raw, &v)
+	}
+
+	return v
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	r, n := utf8.DecodeRuneInString(s)
+
+	return string(unicode.ToLower(r)) + s[n:]
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package smithy
+
+import (
+	"strings"
+	"testing"
+)
+
+const testModel = `{
+  "smithy": "2.0",
+  "shapes": {
+    "example.widgets#Widgets": {
+      "type": "service",
+      "version": "2024-01-01",
+      "resources": [{"target": "example.widgets#Widget"}],
+      "operations": [{"target": "example.widgets#TagResource"}],
+      "traits": {
+        "aws.api#service": {"sdkId": "Widgets"}
+      }
+    },
+    "example.widgets#Widget": {
+      "type": "resource",
+      "identifiers": {"widgetId": {"target": "smithy.api#String"}},
+      "create": {"target": "example.widgets#CreateWidget"},
+      "read": {"target": "example.widgets#GetWidget"},
+      "delete": {"target": "example.widgets#DeleteWidget"},
+      "list": {"target": "example.widgets#ListWidgets"},
+      "resources": [{"target": "example.widgets#Sprocket"}]
+    },
+    "example.widgets#Sprocket": {
+      "type": "resource",
+      "identifiers": {
+        "widgetId": {"target": "smithy.api#String"},
+        "sprocketId": {"target": "smithy.api#String"}
+      },
+      "put": {"target": "example.widgets#PutSprocket"}
+    },
+    "example.widgets#CreateWidget": {
+      "type": "operation",
+      "input": {"target": "example.widgets#CreateWidgetRequest"},
+      "output": {"target": "example.widgets#CreateWidgetResponse"}
+    },
+    "example.widgets#GetWidget": {
+      "type": "operation",
+      "input": {"target": "example.widgets#GetWidgetRequest"},
+      "output": {"target": "example.widgets#GetWidgetResponse"},
+      "errors": [{"target": "example.widgets#ResourceNotFoundException"}]
+    },
+    "example.widgets#DeleteWidget": {
+      "type": "operation",
+      "input": {"target": "example.widgets#GetWidgetRequest"},
+      "output": {"target": "smithy.api#Unit"}
+    },
+    "example.widgets#ListWidgets": {
+      "type": "operation",
+      "input": {"target": "example.widgets#ListWidgetsRequest"},
+      "output": {"target": "example.widgets#ListWidgetsResponse"},
+      "traits": {
+        "smithy.api#paginated": {"inputToken": "nextToken", "outputToken": "nextToken", "items": "widgets"}
+      }
+    },
+    "example.widgets#PutSprocket": {
+      "type": "operation"
+    },
+    "example.widgets#TagResource": {
+      "type": "operation"
+    },
+    "example.widgets#CreateWidgetRequest": {
+      "type": "structure",
+      "members": {
+        "name": {"target": "smithy.api#String", "traits": {"smithy.api#required": {}}},
+        "color": {"target": "example.widgets#Color"},
+        "size": {"target": "smithy.api#Integer"},
+        "createdAt": {"target": "smithy.api#Timestamp"},
+        "labels": {"target": "example.widgets#LabelList"},
+        "tags": {"target": "example.widgets#TagMap"}
+      }
+    },
+    "example.widgets#CreateWidgetResponse": {"type": "structure", "members": {}},
+    "example.widgets#GetWidgetRequest": {"type": "structure", "members": {}},
+    "example.widgets#GetWidgetResponse": {"type": "structure", "members": {}},
+    "example.widgets#ListWidgetsRequest": {"type": "structure", "members": {}},
+    "example.widgets#ListWidgetsResponse": {"type": "structure", "members": {}},
+    "example.widgets#Color": {
+      "type": "enum",
+      "members": {
+        "RED": {"target": "smithy.api#Unit", "traits": {"smithy.api#enumValue": "red"}},
+        "BLUE": {"target": "smithy.api#Unit", "traits": {"smithy.api#enumValue": "blue"}}
+      }
+    },
+    "example.widgets#Shape": {
+      "type": "string",
+      "traits": {"smithy.api#enum": [{"value": "ROUND"}, {"value": "SQUARE"}]}
+    },
+    "example.widgets#LabelList": {
+      "type": "list",
+      "member": {"target": "example.widgets#Label"}
+    },
+    "example.widgets#Label": {
+      "type": "structure",
+      "members": {"key": {"target": "smithy.api#String"}}
+    },
+    "example.widgets#TagMap": {
+      "type": "map",
+      "key": {"target": "smithy.api#String"},
+      "value": {"target": "smithy.api#String"}
+    },
+    "example.widgets#ResourceNotFoundException": {
+      "type": "structure",
+      "members": {"message": {"target": "smithy.api#String"}},
+      "traits": {"smithy.api#error": "client", "smithy.api#httpError": 404}
+    }
+  }
+}`
+
+func loadTestModel(t *testing.T) *Model {
+	t.Helper()
+
+	m, err := Load(strings.NewReader(testModel))
+
+	if err != nil {
+		t.Fatalf("Load() error = %s", err)
+	}
+
+	return m
+}
+
+func TestLoadMissingVersion(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Load(strings.NewReader(`{"shapes": {}}`)); err == nil {
+		t.Error("Load() expected error")
+	}
+}
+
+func TestModelService(t *testing.T) {
+	t.Parallel()
+
+	m := loadTestModel(t)
+
+	id, err := m.Service()
+
+	if err != nil {
+		t.Fatalf("Service() error = %s", err)
+	}
+	if got, want := id, "example.widgets#Widgets"; got != want {
+		t.Errorf("Service() = %v, want %v", got, want)
+	}
+	if got, want := m.SDKID(id), "Widgets"; got != want {
+		t.Errorf("SDKID() = %v, want %v", got, want)
+	}
+}
+
+func TestModelOperations(t *testing.T) {
+	t.Parallel()
+
+	m := loadTestModel(t)
+	id, _ := m.Service()
+
+	var got []string
+	for _, v := range m.Operations(id) {
+		got = append(got, ShapeName(v))
+	}
+
+	want := []string{"CreateWidget", "DeleteWidget", "GetWidget", "ListWidgets", "PutSprocket", "TagResource"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Operations() = %v, want %v", got, want)
+	}
+}
+
+func TestModelResources(t *testing.T) {
+	t.Parallel()
+
+	m := loadTestModel(t)
+	id, _ := m.Service()
+
+	resources := m.Resources(id)
+
+	if got, want := len(resources), 2; got != want {
+		t.Fatalf("length of Resources() = %v, want %v", got, want)
+	}
+
+	sprocket := resources[0]
+	if got, want := sprocket.Name, "Sprocket"; got != want {
+		t.Errorf("Resources()[0].Name = %v, want %v", got, want)
+	}
+	if got, want := strings.Join(sprocket.Identifiers, ","), "widgetId,sprocketId"; got != want {
+		t.Errorf("Resources()[0].Identifiers = %v, want %v", got, want)
+	}
+	if got, want := sprocket.Create, "example.widgets#PutSprocket"; got != want {
+		t.Errorf("Resources()[0].Create = %v, want %v", got, want)
+	}
+
+	widget := resources[1]
+	if got, want := widget.Read, "example.widgets#GetWidget"; got != want {
+		t.Errorf("Resources()[1].Read = %v, want %v", got, want)
+	}
+	if got, want := widget.Update, ""; got != want {
+		t.Errorf("Resources()[1].Update = %v, want %v", got, want)
+	}
+}
+
+func TestModelPaginated(t *testing.T) {
+	t.Parallel()
+
+	m := loadTestModel(t)
+	id, _ := m.Service()
+
+	inputToken, outputToken, items, ok := m.Paginated(id, "example.widgets#ListWidgets")
+
+	if !ok {
+		t.Fatal("Paginated() ok = false")
+	}
+	if inputToken != "nextToken" || outputToken != "nextToken" || items != "widgets" {
+		t.Errorf("Paginated() = %v, %v, %v", inputToken, outputToken, items)
+	}
+
+	if _, _, _, ok := m.Paginated(id, "example.widgets#GetWidget"); ok {
+		t.Error("Paginated() ok = true for unpaginated operation")
+	}
+}
+
+func TestShapeEnumValues(t *testing.T) {
+	t.Parallel()
+
+	m := loadTestModel(t)
+
+	if got, want := strings.Join(m.Shapes["example.widgets#Color"].EnumValues(), ","), "blue,red"; got != want {
+		t.Errorf("EnumValues() = %v, want %v", got, want)
+	}
+	if got, want := strings.Join(m.Shapes["example.widgets#Shape"].EnumValues(), ","), "ROUND,SQUARE"; got != want {
+		t.Errorf("EnumValues() = %v, want %v", got, want)
+	}
+}
+
+func TestShapeError(t *testing.T) {
+	t.Parallel()
+
+	m := loadTestModel(t)
+	shape := m.Shapes["example.widgets#ResourceNotFoundException"]
+
+	if !shape.IsError() {
+		t.Error("IsError() = false")
+	}
+	if got, want := shape.HTTPErrorCode(), 404; got != want {
+		t.Errorf("HTTPErrorCode() = %v, want %v", got, want)
+	}
+}
+
+func TestGoTypes(t *testing.T) {
+	t.Parallel()
+
+	m := loadTestModel(t)
+	id, _ := m.Service()
+	types := NewGoTypes(m, id)
+
+	testCases := map[string]struct {
+		fn   func(string) string
+		id   string
+		want string
+	}{
+		"input rename":    {types.Name, "example.widgets#CreateWidgetRequest", "CreateWidgetInput"},
+		"output rename":   {types.Name, "example.widgets#CreateWidgetResponse", "CreateWidgetOutput"},
+		"shared input":    {types.Name, "example.widgets#GetWidgetRequest", "GetWidgetRequest"},
+		"string field":    {types.FieldType, "smithy.api#String", "*string"},
+		"integer field":   {types.FieldType, "smithy.api#Integer", "*int32"},
+		"timestamp field": {types.FieldType, "smithy.api#Timestamp", "*time.Time"},
+		"enum field":      {types.FieldType, "example.widgets#Color", "Color"},
+		"string enum":     {types.FieldType, "example.widgets#Shape", "Shape"},
+		"list field":      {types.FieldType, "example.widgets#LabelList", "[]Label"},
+		"map field":       {types.FieldType, "example.widgets#TagMap", "map[string]string"},
+		"structure field": {types.FieldType, "example.widgets#Label", "*Label"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.fn(testCase.id); got != testCase.want {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
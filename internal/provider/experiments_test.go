@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experiments"
+)
+
+const experimentTest experiments.Experiment = "provider_test"
+
+func init() {
+	experiments.Register(experimentTest, "Test experiment used by the provider package tests.")
+}
+
+func TestProviderConfig_experiments(t *testing.T) { //nolint:paralleltest
+	testCases := map[string]struct {
+		config        map[string]any
+		wantValidErr  bool
+		wantAttribute string
+	}{
+		"no block": {
+			wantAttribute: "disabled",
+		},
+		"empty block": {
+			config: map[string]any{
+				"experiments": []any{map[string]any{}},
+			},
+			wantAttribute: "disabled",
+		},
+		"enabled": {
+			config: map[string]any{
+				"experiments": []any{map[string]any{
+					"enabled": []any{string(experimentTest)},
+				}},
+			},
+			wantAttribute: "enabled",
+		},
+		"unknown": {
+			config: map[string]any{
+				"experiments": []any{map[string]any{
+					"enabled": []any{"not_an_experiment"},
+				}},
+			},
+			wantValidErr: true,
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			servicemocks.InitSessionTestEnv(t)
+
+			config := map[string]any{
+				"access_key":                  servicemocks.MockStaticAccessKey,
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+			}
+
+			maps.Copy(config, testCase.config)
+
+			p, err := New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rc := terraformsdk.NewResourceConfigRaw(config)
+
+			var diags diag.Diagnostics
+			diags = append(diags, p.Validate(rc)...)
+
+			if got, want := diags.HasError(), testCase.wantValidErr; got != want {
+				t.Fatalf("validating: got error %t, want %t: %s", got, want, sdkdiag.DiagnosticsString(diags))
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			diags = append(diags, p.Configure(ctx, rc)...)
+			if diags.HasError() {
+				t.Fatalf("configuring: %s", sdkdiag.DiagnosticsString(diags))
+			}
+
+			// The flag must reach resources through the provider Meta.
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"experiment": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+				CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+					if meta.(*conns.AWSClient).ExperimentEnabled(ctx, experimentTest) {
+						return d.SetNew("experiment", "enabled")
+					}
+
+					return d.SetNew("experiment", "disabled")
+				},
+			}
+
+			diff, err := r.Diff(ctx, nil, terraformsdk.NewResourceConfigRaw(map[string]any{}), p.Meta())
+			if err != nil {
+				t.Fatalf("diffing: %s", err)
+			}
+
+			v, ok := diff.Attributes["experiment"]
+			if !ok {
+				t.Fatal("experiment attribute not in diff")
+			}
+
+			if got, want := v.New, testCase.wantAttribute; got != want {
+				t.Errorf("experiment = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -651,5 +651,37 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 }
 
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.tags == nil || meta == nil || meta.DeleteProtectionConfig == nil {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		var stateTagsAll fwtypes.Map
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		// Refuse to delete any resource carrying the provider configured delete protection tag.
+		if v := meta.DeleteProtectionConfig; v.Protects(tftags.New(ctx, stateTagsAll)) {
+			serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
+			if err != nil {
+				serviceName = "<service>"
+			}
+
+			diags.AddError(
+				fmt.Sprintf("deleting %s %s", serviceName, inContext.ResourceName),
+				fmt.Sprintf("Resource is protected by provider delete_protection tag %q.", v.Key),
+			)
+		}
+	}
+
 	return ctx, diags
 }
@@ -267,7 +267,40 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"delete_protection": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to prevent the deletion of tagged resources across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_key": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource tag key that marks a resource as protected from deletion.",
+						},
+						"tag_value": schema.StringAttribute{
+							Optional:    true,
+							Description: "Resource tag value that marks a resource as protected from deletion. If not set, any value of the tag key protects the resource.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
+			"experiments": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to opt in to experimental provider behaviors.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Names of the experimental provider behaviors to enable.",
+						},
+					},
+				},
+			},
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
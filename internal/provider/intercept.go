@@ -295,6 +295,12 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 					}
 				}
 			}
+		case Delete:
+			// Refuse to delete any resource carrying the provider configured delete protection tag.
+			tagsAll, _ := d.Get(names.AttrTagsAll).(map[string]interface{})
+			if v := meta.(*conns.AWSClient).DeleteProtectionConfig; v.Protects(tftags.New(ctx, tagsAll)) {
+				return ctx, sdkdiag.AppendErrorf(diags, "deleting %s %s (%s): protected by provider delete_protection tag %q", serviceName, resourceName, d.Id(), v.Key)
+			}
 		}
 	case After:
 		// Set tags and tags_all in state after CRU.
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experiments"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -74,6 +75,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"delete_protection": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to prevent the deletion of tagged resources across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Resource tag key that marks a resource as protected from deletion.",
+						},
+						"tag_value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource tag value that marks a resource as protected from deletion. If not set, any value of the tag key protects the resource.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -87,6 +108,25 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
 			"endpoints": endpointsSchema(),
+			"experiments": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to opt in to experimental provider behaviors.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validExperiment,
+							},
+							Description: "Names of the experimental provider behaviors to enable.",
+						},
+					},
+				},
+			},
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -401,7 +441,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				interceptors = append(interceptors, interceptorItem{
 					when: Before | After | Finally,
-					why:  Create | Read | Update,
+					why:  AllOps,
 					interceptor: tagsResourceInterceptor{
 						tags:       v.Tags,
 						updateFunc: tagsUpdateFunc,
@@ -536,6 +576,13 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("delete_protection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.DeleteProtectionConfig = expandDeleteProtection(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tflog.Info(ctx, "delete_protection configuration set", map[string]any{
+			"tf_aws.delete_protection.tag_key": config.DeleteProtectionConfig.Key,
+		})
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -544,6 +591,13 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 	config.Endpoints = endpoints
 
+	if v, ok := d.GetOk("experiments"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.Experiments = expandExperiments(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tflog.Info(ctx, "experiments configuration set", map[string]any{
+			"tf_aws.experiments": config.Experiments.List(),
+		})
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -852,6 +906,36 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
+func expandDeleteProtection(_ context.Context, tfMap map[string]interface{}) *tftags.DeleteProtectionConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	deleteProtectionConfig := &tftags.DeleteProtectionConfig{}
+
+	if v, ok := tfMap["tag_key"].(string); ok {
+		deleteProtectionConfig.Key = v
+	}
+
+	if v, ok := tfMap["tag_value"].(string); ok && v != "" {
+		deleteProtectionConfig.Value = aws.String(v)
+	}
+
+	return deleteProtectionConfig
+}
+
+func expandExperiments(ctx context.Context, tfMap map[string]interface{}) experiments.Set {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["enabled"].(*schema.Set); ok && v.Len() > 0 {
+		return experiments.New(ctx, flex.ExpandStringValueSet(v))
+	}
+
+	return nil
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
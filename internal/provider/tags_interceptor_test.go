@@ -107,6 +107,83 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 }
 
+func TestTagsResourceInterceptorDeleteProtection(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		deleteProtectionConfig *tftags.DeleteProtectionConfig
+		tagsAll                map[string]interface{}
+		wantErr                bool
+	}{
+		"not configured": {
+			tagsAll: map[string]interface{}{"tf-protected": "true"},
+		},
+		"not tagged": {
+			deleteProtectionConfig: expandDeleteProtection(context.Background(), map[string]interface{}{
+				"tag_key":   "tf-protected",
+				"tag_value": "true",
+			}),
+			tagsAll: map[string]interface{}{"tag1": "value1"},
+		},
+		"tagged with other value": {
+			deleteProtectionConfig: expandDeleteProtection(context.Background(), map[string]interface{}{
+				"tag_key":   "tf-protected",
+				"tag_value": "true",
+			}),
+			tagsAll: map[string]interface{}{"tf-protected": "false"},
+		},
+		"tagged": {
+			deleteProtectionConfig: expandDeleteProtection(context.Background(), map[string]interface{}{
+				"tag_key":   "tf-protected",
+				"tag_value": "true",
+			}),
+			tagsAll: map[string]interface{}{"tf-protected": "true"},
+			wantErr: true,
+		},
+		"tagged any value": {
+			deleteProtectionConfig: expandDeleteProtection(context.Background(), map[string]interface{}{
+				"tag_key": "tf-protected",
+			}),
+			tagsAll: map[string]interface{}{"tf-protected": "yes"},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := tagsResourceInterceptor{
+				tags: &types.ServicePackageResourceTags{
+					IdentifierAttribute: "id",
+				},
+				updateFunc: tagsUpdateFunc,
+				readFunc:   tagsReadFunc,
+			}
+
+			conn := &conns.AWSClient{
+				ServicePackages: map[string]conns.ServicePackage{
+					"Test": &mockService{},
+				},
+				DeleteProtectionConfig: testCase.deleteProtectionConfig,
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "aws_test")
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig, conn.IgnoreTagsConfig)
+			d := &deleteResourceData{tagsAll: testCase.tagsAll}
+
+			var diags diag.Diagnostics
+			_, diags = interceptor.run(ctx, d, conn, Before, Delete, diags)
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("diags.HasError() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 type resourceData struct{}
 
 func (d *resourceData) GetRawConfig() cty.Value {
@@ -148,3 +225,16 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+type deleteResourceData struct {
+	resourceData
+	tagsAll map[string]interface{}
+}
+
+func (d *deleteResourceData) Get(key string) any {
+	if key == "tags_all" {
+		return d.tagsAll
+	}
+
+	return nil
+}
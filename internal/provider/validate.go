@@ -9,6 +9,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/experiments"
 )
 
 // validAssumeRoleDuration validates a string can be parsed as a valid time.Duration
@@ -37,3 +38,12 @@ var validAssumeRoleSourceIdentity = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
 )
+
+// validExperiment validates that a string is the name of a registered experiment.
+func validExperiment(v interface{}, k string) (ws []string, errors []error) {
+	if err := experiments.Validate(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	waitersAttrDelay        = "delay"
+	waitersAttrJitter       = "jitter"
+	waitersAttrPollInterval = "poll_interval"
+)
+
+// WaitersSchema returns the schema for the `waiters` configuration block, which
+// allows a resource's long-running waiters to be tuned per resource instance.
+func WaitersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				waitersAttrDelay: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidDuration,
+				},
+				waitersAttrJitter: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidDuration,
+				},
+				waitersAttrPollInterval: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidDuration,
+				},
+			},
+		},
+	}
+}
+
+// ExpandWaiterOptions converts a `waiters` configuration block into waiter options.
+// Any jitter is added to the initial delay, configured or the waiter's default, as a random duration between 0 and the configured value.
+// Options not configured are omitted so that each waiter's defaults apply.
+func ExpandWaiterOptions(tfList []interface{}) []tfresource.OptionsFunc {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	var optFns []tfresource.OptionsFunc
+
+	if v, ok := tfMap[waitersAttrDelay].(string); ok && v != "" {
+		if delay, _ := time.ParseDuration(v); delay > 0 {
+			optFns = append(optFns, tfresource.WithDelay(delay))
+		}
+	}
+
+	if v, ok := tfMap[waitersAttrJitter].(string); ok && v != "" {
+		if jitter, _ := time.ParseDuration(v); jitter > 0 {
+			optFns = append(optFns, tfresource.WithDelayJitter(jitter))
+		}
+	}
+
+	if v, ok := tfMap[waitersAttrPollInterval].(string); ok && v != "" {
+		if pollInterval, _ := time.ParseDuration(v); pollInterval > 0 {
+			optFns = append(optFns, tfresource.WithPollInterval(pollInterval))
+		}
+	}
+
+	return optFns
+}
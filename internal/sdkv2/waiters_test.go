@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestExpandWaiterOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input            []interface{}
+		wantCount        int
+		wantDelay        time.Duration
+		wantDelayJitter  time.Duration
+		wantPollInterval time.Duration
+	}{
+		"nil": {
+			input: nil,
+		},
+		"empty block": {
+			input: []interface{}{nil},
+		},
+		"unset": {
+			input: []interface{}{map[string]interface{}{
+				"delay":         "",
+				"jitter":        "",
+				"poll_interval": "",
+			}},
+		},
+		"delay and poll interval": {
+			input: []interface{}{map[string]interface{}{
+				"delay":         "1m",
+				"jitter":        "",
+				"poll_interval": "30s",
+			}},
+			wantCount:        2,
+			wantDelay:        1 * time.Minute,
+			wantPollInterval: 30 * time.Second,
+		},
+		"delay and jitter": {
+			input: []interface{}{map[string]interface{}{
+				"delay":         "1m",
+				"jitter":        "10s",
+				"poll_interval": "",
+			}},
+			wantCount:       2,
+			wantDelay:       1 * time.Minute,
+			wantDelayJitter: 10 * time.Second,
+		},
+		"jitter only": {
+			input: []interface{}{map[string]interface{}{
+				"delay":         "",
+				"jitter":        "10s",
+				"poll_interval": "",
+			}},
+			wantCount:       1,
+			wantDelayJitter: 10 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			optFns := ExpandWaiterOptions(testCase.input)
+
+			if got, want := len(optFns), testCase.wantCount; got != want {
+				t.Fatalf("got %d options, want %d", got, want)
+			}
+
+			var options tfresource.Options
+			for _, fn := range optFns {
+				fn(&options)
+			}
+
+			if got, want := options.Delay, testCase.wantDelay; got != want {
+				t.Errorf("got delay %s, want %s", got, want)
+			}
+
+			if got, want := options.DelayJitter, testCase.wantDelayJitter; got != want {
+				t.Errorf("got delay jitter %s, want %s", got, want)
+			}
+
+			if got, want := options.PollInterval, testCase.wantPollInterval; got != want {
+				t.Errorf("got poll interval %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// accountServicePrincipal is the service principal used to enable trusted access
+	// and delegated administration for the Account Management API.
+	accountServicePrincipal = "account.amazonaws.com"
+)
+
+// @SDKDataSource("aws_account_member_accounts", name="Member Accounts")
+func dataSourceMemberAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMemberAccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrEmail: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"caller_can_manage_contacts": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"caller_is_delegated_administrator": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"caller_is_management_account": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"management_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trusted_access_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMemberAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
+	callerAccountID := meta.(*conns.AWSClient).AccountID
+
+	org, err := tforganizations.FindOrganization(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organization: %s", err)
+	}
+
+	managementAccountID := aws.ToString(org.MasterAccountId)
+	isManagementAccount := callerAccountID == managementAccountID
+
+	isDelegatedAdministrator := false
+	if !isManagementAccount {
+		_, err := tforganizations.FindDelegatedAdministratorByTwoPartKey(ctx, conn, callerAccountID, accountServicePrincipal)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Organizations Delegated Administrator (%s): %s", callerAccountID, err)
+		default:
+			isDelegatedAdministrator = true
+		}
+	}
+
+	servicePrincipalNames, err := tforganizations.FindEnabledServicePrincipalNames(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organization enabled service principals: %s", err)
+	}
+
+	trustedAccessEnabled := slices.Contains(servicePrincipalNames, accountServicePrincipal)
+
+	accounts, err := tforganizations.FindAccounts(ctx, conn, &organizations.ListAccountsInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organization accounts: %s", err)
+	}
+
+	// Only active member accounts can have their contacts managed.
+	// The management account's own contacts are managed without an account ID.
+	var ids []string
+	var tfList []interface{}
+
+	for _, v := range accounts {
+		id := aws.ToString(v.Id)
+
+		if v.Status != orgtypes.AccountStatusActive || id == managementAccountID {
+			continue
+		}
+
+		ids = append(ids, id)
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:   aws.ToString(v.Arn),
+			names.AttrEmail: aws.ToString(v.Email),
+			names.AttrID:    id,
+			names.AttrName:  aws.ToString(v.Name),
+		})
+	}
+
+	d.SetId(aws.ToString(org.Id))
+	if err := d.Set("accounts", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting accounts: %s", err)
+	}
+	d.Set("caller_can_manage_contacts", trustedAccessEnabled && (isManagementAccount || isDelegatedAdministrator))
+	d.Set("caller_is_delegated_administrator", isDelegatedAdministrator)
+	d.Set("caller_is_management_account", isManagementAccount)
+	d.Set(names.AttrIDs, ids)
+	d.Set("management_account_id", managementAccountID)
+	d.Set("trusted_access_enabled", trustedAccessEnabled)
+
+	return diags
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccMemberAccountsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_account_member_accounts.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccountServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberAccountsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "caller_is_delegated_administrator", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "caller_is_management_account", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(dataSourceName, "caller_can_manage_contacts"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "management_account_id", "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "trusted_access_enabled"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMemberAccountsDataSourceConfig_basic = `
+data "aws_caller_identity" "current" {}
+
+data "aws_account_member_accounts" "test" {}
+`
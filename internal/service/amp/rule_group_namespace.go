@@ -39,8 +39,9 @@ func resourceRuleGroupNamespace() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"data": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validRuleGroupNamespaceData,
 			},
 			names.AttrName: {
 				Type:     schema.TypeString,
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package amp
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// alertManagerDefinition is the document accepted by CreateAlertManagerDefinition.
+// See https://docs.aws.amazon.com/prometheus/latest/userguide/AMP-alertmanager-config.html.
+type alertManagerDefinition struct {
+	AlertmanagerConfig string            `yaml:"alertmanager_config"`
+	TemplateFiles      map[string]string `yaml:"template_files,omitempty"`
+}
+
+// ruleGroupsNamespaceData is the Prometheus rules file accepted by CreateRuleGroupsNamespace.
+// See https://prometheus.io/docs/prometheus/latest/configuration/recording_rules/.
+type ruleGroupsNamespaceData struct {
+	Groups []ruleGroup `yaml:"groups"`
+}
+
+type ruleGroup struct {
+	Interval    string `yaml:"interval,omitempty"`
+	Limit       int    `yaml:"limit,omitempty"`
+	Name        string `yaml:"name"`
+	QueryOffset string `yaml:"query_offset,omitempty"`
+	Rules       []rule `yaml:"rules"`
+}
+
+type rule struct {
+	Alert         string            `yaml:"alert,omitempty"`
+	Annotations   map[string]string `yaml:"annotations,omitempty"`
+	Expr          string            `yaml:"expr"`
+	For           string            `yaml:"for,omitempty"`
+	KeepFiringFor string            `yaml:"keep_firing_for,omitempty"`
+	Labels        map[string]string `yaml:"labels,omitempty"`
+	Record        string            `yaml:"record,omitempty"`
+}
+
+func validAlertManagerDefinition(v interface{}, k string) (ws []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if err := validateAlertManagerDefinition(value); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid Alert Manager definition: %w", k, err))
+	}
+
+	return
+}
+
+func validateAlertManagerDefinition(s string) error {
+	var definition alertManagerDefinition
+
+	if err := yaml.UnmarshalStrict([]byte(s), &definition); err != nil {
+		return err
+	}
+
+	if definition.AlertmanagerConfig == "" {
+		return errors.New("alertmanager_config is required")
+	}
+
+	var config map[string]interface{}
+
+	if err := yaml.Unmarshal([]byte(definition.AlertmanagerConfig), &config); err != nil {
+		return fmt.Errorf("alertmanager_config: %w", err)
+	}
+
+	if _, ok := config["route"]; !ok {
+		return errors.New("alertmanager_config: route is required")
+	}
+
+	return nil
+}
+
+func validRuleGroupNamespaceData(v interface{}, k string) (ws []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if err := validateRuleGroupNamespaceData(value); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid rule groups namespace: %w", k, err))
+	}
+
+	return
+}
+
+func validateRuleGroupNamespaceData(s string) error {
+	var data ruleGroupsNamespaceData
+
+	if err := yaml.UnmarshalStrict([]byte(s), &data); err != nil {
+		return err
+	}
+
+	names := make(map[string]struct{})
+
+	for i, group := range data.Groups {
+		if group.Name == "" {
+			return fmt.Errorf("groups[%d]: name is required", i)
+		}
+
+		if _, ok := names[group.Name]; ok {
+			return fmt.Errorf("groups[%d]: duplicate group name %q", i, group.Name)
+		}
+		names[group.Name] = struct{}{}
+
+		for j, r := range group.Rules {
+			switch {
+			case r.Alert == "" && r.Record == "":
+				return fmt.Errorf("groups[%d].rules[%d]: one of alert or record is required", i, j)
+			case r.Alert != "" && r.Record != "":
+				return fmt.Errorf("groups[%d].rules[%d]: only one of alert or record may be set", i, j)
+			case r.Expr == "":
+				return fmt.Errorf("groups[%d].rules[%d]: expr is required", i, j)
+			case r.Record != "" && (r.For != "" || r.KeepFiringFor != "" || len(r.Annotations) > 0):
+				return fmt.Errorf("groups[%d].rules[%d]: for, keep_firing_for and annotations are only valid for alerting rules", i, j)
+			}
+		}
+	}
+
+	return nil
+}
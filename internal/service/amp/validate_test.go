@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package amp
+
+import (
+	"testing"
+)
+
+func TestValidAlertManagerDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value     string
+		wantError bool
+	}{
+		"valid": {
+			value: `
+alertmanager_config: |
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+		},
+		"valid with templates": {
+			value: `
+template_files:
+  default_template: |
+    {{ define "sns.default.message" }}{{ .CommonAnnotations.summary }}{{ end }}
+alertmanager_config: |
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+		},
+		"missing alertmanager_config": {
+			value: `
+template_files:
+  default_template: ""
+`,
+			wantError: true,
+		},
+		"unknown top-level key": {
+			value: `
+alertmanager_config: |
+  route:
+    receiver: 'default'
+route:
+  receiver: 'default'
+`,
+			wantError: true,
+		},
+		"missing route": {
+			value: `
+alertmanager_config: |
+  receivers:
+    - name: 'default'
+`,
+			wantError: true,
+		},
+		"invalid YAML": {
+			value:     "alertmanager_config: [",
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validAlertManagerDefinition(testCase.value, "definition")
+
+			if got, want := len(errs) > 0, testCase.wantError; got != want {
+				t.Errorf("validAlertManagerDefinition() errors = %v, want error %t", errs, want)
+			}
+		})
+	}
+}
+
+func TestValidRuleGroupNamespaceData(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value     string
+		wantError bool
+	}{
+		"valid": {
+			value: `
+groups:
+  - name: test
+    rules:
+    - record: metric:recording_rule
+      expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+  - name: alert-test
+    rules:
+    - alert: metric:alerting_rule
+      expr: avg(rate(container_cpu_usage_seconds_total[5m])) > 0
+      for: 2m
+      labels:
+        severity: warning
+      annotations:
+        summary: High CPU
+`,
+		},
+		"unknown top-level key": {
+			value: `
+rules:
+  - record: metric:recording_rule
+    expr: up
+`,
+			wantError: true,
+		},
+		"missing group name": {
+			value: `
+groups:
+  - rules:
+    - record: metric:recording_rule
+      expr: up
+`,
+			wantError: true,
+		},
+		"duplicate group name": {
+			value: `
+groups:
+  - name: test
+    rules:
+    - record: a
+      expr: up
+  - name: test
+    rules:
+    - record: b
+      expr: up
+`,
+			wantError: true,
+		},
+		"neither alert nor record": {
+			value: `
+groups:
+  - name: test
+    rules:
+    - expr: up
+`,
+			wantError: true,
+		},
+		"both alert and record": {
+			value: `
+groups:
+  - name: test
+    rules:
+    - alert: a
+      record: b
+      expr: up
+`,
+			wantError: true,
+		},
+		"missing expr": {
+			value: `
+groups:
+  - name: test
+    rules:
+    - record: a
+`,
+			wantError: true,
+		},
+		"for on recording rule": {
+			value: `
+groups:
+  - name: test
+    rules:
+    - record: a
+      expr: up
+      for: 5m
+`,
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validRuleGroupNamespaceData(testCase.value, "data")
+
+			if got, want := len(errs) > 0, testCase.wantError; got != want {
+				t.Errorf("validRuleGroupNamespaceData() errors = %v, want error %t", errs, want)
+			}
+		})
+	}
+}
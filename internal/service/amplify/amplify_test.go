@@ -45,9 +45,10 @@ func TestAccAmplify_serial(t *testing.T) {
 			"OptionalArguments":    testAccBranch_OptionalArguments,
 		},
 		"DomainAssociation": {
-			acctest.CtBasic:      testAccDomainAssociation_basic,
-			acctest.CtDisappears: testAccDomainAssociation_disappears,
-			"update":             testAccDomainAssociation_update,
+			acctest.CtBasic:       testAccDomainAssociation_basic,
+			acctest.CtDisappears:  testAccDomainAssociation_disappears,
+			"certificateSettings": testAccDomainAssociation_certificateSettings,
+			"update":              testAccDomainAssociation_update,
 		},
 		"Webhook": {
 			acctest.CtBasic:      testAccWebhook_basic,
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -48,6 +49,30 @@ func resourceDomainAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"certificate_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_verification_dns_record": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"custom_certificate_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.CertificateType](),
+						},
+					},
+				},
+			},
 			"certificate_verification_dns_record": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -112,6 +137,10 @@ func resourceDomainAssociationCreate(ctx context.Context, d *schema.ResourceData
 		SubDomainSettings:   expandSubDomainSettings(d.Get("sub_domain").(*schema.Set).List()),
 	}
 
+	if v, ok := d.GetOk("certificate_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CertificateSettings = expandCertificateSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.CreateDomainAssociation(ctx, input)
 
 	if err != nil {
@@ -156,6 +185,13 @@ func resourceDomainAssociationRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("app_id", appID)
 	d.Set(names.AttrARN, domainAssociation.DomainAssociationArn)
+	if domainAssociation.Certificate != nil {
+		if err := d.Set("certificate_settings", []interface{}{flattenCertificate(domainAssociation.Certificate)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting certificate_settings: %s", err)
+		}
+	} else {
+		d.Set("certificate_settings", nil)
+	}
 	d.Set("certificate_verification_dns_record", domainAssociation.CertificateVerificationDNSRecord)
 	d.Set(names.AttrDomainName, domainAssociation.DomainName)
 	d.Set("enable_auto_sub_domain", domainAssociation.EnableAutoSubDomain)
@@ -175,12 +211,18 @@ func resourceDomainAssociationUpdate(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChanges("enable_auto_sub_domain", "sub_domain") {
+	if d.HasChanges("certificate_settings", "enable_auto_sub_domain", "sub_domain") {
 		input := &amplify.UpdateDomainAssociationInput{
 			AppId:      aws.String(appID),
 			DomainName: aws.String(domainName),
 		}
 
+		if d.HasChange("certificate_settings") {
+			if v, ok := d.GetOk("certificate_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CertificateSettings = expandCertificateSettings(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("enable_auto_sub_domain") {
 			input.EnableAutoSubDomain = aws.Bool(d.Get("enable_auto_sub_domain").(bool))
 		}
@@ -424,3 +466,41 @@ func flattenSubDomains(apiObjects []types.SubDomain) []interface{} {
 
 	return tfList
 }
+
+func expandCertificateSettings(tfMap map[string]interface{}) *types.CertificateSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CertificateSettings{}
+
+	if v, ok := tfMap["custom_certificate_arn"].(string); ok && v != "" {
+		apiObject.CustomCertificateArn = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = types.CertificateType(v)
+	}
+
+	return apiObject
+}
+
+func flattenCertificate(apiObject *types.Certificate) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrType: string(apiObject.Type),
+	}
+
+	if v := apiObject.CertificateVerificationDNSRecord; v != nil {
+		tfMap["certificate_verification_dns_record"] = aws.ToString(v)
+	}
+
+	if v := apiObject.CustomCertificateArn; v != nil {
+		tfMap["custom_certificate_arn"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
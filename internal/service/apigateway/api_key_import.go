@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_api_gateway_api_key_import", name="API Key Import")
+func resourceAPIKeyImport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAPIKeyImportCreate,
+		ReadWithoutTimeout:   resourceAPIKeyImportRead,
+		DeleteWithoutTimeout: resourceAPIKeyImportDelete,
+
+		Schema: map[string]*schema.Schema{
+			"api_key_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"body": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"fail_on_warnings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			names.AttrFormat: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          string(types.ApiKeysFormatCsv),
+				ValidateDiagFunc: enum.Validate[types.ApiKeysFormat](),
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAPIKeyImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	input := &apigateway.ImportApiKeysInput{
+		Body:           []byte(d.Get("body").(string)),
+		FailOnWarnings: d.Get("fail_on_warnings").(bool),
+		Format:         types.ApiKeysFormat(d.Get(names.AttrFormat).(string)),
+	}
+
+	output, err := conn.ImportApiKeys(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "importing API Gateway API Keys: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+	d.Set("api_key_ids", output.Ids)
+	d.Set("warnings", output.Warnings)
+
+	for _, warning := range output.Warnings {
+		diags = sdkdiag.AppendWarningf(diags, "importing API Gateway API Keys: %s", warning)
+	}
+
+	return append(diags, resourceAPIKeyImportRead(ctx, d, meta)...)
+}
+
+func resourceAPIKeyImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	var ids []string
+
+	for _, keyID := range flex.ExpandStringValueList(d.Get("api_key_ids").([]interface{})) {
+		_, err := findAPIKeyByID(ctx, conn, keyID)
+
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] API Gateway API Key (%s) not found, removing from API Key Import (%s)", keyID, d.Id())
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading API Gateway API Key (%s): %s", keyID, err)
+		}
+
+		ids = append(ids, keyID)
+	}
+
+	if !d.IsNewResource() && len(ids) == 0 {
+		log.Printf("[WARN] API Gateway API Key Import (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("api_key_ids", ids)
+
+	return diags
+}
+
+func resourceAPIKeyImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	for _, keyID := range flex.ExpandStringValueList(d.Get("api_key_ids").([]interface{})) {
+		log.Printf("[DEBUG] Deleting API Gateway API Key: %s", keyID)
+		_, err := conn.DeleteApiKey(ctx, &apigateway.DeleteApiKeyInput{
+			ApiKey: aws.String(keyID),
+		})
+
+		if errs.IsA[*types.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting API Gateway API Key (%s): %s", keyID, err)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapigateway "github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayAPIKeyImport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_api_key_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIKeyImportDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyImportConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIKeyImportExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "api_key_ids.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_warnings", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "csv"),
+					resource.TestCheckResourceAttr(resourceName, "warnings.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayAPIKeyImport_usagePlan(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_api_key_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIKeyImportDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyImportConfig_usagePlan(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIKeyImportExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "api_key_ids.#", acctest.Ct2),
+					testAccCheckUsagePlanKeysExclusiveCount(ctx, "aws_api_gateway_usage_plan.test", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAPIKeyImportDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_api_gateway_api_key_import" {
+				continue
+			}
+
+			for _, keyID := range testAccAPIKeyImportKeyIDs(rs) {
+				_, err := tfapigateway.FindAPIKeyByID(ctx, conn, keyID)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("API Gateway API Key %s still exists", keyID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAPIKeyImportExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		for _, keyID := range testAccAPIKeyImportKeyIDs(rs) {
+			if _, err := tfapigateway.FindAPIKeyByID(ctx, conn, keyID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAPIKeyImportKeyIDs(rs *terraform.ResourceState) []string {
+	n, _ := strconv.Atoi(rs.Primary.Attributes["api_key_ids.#"])
+	keyIDs := make([]string, 0, n)
+
+	for i := 0; i < n; i++ {
+		keyIDs = append(keyIDs, rs.Primary.Attributes[fmt.Sprintf("api_key_ids.%d", i)])
+	}
+
+	return keyIDs
+}
+
+func testAccAPIKeyImportConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_api_key_import" "test" {
+  fail_on_warnings = true
+
+  body = <<-CSV
+    name,key,description,enabled
+    %[1]s-1,%[1]s-key-1-abcdefghijklmnop,test,true
+    %[1]s-2,%[1]s-key-2-abcdefghijklmnop,test,false
+  CSV
+}
+`, rName)
+}
+
+func testAccAPIKeyImportConfig_usagePlan(rName string) string {
+	return acctest.ConfigCompose(testAccUsagePlanKeyBaseConfig(rName), fmt.Sprintf(`
+resource "aws_api_gateway_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.test.stage_name
+  }
+}
+
+resource "aws_api_gateway_api_key_import" "test" {
+  body = <<-CSV
+    name,key,enabled,usageplanIds
+    %[1]s-1,%[1]s-key-1-abcdefghijklmnop,true,${aws_api_gateway_usage_plan.test.id}
+    %[1]s-2,%[1]s-key-2-abcdefghijklmnop,true,${aws_api_gateway_usage_plan.test.id}
+  CSV
+}
+`, rName))
+}
@@ -5,30 +5,32 @@ package apigateway
 
 // Exports for use in tests only.
 var (
-	ResourceAccount              = resourceAccount
-	ResourceAPIKey               = resourceAPIKey
-	ResourceAuthorizer           = resourceAuthorizer
-	ResourceBasePathMapping      = resourceBasePathMapping
-	ResourceClientCertificate    = resourceClientCertificate
-	ResourceDeployment           = resourceDeployment
-	ResourceDocumentationPart    = resourceDocumentationPart
-	ResourceDocumentationVersion = resourceDocumentationVersion
-	ResourceDomainName           = resourceDomainName
-	ResourceGatewayResponse      = resourceGatewayResponse
-	ResourceIntegration          = resourceIntegration
-	ResourceIntegrationResponse  = resourceIntegrationResponse
-	ResourceMethod               = resourceMethod
-	ResourceMethodResponse       = resourceMethodResponse
-	ResourceMethodSettings       = resourceMethodSettings
-	ResourceModel                = resourceModel
-	ResourceRequestValidator     = resourceRequestValidator
-	ResourceResource             = resourceResource
-	ResourceRestAPI              = resourceRestAPI
-	ResourceRestAPIPolicy        = resourceRestAPIPolicy
-	ResourceStage                = resourceStage
-	ResourceUsagePlan            = resourceUsagePlan
-	ResourceUsagePlanKey         = resourceUsagePlanKey
-	ResourceVPCLink              = resourceVPCLink
+	ResourceAccount                = resourceAccount
+	ResourceAPIKey                 = resourceAPIKey
+	ResourceAPIKeyImport           = resourceAPIKeyImport
+	ResourceAuthorizer             = resourceAuthorizer
+	ResourceBasePathMapping        = resourceBasePathMapping
+	ResourceClientCertificate      = resourceClientCertificate
+	ResourceDeployment             = resourceDeployment
+	ResourceDocumentationPart      = resourceDocumentationPart
+	ResourceDocumentationVersion   = resourceDocumentationVersion
+	ResourceDomainName             = resourceDomainName
+	ResourceGatewayResponse        = resourceGatewayResponse
+	ResourceIntegration            = resourceIntegration
+	ResourceIntegrationResponse    = resourceIntegrationResponse
+	ResourceMethod                 = resourceMethod
+	ResourceMethodResponse         = resourceMethodResponse
+	ResourceMethodSettings         = resourceMethodSettings
+	ResourceModel                  = resourceModel
+	ResourceRequestValidator       = resourceRequestValidator
+	ResourceResource               = resourceResource
+	ResourceRestAPI                = resourceRestAPI
+	ResourceRestAPIPolicy          = resourceRestAPIPolicy
+	ResourceStage                  = resourceStage
+	ResourceUsagePlan              = resourceUsagePlan
+	ResourceUsagePlanKey           = resourceUsagePlanKey
+	ResourceUsagePlanKeysExclusive = resourceUsagePlanKeysExclusive
+	ResourceVPCLink                = resourceVPCLink
 
 	DefaultAuthorizerTTL                 = defaultAuthorizerTTL
 	FindAPIKeyByID                       = findAPIKeyByID
@@ -52,5 +54,6 @@ var (
 	FindStageByTwoPartKey                = findStageByTwoPartKey
 	FindUsagePlanByID                    = findUsagePlanByID
 	FindUsagePlanKeyByTwoPartKey         = findUsagePlanKeyByTwoPartKey
+	FindUsagePlanKeysByUsagePlanID       = findUsagePlanKeysByUsagePlanID
 	FindVPCLinkByID                      = findVPCLinkByID
 )
@@ -89,6 +89,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceAPIKeyImport,
+			TypeName: "aws_api_gateway_api_key_import",
+			Name:     "API Key Import",
+		},
 		{
 			Factory:  resourceAuthorizer,
 			TypeName: "aws_api_gateway_authorizer",
@@ -209,6 +214,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_api_gateway_usage_plan_key",
 			Name:     "Usage Plan Key",
 		},
+		{
+			Factory:  resourceUsagePlanKeysExclusive,
+			TypeName: "aws_api_gateway_usage_plan_keys_exclusive",
+			Name:     "Usage Plan Keys Exclusive",
+		},
 		{
 			Factory:  resourceVPCLink,
 			TypeName: "aws_api_gateway_vpc_link",
@@ -9,13 +9,16 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -65,8 +68,9 @@ func resourceUsagePlan() *schema.Resource {
 										Optional: true,
 									},
 									names.AttrPath: {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(regexache.MustCompile(`^(\*|/\S*)/(\*|ANY|DELETE|GET|HEAD|OPTIONS|PATCH|POST|PUT)$`), "must be in the form {resourcePath}/{httpMethod}"),
 									},
 									"rate_limit": {
 										Type:     schema.TypeFloat,
@@ -143,10 +147,42 @@ func resourceUsagePlan() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceUsagePlanCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceUsagePlanCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Each method can only be throttled once per API stage.
+	for _, tfMapRaw := range d.Get("api_stages").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		paths := make(map[string]struct{})
+
+		for _, v := range tfMap["throttle"].(*schema.Set).List() {
+			path := v.(map[string]interface{})[names.AttrPath].(string)
+
+			// Skip values not known until apply.
+			if path == "" {
+				continue
+			}
+
+			if _, ok := paths[path]; ok {
+				return fmt.Errorf("throttle path %q is configured more than once for the same API stage", path)
+			}
+
+			paths[path] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
 func resourceUsagePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
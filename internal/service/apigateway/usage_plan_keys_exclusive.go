@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	usagePlanKeyTypeAPIKey = "API_KEY"
+)
+
+// @SDKResource("aws_api_gateway_usage_plan_keys_exclusive", name="Usage Plan Keys Exclusive")
+func resourceUsagePlanKeysExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsagePlanKeysExclusiveCreate,
+		ReadWithoutTimeout:   resourceUsagePlanKeysExclusiveRead,
+		UpdateWithoutTimeout: resourceUsagePlanKeysExclusiveUpdate,
+		DeleteWithoutTimeout: schema.NoopContext, // Removing the resource leaves the usage plan's API keys associated.
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("usage_plan_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"usage_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUsagePlanKeysExclusiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	usagePlanID := d.Get("usage_plan_id").(string)
+
+	if err := syncUsagePlanKeys(ctx, conn, usagePlanID, flex.ExpandStringValueSet(d.Get("key_ids").(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating API Gateway Usage Plan Keys Exclusive (%s): %s", usagePlanID, err)
+	}
+
+	d.SetId(usagePlanID)
+
+	return append(diags, resourceUsagePlanKeysExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeysExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	keys, err := findUsagePlanKeysByUsagePlanID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] API Gateway Usage Plan Keys Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway Usage Plan Keys Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("key_ids", tfslices.ApplyToAll(keys, func(v types.UsagePlanKey) string {
+		return aws.ToString(v.Id)
+	}))
+	d.Set("usage_plan_id", d.Id())
+
+	return diags
+}
+
+func resourceUsagePlanKeysExclusiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	if d.HasChange("key_ids") {
+		if err := syncUsagePlanKeys(ctx, conn, d.Id(), flex.ExpandStringValueSet(d.Get("key_ids").(*schema.Set))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway Usage Plan Keys Exclusive (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUsagePlanKeysExclusiveRead(ctx, d, meta)...)
+}
+
+// syncUsagePlanKeys associates the specified API keys with the usage plan and disassociates any others.
+func syncUsagePlanKeys(ctx context.Context, conn *apigateway.Client, usagePlanID string, keyIDs []string) error {
+	keys, err := findUsagePlanKeysByUsagePlanID(ctx, conn, usagePlanID)
+
+	if err != nil {
+		return err
+	}
+
+	have := make(map[string]struct{}, len(keys))
+	for _, v := range keys {
+		have[aws.ToString(v.Id)] = struct{}{}
+	}
+
+	want := make(map[string]struct{}, len(keyIDs))
+	for _, v := range keyIDs {
+		want[v] = struct{}{}
+	}
+
+	for keyID := range have {
+		if _, ok := want[keyID]; ok {
+			continue
+		}
+
+		_, err := conn.DeleteUsagePlanKey(ctx, &apigateway.DeleteUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			UsagePlanId: aws.String(usagePlanID),
+		})
+
+		if errs.IsA[*types.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("disassociating API key (%s): %w", keyID, err)
+		}
+	}
+
+	for keyID := range want {
+		if _, ok := have[keyID]; ok {
+			continue
+		}
+
+		_, err := conn.CreateUsagePlanKey(ctx, &apigateway.CreateUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			KeyType:     aws.String(usagePlanKeyTypeAPIKey),
+			UsagePlanId: aws.String(usagePlanID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("associating API key (%s): %w", keyID, err)
+		}
+	}
+
+	return nil
+}
+
+func findUsagePlanKeysByUsagePlanID(ctx context.Context, conn *apigateway.Client, usagePlanID string) ([]types.UsagePlanKey, error) {
+	input := &apigateway.GetUsagePlanKeysInput{
+		UsagePlanId: aws.String(usagePlanID),
+	}
+	var output []types.UsagePlanKey
+
+	pages := apigateway.NewGetUsagePlanKeysPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapigateway "github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayUsagePlanKeysExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan_keys_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeysExclusiveConfig_basic(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsagePlanKeysExclusiveCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", acctest.Ct3),
+					resource.TestCheckResourceAttrPair(resourceName, "usage_plan_id", "aws_api_gateway_usage_plan.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUsagePlanKeysExclusiveConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsagePlanKeysExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccUsagePlanKeysExclusiveConfig_basic(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsagePlanKeysExclusiveCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayUsagePlanKeysExclusive_removeFromState(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan_keys_exclusive.test"
+	usagePlanResourceName := "aws_api_gateway_usage_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeysExclusiveConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsagePlanKeysExclusiveCount(ctx, resourceName, 2),
+				),
+			},
+			{
+				Config: testAccUsagePlanKeysExclusiveConfig_base(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Destroying the resource leaves the API keys associated.
+					testAccCheckUsagePlanKeysExclusiveCount(ctx, usagePlanResourceName, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayUsagePlanKeysExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan_keys_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeysExclusiveConfig_outOfBandAddition(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsagePlanKeysExclusiveCount(ctx, resourceName, 2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccUsagePlanKeysExclusiveConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsagePlanKeysExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUsagePlanKeysExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		output, err := tfapigateway.FindUsagePlanKeysByUsagePlanID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("API Gateway Usage Plan %s has %d keys, expected %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccUsagePlanKeysExclusiveConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccUsagePlanKeyBaseConfig(rName), fmt.Sprintf(`
+resource "aws_api_gateway_api_key" "test" {
+  count = 3
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_api_gateway_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.test.stage_name
+  }
+}
+`, rName))
+}
+
+func testAccUsagePlanKeysExclusiveConfig_basic(rName string, n int) string {
+	return acctest.ConfigCompose(testAccUsagePlanKeysExclusiveConfig_base(rName), fmt.Sprintf(`
+resource "aws_api_gateway_usage_plan_keys_exclusive" "test" {
+  usage_plan_id = aws_api_gateway_usage_plan.test.id
+  key_ids       = slice(aws_api_gateway_api_key.test[*].id, 0, %[1]d)
+}
+`, n))
+}
+
+func testAccUsagePlanKeysExclusiveConfig_outOfBandAddition(rName string) string {
+	return acctest.ConfigCompose(testAccUsagePlanKeysExclusiveConfig_base(rName), `
+resource "aws_api_gateway_usage_plan_keys_exclusive" "test" {
+  usage_plan_id = aws_api_gateway_usage_plan.test.id
+  key_ids       = [aws_api_gateway_api_key.test[0].id]
+}
+
+resource "aws_api_gateway_usage_plan_key" "test" {
+  key_id        = aws_api_gateway_api_key.test[1].id
+  key_type      = "API_KEY"
+  usage_plan_id = aws_api_gateway_usage_plan.test.id
+
+  depends_on = [aws_api_gateway_usage_plan_keys_exclusive.test]
+}
+`)
+}
@@ -485,6 +485,28 @@ func TestAccAPIGatewayUsagePlan_APIStages_throttle(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayUsagePlan_APIStages_throttleInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsagePlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUsagePlanConfig_apiStagesThrottlePath(rName, "test/GET"),
+				ExpectError: regexache.MustCompile(`must be in the form {resourcePath}/{httpMethod}`),
+			},
+			{
+				Config:      testAccUsagePlanConfig_apiStagesThrottleDuplicate(rName),
+				ExpectError: regexache.MustCompile(`throttle path "/test/GET" is configured more than once`),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayUsagePlan_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf apigateway.GetUsagePlanOutput
@@ -776,6 +798,50 @@ resource "aws_api_gateway_usage_plan" "test" {
 `, rName))
 }
 
+func testAccUsagePlanConfig_apiStagesThrottlePath(rName, path string) string {
+	return acctest.ConfigCompose(testAccUsagePlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_api_gateway_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.test.stage_name
+
+    throttle {
+      path        = %[2]q
+      burst_limit = 3
+      rate_limit  = 6
+    }
+  }
+}
+`, rName, path))
+}
+
+func testAccUsagePlanConfig_apiStagesThrottleDuplicate(rName string) string {
+	return acctest.ConfigCompose(testAccUsagePlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_api_gateway_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.test.stage_name
+
+    throttle {
+      path        = "/test/GET"
+      burst_limit = 3
+      rate_limit  = 6
+    }
+
+    throttle {
+      path        = "/test/GET"
+      burst_limit = 5
+      rate_limit  = 10
+    }
+  }
+}
+`, rName))
+}
+
 func testAccUsagePlanConfig_apiStagesModified(rName string) string {
 	return acctest.ConfigCompose(testAccUsagePlanConfig_base(rName), fmt.Sprintf(`
 resource "aws_api_gateway_usage_plan" "test" {
@@ -5,9 +5,10 @@ package apigatewayv2
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -67,6 +68,11 @@ func resourceAPI() *schema.Resource {
 				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
 				ValidateFunc:     verify.ValidStringIsJSONOrYAML,
 			},
+			"body_reconciliation_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(bodyReconciliationMode_Values(), false),
+			},
 			"cors_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -131,6 +137,26 @@ func resourceAPI() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"imported_routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrTarget: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -225,10 +251,10 @@ func resourceAPICreate(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	d.SetId(aws.ToString(output.ApiId))
 
-	err = reimportOpenAPIDefinition(ctx, d, meta)
+	diags = append(diags, reimportOpenAPIDefinition(ctx, d, meta)...)
 
-	if err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if diags.HasError() {
+		return diags
 	}
 
 	return append(diags, resourceAPIRead(ctx, d, meta)...)
@@ -264,6 +290,36 @@ func resourceAPIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("route_selection_expression", output.RouteSelectionExpression)
 	d.Set(names.AttrVersion, output.Version)
 
+	// Report which of the API's routes are defined by body, so that they can be told apart
+	// from routes managed by aws_apigatewayv2_route.
+	var importedRoutes []interface{}
+	if v, ok := d.GetOk("body"); ok {
+		routeKeys, err := openAPIRouteKeys(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing API Gateway v2 API (%s) OpenAPI definition: %s", d.Id(), err)
+		}
+
+		routes, err := findRoutesByAPIID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 API (%s) routes: %s", d.Id(), err)
+		}
+
+		for _, route := range routes {
+			if slices.Contains(routeKeys, aws.ToString(route.RouteKey)) {
+				importedRoutes = append(importedRoutes, map[string]interface{}{
+					"route_id":       aws.ToString(route.RouteId),
+					"route_key":      aws.ToString(route.RouteKey),
+					names.AttrTarget: aws.ToString(route.Target),
+				})
+			}
+		}
+	}
+	if err := d.Set("imported_routes", importedRoutes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting imported_routes: %s", err)
+	}
+
 	setTagsOut(ctx, output.Tags)
 
 	return diags
@@ -330,10 +386,10 @@ func resourceAPIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	if d.HasChange("body") {
-		err := reimportOpenAPIDefinition(ctx, d, meta)
+		diags = append(diags, reimportOpenAPIDefinition(ctx, d, meta)...)
 
-		if err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
+		if diags.HasError() {
+			return diags
 		}
 	}
 
@@ -360,29 +416,91 @@ func resourceAPIDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	return diags
 }
 
-func reimportOpenAPIDefinition(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+func reimportOpenAPIDefinition(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayV2Client(ctx)
 
-	if body, ok := d.GetOk("body"); ok {
+	if v, ok := d.GetOk("body"); ok {
+		body := v.(string)
+		mode := d.Get("body_reconciliation_mode").(string)
+
+		routeKeys, err := openAPIRouteKeys(body)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing API Gateway v2 API (%s) OpenAPI definition: %s", d.Id(), err)
+		}
+
+		routesBefore, err := findRoutesByAPIID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 API (%s) routes: %s", d.Id(), err)
+		}
+
+		// A reimport replaces the whole API definition. To merge, add the operations of
+		// the current definition that body does not define before reimporting.
+		if mode == bodyReconciliationModeMerge && len(routesBefore) > 0 {
+			export, err := conn.ExportApi(ctx, &apigatewayv2.ExportApiInput{
+				ApiId:             aws.String(d.Id()),
+				IncludeExtensions: aws.Bool(true),
+				OutputType:        aws.String("JSON"),
+				Specification:     aws.String("OAS30"),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "exporting API Gateway v2 API (%s): %s", d.Id(), err)
+			}
+
+			body, err = mergeOpenAPIDefinitions(body, string(export.Body))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "merging API Gateway v2 API (%s) OpenAPI definitions: %s", d.Id(), err)
+			}
+		}
+
 		inputR := &apigatewayv2.ReimportApiInput{
 			ApiId: aws.String(d.Id()),
-			Body:  aws.String(body.(string)),
+			Body:  aws.String(body),
 		}
 
 		if value, ok := d.GetOk("fail_on_warnings"); ok {
 			inputR.FailOnWarnings = aws.Bool(value.(bool))
 		}
 
-		_, err := conn.ReimportApi(ctx, inputR)
+		_, err = conn.ReimportApi(ctx, inputR)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reimporting API Gateway v2 API (%s) OpenAPI definition: %s", d.Id(), err)
+		}
+
+		routesAfter, err := findRoutesByAPIID(ctx, conn, d.Id())
 
 		if err != nil {
-			return fmt.Errorf("reimporting API Gateway v2 API (%s) OpenAPI definition: %w", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 API (%s) routes: %s", d.Id(), err)
+		}
+
+		var removedRouteKeys []string
+		for _, route := range routesBefore {
+			routeKey := aws.ToString(route.RouteKey)
+
+			if slices.Contains(routeKeys, routeKey) {
+				continue
+			}
+
+			if !slices.ContainsFunc(routesAfter, func(v awstypes.Route) bool { return aws.ToString(v.RouteKey) == routeKey }) {
+				removedRouteKeys = append(removedRouteKeys, routeKey)
+			}
+		}
+
+		if len(removedRouteKeys) > 0 {
+			diags = sdkdiag.AppendWarningf(diags, "reimporting API Gateway v2 API (%s) OpenAPI definition removed routes not defined in body: %s. "+
+				"Set body_reconciliation_mode to %q to keep routes managed outside of body, such as by aws_apigatewayv2_route.",
+				d.Id(), strings.Join(removedRouteKeys, ", "), bodyReconciliationModeMerge)
 		}
 
 		corsConfiguration := d.Get("cors_configuration")
 
 		if diags := resourceAPIRead(ctx, d, meta); diags.HasError() {
-			return sdkdiag.DiagnosticsError(diags)
+			return diags
 		}
 
 		inputU := &apigatewayv2.UpdateApiInput{
@@ -399,7 +517,7 @@ func reimportOpenAPIDefinition(ctx context.Context, d *schema.ResourceData, meta
 				})
 
 				if err != nil {
-					return fmt.Errorf("deleting API Gateway v2 API (%s) CORS configuration: %w", d.Id(), err)
+					return sdkdiag.AppendErrorf(diags, "deleting API Gateway v2 API (%s) CORS configuration: %s", d.Id(), err)
 				}
 			} else {
 				inputU.CorsConfiguration = expandCORSConfiguration(corsConfiguration.([]interface{}))
@@ -407,17 +525,17 @@ func reimportOpenAPIDefinition(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if err := updateTags(ctx, conn, d.Get(names.AttrARN).(string), d.Get(names.AttrTagsAll), KeyValueTags(ctx, getTagsIn(ctx))); err != nil {
-			return fmt.Errorf("updating API Gateway v2 API (%s) tags: %w", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway v2 API (%s) tags: %s", d.Id(), err)
 		}
 
 		_, err = conn.UpdateApi(ctx, inputU)
 
 		if err != nil {
-			return fmt.Errorf("updating API Gateway v2 API (%s): %w", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway v2 API (%s): %s", d.Id(), err)
 		}
 	}
 
-	return nil
+	return diags
 }
 
 func findAPIByID(ctx context.Context, conn *apigatewayv2.Client, id string) (*apigatewayv2.GetApiOutput, error) {
@@ -449,6 +567,38 @@ func findAPI(ctx context.Context, conn *apigatewayv2.Client, input *apigatewayv2
 	return output, nil
 }
 
+func findRoutesByAPIID(ctx context.Context, conn *apigatewayv2.Client, apiID string) ([]awstypes.Route, error) {
+	input := &apigatewayv2.GetRoutesInput{
+		ApiId: aws.String(apiID),
+	}
+	var output []awstypes.Route
+
+	for {
+		page, err := conn.GetRoutes(ctx, input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
 func expandCORSConfiguration(vConfiguration []interface{}) *awstypes.Cors {
 	configuration := &awstypes.Cors{}
 
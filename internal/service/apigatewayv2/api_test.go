@@ -321,7 +321,7 @@ func TestAccAPIGatewayV2API_openAPI(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body"},
+				ImportStateVerifyIgnore: []string{"body", "imported_routes"},
 			},
 			{
 				Config: testAccAPIConfig_updatedOpenYAML(rName),
@@ -365,7 +365,7 @@ func TestAccAPIGatewayV2API_OpenAPI_withTags(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body"},
+				ImportStateVerifyIgnore: []string{"body", "imported_routes"},
 			},
 			{
 				Config: testAccAPIConfig_openYAMLTagsUpdated(rName),
@@ -411,7 +411,7 @@ func TestAccAPIGatewayV2API_OpenAPI_withCors(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "cors_configuration.0.allow_methods"},
+				ImportStateVerifyIgnore: []string{"body", "imported_routes", "cors_configuration.0.allow_methods"},
 			},
 			{
 				Config: testAccAPIConfig_openYAMLCorsConfigurationUpdated(rName),
@@ -473,7 +473,7 @@ func TestAccAPIGatewayV2API_OpenAPI_withMoreFields(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body"},
+				ImportStateVerifyIgnore: []string{"body", "imported_routes"},
 			},
 			{
 				Config: testAccAPIConfig_updatedOpen2(rName),
@@ -493,7 +493,7 @@ func TestAccAPIGatewayV2API_OpenAPI_withMoreFields(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body"},
+				ImportStateVerifyIgnore: []string{"body", "imported_routes"},
 			},
 		},
 	})
@@ -532,7 +532,7 @@ func TestAccAPIGatewayV2API_OpenAPI_failOnWarnings(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "fail_on_warnings"},
+				ImportStateVerifyIgnore: []string{"body", "imported_routes", "fail_on_warnings"},
 			},
 			// fail_on_warnings should be optional and false by default
 			{
@@ -548,7 +548,49 @@ func TestAccAPIGatewayV2API_OpenAPI_failOnWarnings(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "fail_on_warnings"},
+				ImportStateVerifyIgnore: []string{"body", "imported_routes", "fail_on_warnings"},
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayV2API_OpenAPI_mergeMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v apigatewayv2.GetApiOutput
+	resourceName := "aws_apigatewayv2_api.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIConfig_openReconciliationMode(rName, "/test", "merge"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "body_reconciliation_mode", "merge"),
+					resource.TestCheckResourceAttr(resourceName, "imported_routes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "imported_routes.0.route_key", "GET /test"),
+					resource.TestCheckResourceAttrSet(resourceName, "imported_routes.0.route_id"),
+					resource.TestMatchResourceAttr(resourceName, "imported_routes.0.target", regexache.MustCompile(`^integrations/`)),
+				),
+			},
+			{
+				Config: testAccAPIConfig_openReconciliationMode(rName, "/update", "merge"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "imported_routes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "imported_routes.0.route_key", "GET /update"),
+					testAccCheckAPIRoutes(ctx, &v, []string{"GET /managed", "GET /test", "GET /update"}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body", "body_reconciliation_mode", "imported_routes"},
 			},
 		},
 	})
@@ -1200,6 +1242,44 @@ EOF
 `, rName)
 }
 
+func testAccAPIConfig_openReconciliationMode(rName, path, mode string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name                     = %[1]q
+  protocol_type            = "HTTP"
+  body_reconciliation_mode = %[3]q
+  body                     = <<EOF
+---
+openapi: 3.0.1
+info:
+  title: %[1]s
+  version: 1.0
+paths:
+  "%[2]s":
+    get:
+      x-amazon-apigateway-integration:
+        type: HTTP_PROXY
+        httpMethod: GET
+        payloadFormatVersion: '1.0'
+        uri: https://www.google.de
+EOF
+}
+
+resource "aws_apigatewayv2_integration" "test" {
+  api_id             = aws_apigatewayv2_api.test.id
+  integration_type   = "HTTP_PROXY"
+  integration_method = "GET"
+  integration_uri    = "https://www.example.com"
+}
+
+resource "aws_apigatewayv2_route" "test" {
+  api_id    = aws_apigatewayv2_api.test.id
+  route_key = "GET /managed"
+  target    = "integrations/${aws_apigatewayv2_integration.test.id}"
+}
+`, rName, path, mode)
+}
+
 func testAccAPIConfig_updatedOpen2(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_apigatewayv2_api" "test" {
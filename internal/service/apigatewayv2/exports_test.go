@@ -30,4 +30,7 @@ var (
 	FindRouteResponseByThreePartKey       = findRouteResponseByThreePartKey
 	FindStageByTwoPartKey                 = findStageByTwoPartKey
 	FindVPCLinkByID                       = findVPCLinkByID
+
+	MergeOpenAPIDefinitions = mergeOpenAPIDefinitions
+	OpenAPIRouteKeys        = openAPIRouteKeys
 )
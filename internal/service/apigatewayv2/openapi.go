@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	bodyReconciliationModeMerge     = "merge"
+	bodyReconciliationModeOverwrite = "overwrite"
+)
+
+func bodyReconciliationMode_Values() []string {
+	return []string{
+		bodyReconciliationModeMerge,
+		bodyReconciliationModeOverwrite,
+	}
+}
+
+const (
+	openAPIAnyMethod    = "x-amazon-apigateway-any-method"
+	openAPIDefaultRoute = "$default"
+)
+
+var openAPIMethods = []string{
+	"delete",
+	"get",
+	"head",
+	"options",
+	"patch",
+	"post",
+	"put",
+	"trace",
+}
+
+// parseOpenAPIDefinition parses a JSON or YAML OpenAPI definition.
+func parseOpenAPIDefinition(body string) (map[string]interface{}, error) {
+	var v interface{}
+
+	if err := yaml.Unmarshal([]byte(body), &v); err != nil {
+		return nil, err
+	}
+
+	m, ok := normalizeYAMLValue(v).(map[string]interface{})
+
+	if !ok {
+		return nil, fmt.Errorf("OpenAPI definition is not an object")
+	}
+
+	return m, nil
+}
+
+// normalizeYAMLValue converts the map[interface{}]interface{} values produced by yaml.v2
+// into map[string]interface{} so that the result can be marshaled as JSON.
+func normalizeYAMLValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, v := range v {
+			m[fmt.Sprint(k)] = normalizeYAMLValue(v)
+		}
+		return m
+	case []interface{}:
+		for i, e := range v {
+			v[i] = normalizeYAMLValue(e)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// openAPIRouteKeys returns the API Gateway route keys defined by an OpenAPI definition's paths.
+func openAPIRouteKeys(body string) ([]string, error) {
+	doc, err := parseOpenAPIDefinition(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	paths, _ := doc["paths"].(map[string]interface{})
+	var routeKeys []string
+
+	for path, v := range paths {
+		operations, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for method := range operations {
+			switch {
+			case method == openAPIAnyMethod && path == openAPIDefaultRoute:
+				routeKeys = append(routeKeys, openAPIDefaultRoute)
+			case method == openAPIAnyMethod:
+				routeKeys = append(routeKeys, "ANY "+path)
+			case slices.Contains(openAPIMethods, strings.ToLower(method)):
+				routeKeys = append(routeKeys, strings.ToUpper(method)+" "+path)
+			}
+		}
+	}
+
+	slices.Sort(routeKeys)
+
+	return routeKeys, nil
+}
+
+// mergeOpenAPIDefinitions adds the operations and components of the existing definition
+// that are not present in body, so that reimporting the result does not remove them.
+// Operations and components defined in body take precedence.
+func mergeOpenAPIDefinitions(body, existing string) (string, error) {
+	doc, err := parseOpenAPIDefinition(body)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing body: %w", err)
+	}
+
+	existingDoc, err := parseOpenAPIDefinition(existing)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing existing definition: %w", err)
+	}
+
+	paths, _ := doc["paths"].(map[string]interface{})
+	if paths == nil {
+		paths = make(map[string]interface{})
+	}
+
+	existingPaths, _ := existingDoc["paths"].(map[string]interface{})
+
+	for path, v := range existingPaths {
+		existingOperations, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		operations, ok := paths[path].(map[string]interface{})
+
+		if !ok {
+			paths[path] = existingOperations
+			continue
+		}
+
+		for method, operation := range existingOperations {
+			if _, ok := operations[method]; !ok {
+				operations[method] = operation
+			}
+		}
+	}
+
+	doc["paths"] = paths
+
+	if existingComponents, ok := existingDoc["components"].(map[string]interface{}); ok {
+		components, _ := doc["components"].(map[string]interface{})
+		if components == nil {
+			components = make(map[string]interface{})
+		}
+
+		for section, v := range existingComponents {
+			existingItems, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			items, ok := components[section].(map[string]interface{})
+
+			if !ok {
+				components[section] = existingItems
+				continue
+			}
+
+			for name, item := range existingItems {
+				if _, ok := items[name]; !ok {
+					items[name] = item
+				}
+			}
+		}
+
+		doc["components"] = components
+	}
+
+	output, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfapigatewayv2 "github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
+)
+
+func TestOpenAPIRouteKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name: "JSON",
+			body: `{
+  "openapi": "3.0.1",
+  "paths": {
+    "/pets": {
+      "get": {},
+      "post": {},
+      "parameters": []
+    }
+  }
+}`,
+			expected: []string{"GET /pets", "POST /pets"},
+		},
+		{
+			name: "YAML",
+			body: `
+openapi: 3.0.1
+paths:
+  "/pets/{id}":
+    x-amazon-apigateway-any-method: {}
+  "$default":
+    x-amazon-apigateway-any-method: {}
+`,
+			expected: []string{"$default", "ANY /pets/{id}"},
+		},
+		{
+			name:     "no paths",
+			body:     `openapi: 3.0.1`,
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfapigatewayv2.OpenAPIRouteKeys(testCase.body)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeOpenAPIDefinitions(t *testing.T) {
+	t.Parallel()
+
+	body := `
+openapi: 3.0.1
+info:
+  title: new
+paths:
+  "/pets":
+    get:
+      operationId: newGetPets
+`
+	existing := `{
+  "openapi": "3.0.1",
+  "info": {"title": "old"},
+  "paths": {
+    "/pets": {
+      "get": {"operationId": "oldGetPets"},
+      "post": {"operationId": "oldPostPets", "security": [{"auth": []}]}
+    },
+    "/managed": {
+      "get": {"operationId": "oldGetManaged"}
+    }
+  },
+  "components": {
+    "securitySchemes": {
+      "auth": {"type": "apiKey"}
+    }
+  }
+}`
+
+	got, err := tfapigatewayv2.MergeOpenAPIDefinitions(body, existing)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var gotDoc map[string]interface{}
+	if err := json.Unmarshal([]byte(got), &gotDoc); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"openapi": "3.0.1",
+		"info":    map[string]interface{}{"title": "new"},
+		"paths": map[string]interface{}{
+			"/pets": map[string]interface{}{
+				"get":  map[string]interface{}{"operationId": "newGetPets"},
+				"post": map[string]interface{}{"operationId": "oldPostPets", "security": []interface{}{map[string]interface{}{"auth": []interface{}{}}}},
+			},
+			"/managed": map[string]interface{}{
+				"get": map[string]interface{}{"operationId": "oldGetManaged"},
+			},
+		},
+		"components": map[string]interface{}{
+			"securitySchemes": map[string]interface{}{
+				"auth": map[string]interface{}{"type": "apiKey"},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(gotDoc, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
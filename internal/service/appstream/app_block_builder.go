@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appstream_app_block_builder", name="App Block Builder")
+// @Tags(identifierAttribute="arn")
+func ResourceAppBlockBuilder() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppBlockBuilderCreate,
+		ReadWithoutTimeout:   resourceAppBlockBuilderRead,
+		UpdateWithoutTimeout: resourceAppBlockBuilderUpdate,
+		DeleteWithoutTimeout: resourceAppBlockBuilderDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_endpoint": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEndpointType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AccessEndpointType](),
+						},
+						"vpce_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"enable_default_internet_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrIAMRoleARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrInstanceType: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"platform": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.AppBlockBuilderPlatformType](),
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVPCConfig: {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrSecurityGroupIDs: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrSubnetIDs: {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAppBlockBuilderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &appstream.CreateAppBlockBuilderInput{
+		InstanceType: aws.String(d.Get(names.AttrInstanceType).(string)),
+		Name:         aws.String(name),
+		Platform:     awstypes.AppBlockBuilderPlatformType(d.Get("platform").(string)),
+		Tags:         getTagsIn(ctx),
+		VpcConfig:    expandImageBuilderVPCConfig(d.Get(names.AttrVPCConfig).([]interface{})),
+	}
+
+	if v, ok := d.GetOk("access_endpoint"); ok && v.(*schema.Set).Len() > 0 {
+		input.AccessEndpoints = expandAccessEndpoints(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDisplayName); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("enable_default_internet_access"); ok {
+		input.EnableDefaultInternetAccess = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk(names.AttrIAMRoleARN); ok {
+		input.IamRoleArn = aws.String(v.(string))
+	}
+
+	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidRoleException](ctx, iamPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateAppBlockBuilder(ctx, input)
+	}, "encountered an error because your IAM role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppStream App Block Builder (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*appstream.CreateAppBlockBuilderOutput).AppBlockBuilder.Name))
+
+	return append(diags, resourceAppBlockBuilderRead(ctx, d, meta)...)
+}
+
+func resourceAppBlockBuilderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	appBlockBuilder, err := FindAppBlockBuilderByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream App Block Builder (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppStream App Block Builder (%s): %s", d.Id(), err)
+	}
+
+	if err = d.Set("access_endpoint", flattenAccessEndpoints(appBlockBuilder.AccessEndpoints)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting access_endpoint: %s", err)
+	}
+	d.Set(names.AttrARN, appBlockBuilder.Arn)
+	d.Set(names.AttrCreatedTime, aws.ToTime(appBlockBuilder.CreatedTime).Format(time.RFC3339))
+	d.Set(names.AttrDescription, appBlockBuilder.Description)
+	d.Set(names.AttrDisplayName, appBlockBuilder.DisplayName)
+	d.Set("enable_default_internet_access", appBlockBuilder.EnableDefaultInternetAccess)
+	d.Set(names.AttrIAMRoleARN, appBlockBuilder.IamRoleArn)
+	d.Set(names.AttrInstanceType, appBlockBuilder.InstanceType)
+	d.Set(names.AttrName, appBlockBuilder.Name)
+	d.Set("platform", appBlockBuilder.Platform)
+	d.Set(names.AttrState, appBlockBuilder.State)
+	if appBlockBuilder.VpcConfig != nil {
+		if err = d.Set(names.AttrVPCConfig, []interface{}{flattenVPCConfig(appBlockBuilder.VpcConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
+		}
+	} else {
+		d.Set(names.AttrVPCConfig, nil)
+	}
+
+	return diags
+}
+
+func resourceAppBlockBuilderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		appBlockBuilder, err := FindAppBlockBuilderByName(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading AppStream App Block Builder (%s): %s", d.Id(), err)
+		}
+
+		// An App Block Builder can only be updated while it is stopped.
+		shouldRestart := appBlockBuilder.State != awstypes.AppBlockBuilderStateStopped
+
+		if shouldRestart {
+			if err := stopAppBlockBuilder(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		input := &appstream.UpdateAppBlockBuilderInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_endpoint") {
+			if v, ok := d.GetOk("access_endpoint"); ok && v.(*schema.Set).Len() > 0 {
+				input.AccessEndpoints = expandAccessEndpoints(v.(*schema.Set).List())
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, awstypes.AppBlockBuilderAttributeAccessEndpoints)
+			}
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrDisplayName) {
+			input.DisplayName = aws.String(d.Get(names.AttrDisplayName).(string))
+		}
+
+		if d.HasChange("enable_default_internet_access") {
+			input.EnableDefaultInternetAccess = aws.Bool(d.Get("enable_default_internet_access").(bool))
+		}
+
+		if d.HasChange(names.AttrIAMRoleARN) {
+			if v, ok := d.GetOk(names.AttrIAMRoleARN); ok {
+				input.IamRoleArn = aws.String(v.(string))
+			} else {
+				input.AttributesToDelete = append(input.AttributesToDelete, awstypes.AppBlockBuilderAttributeIamRoleArn)
+			}
+		}
+
+		if d.HasChange(names.AttrInstanceType) {
+			input.InstanceType = aws.String(d.Get(names.AttrInstanceType).(string))
+		}
+
+		if d.HasChange("platform") {
+			input.Platform = awstypes.PlatformType(d.Get("platform").(string))
+		}
+
+		if d.HasChange(names.AttrVPCConfig) {
+			input.VpcConfig = expandImageBuilderVPCConfig(d.Get(names.AttrVPCConfig).([]interface{}))
+		}
+
+		_, err = conn.UpdateAppBlockBuilder(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating AppStream App Block Builder (%s): %s", d.Id(), err)
+		}
+
+		if shouldRestart {
+			_, err = conn.StartAppBlockBuilder(ctx, &appstream.StartAppBlockBuilderInput{
+				Name: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "starting AppStream App Block Builder (%s): %s", d.Id(), err)
+			}
+
+			if _, err = waitAppBlockBuilderStateRunning(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for AppStream App Block Builder (%s) to be running: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceAppBlockBuilderRead(ctx, d, meta)...)
+}
+
+func resourceAppBlockBuilderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	appBlockBuilder, err := FindAppBlockBuilderByName(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppStream App Block Builder (%s): %s", d.Id(), err)
+	}
+
+	if appBlockBuilder.State != awstypes.AppBlockBuilderStateStopped {
+		if err := stopAppBlockBuilder(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream App Block Builder: %s", d.Id())
+	_, err = conn.DeleteAppBlockBuilder(ctx, &appstream.DeleteAppBlockBuilderInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppStream App Block Builder (%s): %s", d.Id(), err)
+	}
+
+	if _, err = waitAppBlockBuilderDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for AppStream App Block Builder (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func stopAppBlockBuilder(ctx context.Context, conn *appstream.Client, name string) error {
+	_, err := conn.StopAppBlockBuilder(ctx, &appstream.StopAppBlockBuilderInput{
+		Name: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("stopping AppStream App Block Builder (%s): %w", name, err)
+	}
+
+	if _, err := waitAppBlockBuilderStateStopped(ctx, conn, name); err != nil {
+		return fmt.Errorf("waiting for AppStream App Block Builder (%s) to be stopped: %w", name, err)
+	}
+
+	return nil
+}
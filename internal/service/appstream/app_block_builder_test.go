@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppStreamAppBlockBuilder_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block_builder.test"
+	instanceType := "stream.standard.small"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockBuilderDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderConfig_basic(rName, instanceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "appstream", fmt.Sprintf("app-block-builder/%s", rName)),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, instanceType),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "platform", string(awstypes.AppBlockBuilderPlatformTypeWindowsServer2019)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.AppBlockBuilderStateStopped)),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamAppBlockBuilder_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block_builder.test"
+	instanceType := "stream.standard.small"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockBuilderDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderConfig_basic(rName, instanceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappstream.ResourceAppBlockBuilder(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamAppBlockBuilder_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block_builder.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := "Description of a test"
+	descriptionUpdated := "Updated Description of a test"
+	instanceType := "stream.standard.small"
+	instanceTypeUpdated := "stream.standard.medium"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockBuilderDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderConfig_complete(rName, description, instanceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, description),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttr(resourceName, "enable_default_internet_access", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, instanceType),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppBlockBuilderConfig_complete(rName, descriptionUpdated, instanceTypeUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, descriptionUpdated),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, instanceTypeUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppStreamAppBlockBuilder_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_app_block_builder.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppBlockBuilderDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppBlockBuilderConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppBlockBuilderConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccAppBlockBuilderConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppBlockBuilderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAppBlockBuilderExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		_, err := tfappstream.FindAppBlockBuilderByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAppBlockBuilderDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appstream_app_block_builder" {
+				continue
+			}
+
+			_, err := tfappstream.FindAppBlockBuilderByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppStream App Block Builder %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAppBlockBuilderConfig_basic(rName, instanceType string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_appstream_app_block_builder" "test" {
+  name          = %[1]q
+  instance_type = %[2]q
+  platform      = "WINDOWS_SERVER_2019"
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+`, rName, instanceType))
+}
+
+func testAccAppBlockBuilderConfig_complete(rName, description, instanceType string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_appstream_app_block_builder" "test" {
+  name                           = %[1]q
+  description                    = %[2]q
+  display_name                   = %[1]q
+  enable_default_internet_access = false
+  instance_type                  = %[3]q
+  platform                       = "WINDOWS_SERVER_2019"
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+`, rName, description, instanceType))
+}
+
+func testAccAppBlockBuilderConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_appstream_app_block_builder" "test" {
+  name          = %[1]q
+  instance_type = "stream.standard.small"
+  platform      = "WINDOWS_SERVER_2019"
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAppBlockBuilderConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_appstream_app_block_builder" "test" {
+  name          = %[1]q
+  instance_type = "stream.standard.small"
+  platform      = "WINDOWS_SERVER_2019"
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
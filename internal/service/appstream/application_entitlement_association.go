@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appstream"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_appstream_application_entitlement_association")
+func ResourceApplicationEntitlementAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationEntitlementAssociationCreate,
+		ReadWithoutTimeout:   resourceApplicationEntitlementAssociationRead,
+		DeleteWithoutTimeout: resourceApplicationEntitlementAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"application_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"entitlement_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stack_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationEntitlementAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	stackName, entitlementName, applicationIdentifier := d.Get("stack_name").(string), d.Get("entitlement_name").(string), d.Get("application_identifier").(string)
+	id := EncodeApplicationEntitlementAssociationID(stackName, entitlementName, applicationIdentifier)
+	input := &appstream.AssociateApplicationToEntitlementInput{
+		ApplicationIdentifier: aws.String(applicationIdentifier),
+		EntitlementName:       aws.String(entitlementName),
+		StackName:             aws.String(stackName),
+	}
+
+	_, err := conn.AssociateApplicationToEntitlement(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppStream Application Entitlement Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceApplicationEntitlementAssociationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationEntitlementAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	stackName, entitlementName, applicationIdentifier, err := DecodeApplicationEntitlementAssociationID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "decoding AppStream Application Entitlement Association ID (%s): %s", d.Id(), err)
+	}
+
+	err = FindApplicationEntitlementAssociation(ctx, conn, stackName, entitlementName, applicationIdentifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Application Entitlement Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppStream Application Entitlement Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_identifier", applicationIdentifier)
+	d.Set("entitlement_name", entitlementName)
+	d.Set("stack_name", stackName)
+
+	return diags
+}
+
+func resourceApplicationEntitlementAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamClient(ctx)
+
+	stackName, entitlementName, applicationIdentifier, err := DecodeApplicationEntitlementAssociationID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "decoding AppStream Application Entitlement Association ID (%s): %s", d.Id(), err)
+	}
+
+	_, err = conn.DisassociateApplicationFromEntitlement(ctx, &appstream.DisassociateApplicationFromEntitlementInput{
+		ApplicationIdentifier: aws.String(applicationIdentifier),
+		EntitlementName:       aws.String(entitlementName),
+		StackName:             aws.String(stackName),
+	})
+
+	if errs.IsA[*awstypes.EntitlementNotFoundException](err) || errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppStream Application Entitlement Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func EncodeApplicationEntitlementAssociationID(stackName, entitlementName, applicationIdentifier string) string {
+	return fmt.Sprintf("%s/%s/%s", stackName, entitlementName, applicationIdentifier)
+}
+
+// DecodeApplicationEntitlementAssociationID splits the ID into its parts.
+// The application identifier may be an ARN containing "/", so it must come last.
+func DecodeApplicationEntitlementAssociationID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return "", "", "", fmt.Errorf("expected ID in format StackName/EntitlementName/ApplicationIdentifier, received: %s", id)
+	}
+	return idParts[0], idParts[1], idParts[2], nil
+}
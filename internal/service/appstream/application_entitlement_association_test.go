@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Entitlements and applications must be created outside of Terraform,
+// so these tests require an existing stack, entitlement and application.
+func testAccApplicationEntitlementAssociationPreCheck(t *testing.T) (string, string, string) {
+	t.Helper()
+
+	stackName := acctest.SkipIfEnvVarNotSet(t, "APPSTREAM_ENTITLEMENT_STACK_NAME")
+	entitlementName := acctest.SkipIfEnvVarNotSet(t, "APPSTREAM_ENTITLEMENT_NAME")
+	applicationARN := acctest.SkipIfEnvVarNotSet(t, "APPSTREAM_APPLICATION_ARN")
+
+	return stackName, entitlementName, applicationARN
+}
+
+func TestAccAppStreamApplicationEntitlementAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_application_entitlement_association.test"
+	stackName, entitlementName, applicationARN := testAccApplicationEntitlementAssociationPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationEntitlementAssociationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationEntitlementAssociationConfig_basic(stackName, entitlementName, applicationARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationEntitlementAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "application_identifier", applicationARN),
+					resource.TestCheckResourceAttr(resourceName, "entitlement_name", entitlementName),
+					resource.TestCheckResourceAttr(resourceName, "stack_name", stackName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamApplicationEntitlementAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appstream_application_entitlement_association.test"
+	stackName, entitlementName, applicationARN := testAccApplicationEntitlementAssociationPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationEntitlementAssociationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationEntitlementAssociationConfig_basic(stackName, entitlementName, applicationARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationEntitlementAssociationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappstream.ResourceApplicationEntitlementAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationEntitlementAssociationExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		stackName, entitlementName, applicationIdentifier, err := tfappstream.DecodeApplicationEntitlementAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		return tfappstream.FindApplicationEntitlementAssociation(ctx, conn, stackName, entitlementName, applicationIdentifier)
+	}
+}
+
+func testAccCheckApplicationEntitlementAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appstream_application_entitlement_association" {
+				continue
+			}
+
+			stackName, entitlementName, applicationIdentifier, err := tfappstream.DecodeApplicationEntitlementAssociationID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			err = tfappstream.FindApplicationEntitlementAssociation(ctx, conn, stackName, entitlementName, applicationIdentifier)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppStream Application Entitlement Association %q still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccApplicationEntitlementAssociationConfig_basic(stackName, entitlementName, applicationARN string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_application_entitlement_association" "test" {
+  stack_name             = %[1]q
+  entitlement_name       = %[2]q
+  application_identifier = %[3]q
+}
+`, stackName, entitlementName, applicationARN)
+}
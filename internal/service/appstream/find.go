@@ -182,3 +182,162 @@ func FindFleetStackAssociation(ctx context.Context, conn *appstream.Client, flee
 
 	return nil
 }
+
+func FindAppBlockByARN(ctx context.Context, conn *appstream.Client, arn string) (*awstypes.AppBlock, error) {
+	input := &appstream.DescribeAppBlocksInput{
+		Arns: []string{arn},
+	}
+
+	output, err := findAppBlock(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.Arn) != arn {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findAppBlocks(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlocksInput) ([]awstypes.AppBlock, error) {
+	var output []awstypes.AppBlock
+
+	err := describeAppBlocksPages(ctx, conn, input, func(page *appstream.DescribeAppBlocksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.AppBlocks...)
+
+		return !lastPage
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAppBlock(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlocksInput) (*awstypes.AppBlock, error) {
+	output, err := findAppBlocks(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func FindAppBlockBuilderByName(ctx context.Context, conn *appstream.Client, name string) (*awstypes.AppBlockBuilder, error) {
+	input := &appstream.DescribeAppBlockBuildersInput{
+		Names: []string{name},
+	}
+
+	output, err := findAppBlockBuilder(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.Name) != name {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findAppBlockBuilders(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlockBuildersInput) ([]awstypes.AppBlockBuilder, error) {
+	var output []awstypes.AppBlockBuilder
+
+	err := describeAppBlockBuildersPages(ctx, conn, input, func(page *appstream.DescribeAppBlockBuildersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.AppBlockBuilders...)
+
+		return !lastPage
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAppBlockBuilder(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlockBuildersInput) (*awstypes.AppBlockBuilder, error) {
+	output, err := findAppBlockBuilders(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+// FindApplicationEntitlementAssociation Validates that an entitlement has the identified application
+func FindApplicationEntitlementAssociation(ctx context.Context, conn *appstream.Client, stackName, entitlementName, applicationIdentifier string) error {
+	input := &appstream.ListEntitledApplicationsInput{
+		EntitlementName: aws.String(entitlementName),
+		StackName:       aws.String(stackName),
+	}
+
+	found := false
+	err := listEntitledApplicationsPages(ctx, conn, input, func(page *appstream.ListEntitledApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EntitledApplications {
+			if aws.ToString(v.ApplicationIdentifier) == applicationIdentifier {
+				found = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if errs.IsA[*awstypes.EntitlementNotFoundException](err) || errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return &retry.NotFoundError{
+			Message:     fmt.Sprintf("No application %q associated with entitlement %q", applicationIdentifier, entitlementName),
+			LastRequest: input,
+		}
+	}
+
+	return nil
+}
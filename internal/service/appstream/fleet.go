@@ -5,6 +5,7 @@ package appstream
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"reflect"
 	"time"
@@ -44,6 +45,7 @@ func ResourceFleet() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			resourceFleetCustDiff,
+			resourceFleetTypeCustDiff,
 			verify.SetTagsDiff,
 		),
 
@@ -55,7 +57,8 @@ func ResourceFleet() *schema.Resource {
 			"compute_capacity": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"available": {
@@ -167,6 +170,11 @@ func ResourceFleet() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"max_concurrent_sessions": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
 			"max_sessions_per_instance": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -182,6 +190,12 @@ func ResourceFleet() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"platform": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PlatformType](),
+			},
 			"stream_view": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -271,6 +285,10 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.IamRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("max_concurrent_sessions"); ok {
+		input.MaxConcurrentSessions = aws.Int32(int32(v.(int)))
+	}
+
 	if v, ok := d.GetOk("max_sessions_per_instance"); ok {
 		input.MaxSessionsPerInstance = aws.Int32(int32(v.(int)))
 	}
@@ -279,6 +297,10 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.MaxUserDurationInSeconds = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk("platform"); ok {
+		input.Platform = awstypes.PlatformType(v.(string))
+	}
+
 	if v, ok := d.GetOk("stream_view"); ok {
 		input.StreamView = awstypes.StreamView(v.(string))
 	}
@@ -388,9 +410,11 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("image_name", fleet.ImageName)
 	d.Set("image_arn", fleet.ImageArn)
 	d.Set(names.AttrInstanceType, fleet.InstanceType)
+	d.Set("max_concurrent_sessions", fleet.MaxConcurrentSessions)
 	d.Set("max_sessions_per_instance", fleet.MaxSessionsPerInstance)
 	d.Set("max_user_duration_in_seconds", fleet.MaxUserDurationInSeconds)
 	d.Set(names.AttrName, fleet.Name)
+	d.Set("platform", fleet.Platform)
 	d.Set(names.AttrState, fleet.State)
 	d.Set("stream_view", fleet.StreamView)
 
@@ -414,7 +438,7 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	shouldStop := false
 
-	if d.HasChanges(names.AttrDescription, "domain_join_info", "enable_default_internet_access", names.AttrIAMRoleARN, names.AttrInstanceType, "max_user_duration_in_seconds", "stream_view", names.AttrVPCConfig) {
+	if d.HasChanges(names.AttrDescription, "domain_join_info", "enable_default_internet_access", names.AttrIAMRoleARN, names.AttrInstanceType, "max_user_duration_in_seconds", "platform", "stream_view", names.AttrVPCConfig) {
 		shouldStop = true
 	}
 
@@ -479,6 +503,10 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.InstanceType = aws.String(d.Get(names.AttrInstanceType).(string))
 	}
 
+	if d.HasChange("max_concurrent_sessions") {
+		input.MaxConcurrentSessions = aws.Int32(int32(d.Get("max_concurrent_sessions").(int)))
+	}
+
 	if d.HasChange("max_sessions_per_instance") {
 		input.MaxSessionsPerInstance = aws.Int32(int32(d.Get("max_sessions_per_instance").(int)))
 	}
@@ -487,6 +515,10 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.MaxUserDurationInSeconds = aws.Int32(int32(d.Get("max_user_duration_in_seconds").(int)))
 	}
 
+	if d.HasChange("platform") {
+		input.Platform = awstypes.PlatformType(d.Get("platform").(string))
+	}
+
 	if d.HasChange(names.AttrVPCConfig) {
 		input.VpcConfig = expandVPCConfig(d.Get(names.AttrVPCConfig).([]interface{}))
 	}
@@ -563,6 +595,37 @@ func resourceFleetCustDiff(_ context.Context, diff *schema.ResourceDiff, meta in
 	return nil
 }
 
+// resourceFleetTypeCustDiff validates the arguments required by each fleet type.
+// Elastic fleets are not backed by a pool of instances, so they need a platform,
+// a session limit and VPC subnets instead of a compute capacity.
+func resourceFleetTypeCustDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("fleet_type") {
+		return nil
+	}
+
+	if awstypes.FleetType(diff.Get("fleet_type").(string)) != awstypes.FleetTypeElastic {
+		if diff.Id() == "" && len(diff.Get("compute_capacity").([]interface{})) == 0 {
+			return fmt.Errorf("compute_capacity is required for %s and %s fleets", awstypes.FleetTypeAlwaysOn, awstypes.FleetTypeOnDemand)
+		}
+
+		return nil
+	}
+
+	if diff.NewValueKnown("platform") && diff.Get("platform").(string) == "" {
+		return fmt.Errorf("platform is required for %s fleets", awstypes.FleetTypeElastic)
+	}
+
+	if diff.NewValueKnown("max_concurrent_sessions") && diff.Get("max_concurrent_sessions").(int) == 0 {
+		return fmt.Errorf("max_concurrent_sessions is required for %s fleets", awstypes.FleetTypeElastic)
+	}
+
+	if diff.NewValueKnown("vpc_config.0.subnet_ids") && len(diff.Get("vpc_config.0.subnet_ids").([]interface{})) == 0 {
+		return fmt.Errorf("vpc_config.0.subnet_ids is required for %s fleets", awstypes.FleetTypeElastic)
+	}
+
+	return nil
+}
+
 func expandComputeCapacity(tfList []interface{}) *awstypes.ComputeCapacity {
 	if len(tfList) == 0 {
 		return nil
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/appstream"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -358,6 +359,75 @@ func TestAccAppStreamFleet_multiSession(t *testing.T) {
 	})
 }
 
+func TestAccAppStreamFleet_elastic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleetOutput awstypes.Fleet
+	resourceName := "aws_appstream_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	instanceType := "stream.standard.small"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "AmazonAppStreamServiceAccess")
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_elastic(rName, instanceType, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleetOutput),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", string(awstypes.FleetTypeElastic)),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_sessions", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "platform", string(awstypes.PlatformTypeWindowsServer2019)),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetConfig_elastic(rName, instanceType, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleetOutput),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_sessions", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppStreamFleet_elasticValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetConfig_elasticNoPlatform(rName),
+				ExpectError: regexache.MustCompile(`platform is required for ELASTIC fleets`),
+			},
+			{
+				Config:      testAccFleetConfig_elasticNoSubnets(rName),
+				ExpectError: regexache.MustCompile(`vpc_config.0.subnet_ids is required for ELASTIC fleets`),
+			},
+			{
+				Config:      testAccFleetConfig_noComputeCapacity(rName),
+				ExpectError: regexache.MustCompile(`compute_capacity is required for ALWAYS_ON and ON_DEMAND fleets`),
+			},
+		},
+	})
+}
+
 func testAccCheckFleetExists(ctx context.Context, resourceName string, appStreamFleet *awstypes.Fleet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -607,3 +677,56 @@ resource "aws_appstream_fleet" "test" {
 }
 `, name, instanceType, desiredSessions, maxSessionsPerInstance))
 }
+
+func testAccFleetConfig_elastic(name, instanceType string, maxConcurrentSessions int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(name, 2), fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name                    = %[1]q
+  fleet_type              = "ELASTIC"
+  instance_type           = %[2]q
+  max_concurrent_sessions = %[3]d
+  platform                = "WINDOWS_SERVER_2019"
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+`, name, instanceType, maxConcurrentSessions))
+}
+
+func testAccFleetConfig_elasticNoPlatform(name string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(name, 2), fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name                    = %[1]q
+  fleet_type              = "ELASTIC"
+  instance_type           = "stream.standard.small"
+  max_concurrent_sessions = 1
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+`, name))
+}
+
+func testAccFleetConfig_elasticNoSubnets(name string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name                    = %[1]q
+  fleet_type              = "ELASTIC"
+  instance_type           = "stream.standard.small"
+  max_concurrent_sessions = 1
+  platform                = "WINDOWS_SERVER_2019"
+}
+`, name)
+}
+
+func testAccFleetConfig_noComputeCapacity(name string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-03-11-2023"
+  instance_type = "stream.standard.small"
+}
+`, name)
+}
@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -AWSSDKVersion=2 -ListOps=DescribeDirectoryConfigs,DescribeFleets,DescribeImageBuilders,DescribeStacks,DescribeUsers,ListAssociatedStacks"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -AWSSDKVersion=2 -ListOps=DescribeAppBlockBuilders,DescribeAppBlocks,DescribeDirectoryConfigs,DescribeFleets,DescribeImageBuilders,DescribeStacks,DescribeUsers,ListAssociatedStacks,ListEntitledApplications"; DO NOT EDIT.
 
 package appstream
 
@@ -9,6 +9,38 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/appstream"
 )
 
+func describeAppBlockBuildersPages(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlockBuildersInput, fn func(*appstream.DescribeAppBlockBuildersOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeAppBlockBuilders(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+func describeAppBlocksPages(ctx context.Context, conn *appstream.Client, input *appstream.DescribeAppBlocksInput, fn func(*appstream.DescribeAppBlocksOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeAppBlocks(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func describeDirectoryConfigsPages(ctx context.Context, conn *appstream.Client, input *appstream.DescribeDirectoryConfigsInput, fn func(*appstream.DescribeDirectoryConfigsOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeDirectoryConfigs(ctx, input)
@@ -105,3 +137,19 @@ func listAssociatedStacksPages(ctx context.Context, conn *appstream.Client, inpu
 	}
 	return nil
 }
+func listEntitledApplicationsPages(ctx context.Context, conn *appstream.Client, input *appstream.ListEntitledApplicationsInput, fn func(*appstream.ListEntitledApplicationsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListEntitledApplications(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
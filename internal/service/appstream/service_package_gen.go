@@ -29,6 +29,26 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceAppBlock,
+			TypeName: "aws_appstream_app_block",
+			Name:     "App Block",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  ResourceAppBlockBuilder,
+			TypeName: "aws_appstream_app_block_builder",
+			Name:     "App Block Builder",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  ResourceApplicationEntitlementAssociation,
+			TypeName: "aws_appstream_application_entitlement_association",
+		},
 		{
 			Factory:  ResourceDirectoryConfig,
 			TypeName: "aws_appstream_directory_config",
@@ -60,3 +60,19 @@ func statusUserAvailable(ctx context.Context, conn *appstream.Client, username,
 		return user, userAvailable, nil
 	}
 }
+
+func statusAppBlockBuilderState(ctx context.Context, conn *appstream.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAppBlockBuilderByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
@@ -25,6 +25,9 @@ const (
 	// imageBuilderStateTimeout Maximum amount of time to wait for the statusImageBuilderState to be RUNNING
 	// or for the ImageBuilder to be deleted
 	imageBuilderStateTimeout = 60 * time.Minute
+	// appBlockBuilderStateTimeout Maximum amount of time to wait for the statusAppBlockBuilderState to be RUNNING or STOPPED
+	// or for the AppBlockBuilder to be deleted
+	appBlockBuilderStateTimeout = 60 * time.Minute
 	// userOperationTimeout Maximum amount of time to wait for User operation eventual consistency
 	userOperationTimeout = 4 * time.Minute
 	// iamPropagationTimeout Maximum amount of time to wait for an iam resource eventual consistency
@@ -142,6 +145,77 @@ func waitImageBuilderStateDeleted(ctx context.Context, conn *appstream.Client, n
 	return nil, err
 }
 
+func waitAppBlockBuilderStateRunning(ctx context.Context, conn *appstream.Client, name string) (*awstypes.AppBlockBuilder, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppBlockBuilderStateStarting),
+		Target:  enum.Slice(awstypes.AppBlockBuilderStateRunning),
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AppBlockBuilder); ok {
+		if v := output.AppBlockBuilderErrors; len(v) > 0 {
+			var errs []error
+
+			for _, err := range v {
+				errs = append(errs, fmt.Errorf("%s: %s", string(err.ErrorCode), aws.ToString(err.ErrorMessage)))
+			}
+
+			tfresource.SetLastError(err, errors.Join(errs...))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppBlockBuilderStateStopped(ctx context.Context, conn *appstream.Client, name string) (*awstypes.AppBlockBuilder, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppBlockBuilderStateStopping),
+		Target:  enum.Slice(awstypes.AppBlockBuilderStateStopped),
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AppBlockBuilder); ok {
+		if v := output.AppBlockBuilderErrors; len(v) > 0 {
+			var errs []error
+
+			for _, err := range v {
+				errs = append(errs, fmt.Errorf("%s: %s", string(err.ErrorCode), aws.ToString(err.ErrorMessage)))
+			}
+
+			tfresource.SetLastError(err, errors.Join(errs...))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppBlockBuilderDeleted(ctx context.Context, conn *appstream.Client, name string) (*awstypes.AppBlockBuilder, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppBlockBuilderStateStopped, awstypes.AppBlockBuilderStateStopping),
+		Target:  []string{},
+		Refresh: statusAppBlockBuilderState(ctx, conn, name),
+		Timeout: appBlockBuilderStateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AppBlockBuilder); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 // waitUserAvailable waits for a user be available
 func waitUserAvailable(ctx context.Context, conn *appstream.Client, username, authType string) (*awstypes.User, error) {
 	stateConf := &retry.StateChangeConf{
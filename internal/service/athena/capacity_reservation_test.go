@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAthenaCapacityReservation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_basic(rName, 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "athena", fmt.Sprintf("capacity-reservation/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.CapacityReservationStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "target_dpus", "24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationConfig_basic(rName, 28),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_dpus", "28"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaCapacityReservation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_basic(rName, 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfathena.ResourceCapacityReservation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAthenaCapacityReservation_capacityAssignment(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_capacityAssignment(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.0.workgroup_names.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "capacity_assignment.0.workgroup_names.*", "aws_athena_workgroup.test", names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationConfig_basic(rName, 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaCapacityReservation_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccCapacityReservationConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityReservationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaClient(ctx)
+
+		_, err := tfathena.FindCapacityReservationByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckCapacityReservationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_athena_capacity_reservation" {
+				continue
+			}
+
+			_, err := tfathena.FindCapacityReservationByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Athena Capacity Reservation %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCapacityReservationConfig_basic(rName string, targetDPUs int) string {
+	return fmt.Sprintf(`
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = %[2]d
+}
+`, rName, targetDPUs)
+}
+
+func testAccCapacityReservationConfig_capacityAssignment(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+
+  configuration {
+    engine_version {
+      selected_engine_version = "Athena engine version 3"
+    }
+  }
+}
+
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = 24
+
+  capacity_assignment {
+    workgroup_names = [aws_athena_workgroup.test.name]
+  }
+}
+`, rName)
+}
+
+func testAccCapacityReservationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = 24
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCapacityReservationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = 24
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -34,6 +34,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceCapacityReservation,
+			TypeName: "aws_athena_capacity_reservation",
+			Name:     "Capacity Reservation",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceDataCatalog,
 			TypeName: "aws_athena_data_catalog",
@@ -88,6 +88,27 @@ func resourceWorkGroup() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"identity_center_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_identity_center": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"identity_center_instance_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
 						"publish_cloudwatch_metrics_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -360,6 +381,10 @@ func expandWorkGroupConfiguration(l []interface{}) *types.WorkGroupConfiguration
 		configuration.ExecutionRole = aws.String(v)
 	}
 
+	if v, ok := m["identity_center_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.IdentityCenterConfiguration = expandWorkGroupIdentityCenterConfiguration(v)
+	}
+
 	if v, ok := m["publish_cloudwatch_metrics_enabled"].(bool); ok {
 		configuration.PublishCloudWatchMetricsEnabled = aws.Bool(v)
 	}
@@ -391,6 +416,26 @@ func expandWorkGroupEngineVersion(l []interface{}) *types.EngineVersion {
 	return engineVersion
 }
 
+func expandWorkGroupIdentityCenterConfiguration(l []interface{}) *types.IdentityCenterConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	identityCenterConfiguration := &types.IdentityCenterConfiguration{}
+
+	if v, ok := m["enable_identity_center"].(bool); ok {
+		identityCenterConfiguration.EnableIdentityCenter = aws.Bool(v)
+	}
+
+	if v, ok := m["identity_center_instance_arn"].(string); ok && v != "" {
+		identityCenterConfiguration.IdentityCenterInstanceArn = aws.String(v)
+	}
+
+	return identityCenterConfiguration
+}
+
 func expandWorkGroupConfigurationUpdates(l []interface{}) *types.WorkGroupConfigurationUpdates {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -527,6 +572,7 @@ func flattenWorkGroupConfiguration(configuration *types.WorkGroupConfiguration)
 		"enforce_workgroup_configuration":    aws.ToBool(configuration.EnforceWorkGroupConfiguration),
 		names.AttrEngineVersion:              flattenWorkGroupEngineVersion(configuration.EngineVersion),
 		"execution_role":                     aws.ToString(configuration.ExecutionRole),
+		"identity_center_configuration":      flattenWorkGroupIdentityCenterConfiguration(configuration.IdentityCenterConfiguration),
 		"publish_cloudwatch_metrics_enabled": aws.ToBool(configuration.PublishCloudWatchMetricsEnabled),
 		"result_configuration":               flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
 		"requester_pays_enabled":             aws.ToBool(configuration.RequesterPaysEnabled),
@@ -548,6 +594,19 @@ func flattenWorkGroupEngineVersion(engineVersion *types.EngineVersion) []interfa
 	return []interface{}{m}
 }
 
+func flattenWorkGroupIdentityCenterConfiguration(identityCenterConfiguration *types.IdentityCenterConfiguration) []interface{} {
+	if identityCenterConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enable_identity_center":       aws.ToBool(identityCenterConfiguration.EnableIdentityCenter),
+		"identity_center_instance_arn": aws.ToString(identityCenterConfiguration.IdentityCenterInstanceArn),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenWorkGroupResultConfiguration(resultConfiguration *types.ResultConfiguration) []interface{} {
 	if resultConfiguration == nil {
 		return []interface{}{}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/bedrock"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -38,13 +39,13 @@ func newProvisionedModelThroughputResource(context.Context) (resource.ResourceWi
 	r := &resourceProvisionedModelThroughput{}
 
 	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
 
 	return r, nil
 }
 
 type resourceProvisionedModelThroughput struct {
 	framework.ResourceWithConfigure
-	framework.WithNoOpUpdate[provisionedModelThroughputResourceModel]
 	framework.WithImportByID
 	framework.WithTimeouts
 }
@@ -63,13 +64,17 @@ func (r *resourceProvisionedModelThroughput) Schema(ctx context.Context, request
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"commitment_expiration_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrID: framework.IDAttribute(),
 			"model_arn": schema.StringAttribute{
 				Required:   true,
 				CustomType: fwtypes.ARNType,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"model_units": schema.Int64Attribute{
 				Required: true,
@@ -80,9 +85,6 @@ func (r *resourceProvisionedModelThroughput) Schema(ctx context.Context, request
 			"provisioned_model_arn": framework.ARNAttributeComputedOnly(),
 			"provisioned_model_name": schema.StringAttribute{
 				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
@@ -90,6 +92,7 @@ func (r *resourceProvisionedModelThroughput) Schema(ctx context.Context, request
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 			}),
 		},
 	}
@@ -128,12 +131,16 @@ func (r *resourceProvisionedModelThroughput) Create(ctx context.Context, request
 	data.ProvisionedModelARN = fwflex.StringToFramework(ctx, output.ProvisionedModelArn)
 	data.setID()
 
-	if _, err := waitProvisionedModelThroughputCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+	throughput, err := waitProvisionedModelThroughputCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Provisioned Model Throughput (%s) create", data.ID.ValueString()), err.Error())
 
 		return
 	}
 
+	data.CommitmentExpirationTime = fwflex.TimeToFramework(ctx, throughput.CommitmentExpirationTime)
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -175,6 +182,56 @@ func (r *resourceProvisionedModelThroughput) Read(ctx context.Context, request r
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+func (r *resourceProvisionedModelThroughput) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new provisionedModelThroughputResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	if !new.ModelARN.Equal(old.ModelARN) || !new.ProvisionedModelName.Equal(old.ProvisionedModelName) {
+		input := &bedrock.UpdateProvisionedModelThroughputInput{
+			ProvisionedModelId: fwflex.StringFromFramework(ctx, new.ID),
+		}
+
+		if !new.ModelARN.Equal(old.ModelARN) {
+			input.DesiredModelId = fwflex.StringFromFramework(ctx, new.ModelARN)
+		}
+
+		if !new.ProvisionedModelName.Equal(old.ProvisionedModelName) {
+			input.DesiredProvisionedModelName = fwflex.StringFromFramework(ctx, new.ProvisionedModelName)
+		}
+
+		_, err := conn.UpdateProvisionedModelThroughput(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Provisioned Model Throughput (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := waitProvisionedModelThroughputUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Provisioned Model Throughput (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.CommitmentExpirationTime = fwflex.TimeToFramework(ctx, output.CommitmentExpirationTime)
+	} else {
+		new.CommitmentExpirationTime = old.CommitmentExpirationTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
 func (r *resourceProvisionedModelThroughput) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	var data provisionedModelThroughputResourceModel
 	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
@@ -259,16 +316,36 @@ func waitProvisionedModelThroughputCreated(ctx context.Context, conn *bedrock.Cl
 	return nil, err
 }
 
+func waitProvisionedModelThroughputUpdated(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetProvisionedModelThroughputOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProvisionedModelStatusUpdating),
+		Target:  enum.Slice(awstypes.ProvisionedModelStatusInService),
+		Refresh: statusProvisionedModelThroughput(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetProvisionedModelThroughputOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 type provisionedModelThroughputResourceModel struct {
-	CommitmentDuration   fwtypes.StringEnum[awstypes.CommitmentDuration] `tfsdk:"commitment_duration"`
-	ID                   types.String                                    `tfsdk:"id"`
-	ModelARN             fwtypes.ARN                                     `tfsdk:"model_arn"`
-	ModelUnits           types.Int64                                     `tfsdk:"model_units"`
-	ProvisionedModelARN  types.String                                    `tfsdk:"provisioned_model_arn"`
-	ProvisionedModelName types.String                                    `tfsdk:"provisioned_model_name"`
-	Tags                 types.Map                                       `tfsdk:"tags"`
-	TagsAll              types.Map                                       `tfsdk:"tags_all"`
-	Timeouts             timeouts.Value                                  `tfsdk:"timeouts"`
+	CommitmentDuration       fwtypes.StringEnum[awstypes.CommitmentDuration] `tfsdk:"commitment_duration"`
+	CommitmentExpirationTime timetypes.RFC3339                               `tfsdk:"commitment_expiration_time"`
+	ID                       types.String                                    `tfsdk:"id"`
+	ModelARN                 fwtypes.ARN                                     `tfsdk:"model_arn"`
+	ModelUnits               types.Int64                                     `tfsdk:"model_units"`
+	ProvisionedModelARN      types.String                                    `tfsdk:"provisioned_model_arn"`
+	ProvisionedModelName     types.String                                    `tfsdk:"provisioned_model_name"`
+	Tags                     types.Map                                       `tfsdk:"tags"`
+	TagsAll                  types.Map                                       `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                  `tfsdk:"timeouts"`
 }
 
 func (data *provisionedModelThroughputResourceModel) InitFromID() error {
@@ -8,10 +8,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/bedrock"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
@@ -25,7 +29,7 @@ func TestAccBedrockProvisionedModelThroughput_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_bedrock_provisioned_model_throughput.test"
-	var v bedrock.GetProvisionedModelThroughputOutput
+	var v1, v2 bedrock.GetProvisionedModelThroughputOutput
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
@@ -36,7 +40,7 @@ func TestAccBedrockProvisionedModelThroughput_basic(t *testing.T) {
 			{
 				Config: testAccProvisionedModelThroughputConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckProvisionedModelThroughputExists(ctx, resourceName, &v),
+					testAccCheckProvisionedModelThroughputExists(ctx, resourceName, &v1),
 					resource.TestCheckResourceAttr(resourceName, "commitment_duration", "OneMonth"),
 					resource.TestCheckResourceAttrSet(resourceName, "model_arn"),
 					resource.TestCheckResourceAttr(resourceName, "model_units", acctest.Ct1),
@@ -50,6 +54,20 @@ func TestAccBedrockProvisionedModelThroughput_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccProvisionedModelThroughputConfig_basic(rName + "-updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("commitment_expiration_time"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(ctx, resourceName, &v2),
+					testAccCheckProvisionedModelThroughputNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_model_name", rName+"-updated"),
+				),
+			},
 		},
 	})
 }
@@ -104,6 +122,16 @@ func testAccCheckProvisionedModelThroughputExists(ctx context.Context, n string,
 	}
 }
 
+func testAccCheckProvisionedModelThroughputNotRecreated(before, after *bedrock.GetProvisionedModelThroughputOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.ProvisionedModelArn), aws.ToString(after.ProvisionedModelArn); before != after {
+			return fmt.Errorf("Bedrock Provisioned Model Throughput (%s) recreated as (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccProvisionedModelThroughputConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 data "aws_bedrock_foundation_model" "test" {
@@ -5,6 +5,7 @@ package ce
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -104,10 +106,27 @@ func resourceAnomalySubscription() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffAnomalySubscription,
+		),
 	}
 }
 
+func customizeDiffAnomalySubscription(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("threshold_expression") {
+		return nil
+	}
+
+	if v, ok := d.GetOk("threshold_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := validateExpression(v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return fmt.Errorf("threshold_expression: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func resourceAnomalySubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CEClient(ctx)
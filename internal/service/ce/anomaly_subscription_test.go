@@ -226,6 +226,48 @@ func TestAccCEAnomalySubscription_Subscriber(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalySubscription_ThresholdExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	var subscription awstypes.AnomalySubscription
+	resourceName := "aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalySubscriptionConfig_thresholdExpressionInvalid(rName, address),
+				ExpectError: regexache.MustCompile(`threshold_expression: and: must contain at least 2 expressions`),
+			},
+			{
+				Config: testAccAnomalySubscriptionConfig_thresholdExpression(rName, address, "and"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.and.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalySubscriptionConfig_thresholdExpression(rName, address, "or"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.and.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.or.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCEAnomalySubscription_Tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var subscription awstypes.AnomalySubscription
@@ -640,3 +682,67 @@ resource "aws_ce_anomaly_subscription" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2, address))
 }
+
+func testAccAnomalySubscriptionConfig_thresholdExpression(rName, address, operator string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    %[3]s {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+        values        = ["100.0"]
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+      }
+    }
+
+    %[3]s {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_PERCENTAGE"
+        values        = ["50.0"]
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+      }
+    }
+  }
+}
+`, rName, address, operator))
+}
+
+func testAccAnomalySubscriptionConfig_thresholdExpressionInvalid(rName, address string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    and {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+        values        = ["100.0"]
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+      }
+    }
+  }
+}
+`, rName, address))
+}
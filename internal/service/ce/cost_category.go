@@ -5,6 +5,7 @@ package ce
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -43,7 +44,10 @@ func resourceCostCategory() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: customdiff.Sequence(verify.SetTagsDiff),
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffCostCategory,
+		),
 
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
@@ -297,6 +301,121 @@ func expressionElem(level int) *schema.Resource {
 	}
 }
 
+// validateExpression checks that an expression specifies exactly one of its operands
+// and that any logical AND or OR operator combines at least two expressions.
+func validateExpression(tfMap map[string]interface{}) error {
+	var operands []string
+
+	for _, k := range []string{"and", "or"} {
+		if v, ok := tfMap[k].(*schema.Set); ok && v.Len() > 0 {
+			if v.Len() < 2 {
+				return fmt.Errorf("%s: must contain at least 2 expressions", k)
+			}
+
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if err := validateExpression(tfMap); err != nil {
+					return fmt.Errorf("%s: %w", k, err)
+				}
+			}
+
+			operands = append(operands, k)
+		}
+	}
+
+	if v, ok := tfMap["not"].([]interface{}); ok && len(v) > 0 {
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if err := validateExpression(tfMap); err != nil {
+				return fmt.Errorf("not: %w", err)
+			}
+		}
+
+		operands = append(operands, "not")
+	}
+
+	for _, k := range []string{"cost_category", "dimension", names.AttrTags} {
+		if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 {
+			operands = append(operands, k)
+		}
+	}
+
+	if len(operands) != 1 {
+		return fmt.Errorf("exactly one of and, cost_category, dimension, not, or, tags must be specified, got %d", len(operands))
+	}
+
+	return nil
+}
+
+func validateCostCategorySplitChargeRule(tfMap map[string]interface{}) error {
+	source := tfMap[names.AttrSource].(string)
+	targets := tfMap["targets"].(*schema.Set)
+
+	if targets.Contains(source) {
+		return fmt.Errorf("source (%s) must not be one of the targets", source)
+	}
+
+	parameters := tfMap[names.AttrParameter].(*schema.Set).List()
+
+	switch method := awstypes.CostCategorySplitChargeMethod(tfMap["method"].(string)); method {
+	case awstypes.CostCategorySplitChargeMethodFixed:
+		if len(parameters) != 1 {
+			return fmt.Errorf("method %s requires exactly one parameter", method)
+		}
+
+		parameter := parameters[0].(map[string]interface{})
+
+		if v := parameter[names.AttrType].(string); v != string(awstypes.CostCategorySplitChargeRuleParameterTypeAllocationPercentages) {
+			return fmt.Errorf("method %s requires a parameter of type %s", method, awstypes.CostCategorySplitChargeRuleParameterTypeAllocationPercentages)
+		}
+
+		if got, want := len(parameter[names.AttrValues].([]interface{})), targets.Len(); got != want {
+			return fmt.Errorf("method %s requires one allocation percentage per target, got %d for %d targets", method, got, want)
+		}
+	default:
+		if len(parameters) > 0 {
+			return fmt.Errorf("method %s does not support parameters", method)
+		}
+	}
+
+	return nil
+}
+
+func customizeDiffCostCategory(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown(names.AttrRule) {
+		for _, tfMapRaw := range d.Get(names.AttrRule).(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if v, ok := tfMap[names.AttrRule].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				if err := validateExpression(v[0].(map[string]interface{})); err != nil {
+					return fmt.Errorf("rule: %w", err)
+				}
+			}
+		}
+	}
+
+	if d.NewValueKnown("split_charge_rule") {
+		for _, tfMapRaw := range d.Get("split_charge_rule").(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if err := validateCostCategorySplitChargeRule(tfMap); err != nil {
+				return fmt.Errorf("split_charge_rule: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceCostCategoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CEClient(ctx)
@@ -216,6 +216,24 @@ func TestAccCECostCategory_splitCharge(t *testing.T) {
 	})
 }
 
+func TestAccCECostCategory_invalidRule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCostCategoryDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCostCategoryConfig_multipleOperands(rName),
+				ExpectError: regexache.MustCompile(`rule: exactly one of and, cost_category, dimension, not, or, tags must be specified`),
+			},
+		},
+	})
+}
+
 func TestAccCECostCategory_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var output awstypes.CostCategory
@@ -627,3 +645,28 @@ resource "aws_ce_cost_category" "test" {
 }
 `, rName)
 }
+
+func testAccCostCategoryConfig_multipleOperands(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+  rule {
+    value = "production"
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+      tags {
+        key           = "Environment"
+        values        = ["production"]
+        match_options = ["EQUALS"]
+      }
+    }
+    type = "REGULAR"
+  }
+}
+`, rName)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -830,6 +831,7 @@ func resourceDistribution() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"waiters": sdkv2.WaitersSchema(),
 			"web_acl_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -871,7 +873,7 @@ func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, met
 	d.SetId(aws.ToString(outputRaw.(*cloudfront.CreateDistributionWithTagsOutput).Distribution.Id))
 
 	if d.Get("wait_for_deployment").(bool) {
-		if _, err := waitDistributionDeployed(ctx, conn, d.Id()); err != nil {
+		if _, err := waitDistributionDeployed(ctx, conn, d.Id(), sdkv2.ExpandWaiterOptions(d.Get("waiters").([]interface{}))...); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Distribution (%s) deploy: %s", d.Id(), err)
 		}
 	}
@@ -975,7 +977,7 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "waiters") {
 		input := &cloudfront.UpdateDistributionInput{
 			DistributionConfig: expandDistributionConfig(d),
 			Id:                 aws.String(d.Id()),
@@ -1010,7 +1012,7 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 
 		if d.Get("wait_for_deployment").(bool) {
-			if _, err := waitDistributionDeployed(ctx, conn, d.Id()); err != nil {
+			if _, err := waitDistributionDeployed(ctx, conn, d.Id(), sdkv2.ExpandWaiterOptions(d.Get("waiters").([]interface{}))...); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Distribution (%s) deploy: %s", d.Id(), err)
 			}
 		}
@@ -1022,6 +1024,7 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+	optFns := sdkv2.ExpandWaiterOptions(d.Get("waiters").([]interface{}))
 
 	if d.Get(names.AttrARN).(string) == "" {
 		diags = append(diags, resourceDistributionRead(ctx, d, meta)...)
@@ -1035,13 +1038,13 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 		case err != nil:
 			return sdkdiag.AppendFromErr(diags, err)
 		default:
-			if _, err := waitDistributionDeployed(ctx, conn, d.Id()); err != nil && !tfresource.NotFound(err) {
+			if _, err := waitDistributionDeployed(ctx, conn, d.Id(), optFns...); err != nil && !tfresource.NotFound(err) {
 				return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Distribution (%s) deploy: %s", d.Id(), err)
 			}
 		}
 	}
 
-	if err := disableDistribution(ctx, conn, d.Id()); err != nil {
+	if err := disableDistribution(ctx, conn, d.Id(), optFns...); err != nil {
 		if tfresource.NotFound(err) {
 			return diags
 		}
@@ -1054,7 +1057,7 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 		return diags
 	}
 
-	err := deleteDistribution(ctx, conn, d.Id())
+	err := deleteDistribution(ctx, conn, d.Id(), optFns...)
 
 	if err == nil || tfresource.NotFound(err) || errs.IsA[*awstypes.NoSuchDistribution](err) {
 		return diags
@@ -1064,7 +1067,7 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 	// Here we update via the deployed configuration to ensure we are not submitting an out of date
 	// configuration from the Terraform configuration, should other changes have occurred manually.
 	if errs.IsA[*awstypes.DistributionNotDisabled](err) {
-		if err := disableDistribution(ctx, conn, d.Id()); err != nil {
+		if err := disableDistribution(ctx, conn, d.Id(), optFns...); err != nil {
 			if tfresource.NotFound(err) {
 				return diags
 			}
@@ -1076,7 +1079,7 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 			timeout = 3 * time.Minute
 		)
 		_, err = tfresource.RetryWhenIsA[*awstypes.DistributionNotDisabled](ctx, timeout, func() (interface{}, error) {
-			return nil, deleteDistribution(ctx, conn, d.Id())
+			return nil, deleteDistribution(ctx, conn, d.Id(), optFns...)
 		})
 	}
 
@@ -1085,12 +1088,12 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 			timeout = 1 * time.Minute
 		)
 		_, err = tfresource.RetryWhenIsOneOf2[*awstypes.PreconditionFailed, *awstypes.InvalidIfMatchVersion](ctx, timeout, func() (interface{}, error) {
-			return nil, deleteDistribution(ctx, conn, d.Id())
+			return nil, deleteDistribution(ctx, conn, d.Id(), optFns...)
 		})
 	}
 
 	if errs.IsA[*awstypes.DistributionNotDisabled](err) {
-		if err := disableDistribution(ctx, conn, d.Id()); err != nil {
+		if err := disableDistribution(ctx, conn, d.Id(), optFns...); err != nil {
 			if tfresource.NotFound(err) {
 				return diags
 			}
@@ -1098,7 +1101,7 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
-		err = deleteDistribution(ctx, conn, d.Id())
+		err = deleteDistribution(ctx, conn, d.Id(), optFns...)
 	}
 
 	if errs.IsA[*awstypes.NoSuchDistribution](err) { // nosemgrep:dgryski.semgrep-go.oddifsequence.odd-sequence-ifs
@@ -1112,7 +1115,7 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
-func deleteDistribution(ctx context.Context, conn *cloudfront.Client, id string) error {
+func deleteDistribution(ctx context.Context, conn *cloudfront.Client, id string, optFns ...tfresource.OptionsFunc) error {
 	etag, err := distroETag(ctx, conn, id)
 
 	if err != nil {
@@ -1130,7 +1133,7 @@ func deleteDistribution(ctx context.Context, conn *cloudfront.Client, id string)
 		return fmt.Errorf("deleting CloudFront Distribution (%s): %w", id, err)
 	}
 
-	if _, err := waitDistributionDeleted(ctx, conn, id); err != nil {
+	if _, err := waitDistributionDeleted(ctx, conn, id, optFns...); err != nil {
 		return fmt.Errorf("waiting for CloudFront Distribution (%s) delete: %w", id, err)
 	}
 
@@ -1147,7 +1150,7 @@ func distroETag(ctx context.Context, conn *cloudfront.Client, id string) (string
 	return aws.ToString(output.ETag), nil
 }
 
-func disableDistribution(ctx context.Context, conn *cloudfront.Client, id string) error {
+func disableDistribution(ctx context.Context, conn *cloudfront.Client, id string, optFns ...tfresource.OptionsFunc) error {
 	output, err := findDistributionByID(ctx, conn, id)
 
 	if err != nil {
@@ -1155,7 +1158,7 @@ func disableDistribution(ctx context.Context, conn *cloudfront.Client, id string
 	}
 
 	if aws.ToString(output.Distribution.Status) == distributionStatusInProgress {
-		output, err = waitDistributionDeployed(ctx, conn, id)
+		output, err = waitDistributionDeployed(ctx, conn, id, optFns...)
 
 		if err != nil {
 			return fmt.Errorf("waiting for CloudFront Distribution (%s) deploy: %w", id, err)
@@ -1179,7 +1182,7 @@ func disableDistribution(ctx context.Context, conn *cloudfront.Client, id string
 		return fmt.Errorf("updating CloudFront Distribution (%s): %w", id, err)
 	}
 
-	if _, err := waitDistributionDeployed(ctx, conn, id); err != nil {
+	if _, err := waitDistributionDeployed(ctx, conn, id, optFns...); err != nil {
 		return fmt.Errorf("waiting for CloudFront Distribution (%s) deploy: %w", id, err)
 	}
 
@@ -1231,7 +1234,12 @@ func statusDistribution(ctx context.Context, conn *cloudfront.Client, id string)
 	}
 }
 
-func waitDistributionDeployed(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetDistributionOutput, error) {
+func waitDistributionDeployed(ctx context.Context, conn *cloudfront.Client, id string, optFns ...tfresource.OptionsFunc) (*cloudfront.GetDistributionOutput, error) {
+	options := tfresource.Options{}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{distributionStatusInProgress},
 		Target:     []string{distributionStatusDeployed},
@@ -1240,6 +1248,7 @@ func waitDistributionDeployed(ctx context.Context, conn *cloudfront.Client, id s
 		MinTimeout: 15 * time.Second,
 		Delay:      30 * time.Second,
 	}
+	options.Apply(stateConf)
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
@@ -1250,7 +1259,12 @@ func waitDistributionDeployed(ctx context.Context, conn *cloudfront.Client, id s
 	return nil, err
 }
 
-func waitDistributionDeleted(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetDistributionOutput, error) {
+func waitDistributionDeleted(ctx context.Context, conn *cloudfront.Client, id string, optFns ...tfresource.OptionsFunc) (*cloudfront.GetDistributionOutput, error) {
+	options := tfresource.Options{}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{distributionStatusInProgress, distributionStatusDeployed},
 		Target:     []string{},
@@ -1259,6 +1273,7 @@ func waitDistributionDeleted(ctx context.Context, conn *cloudfront.Client, id st
 		MinTimeout: 15 * time.Second,
 		Delay:      15 * time.Second,
 	}
+	options.Apply(stateConf)
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
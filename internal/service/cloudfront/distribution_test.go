@@ -14,6 +14,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -1338,6 +1339,61 @@ func TestAccCloudFrontDistribution_waitForDeployment(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontDistribution_waiters(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var distribution awstypes.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfig_waiters("1m", "2m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					testAccCheckDistributionStatusDeployed(&distribution),
+					resource.TestCheckResourceAttr(resourceName, "waiters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "waiters.0.delay", "1m"),
+					resource.TestCheckResourceAttr(resourceName, "waiters.0.jitter", "30s"),
+					resource.TestCheckResourceAttr(resourceName, "waiters.0.poll_interval", "2m"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"retain_on_delete",
+					"wait_for_deployment",
+					"waiters",
+				},
+			},
+			{
+				Config: testAccDistributionConfig_waiters("2m", "1m"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					testAccCheckDistributionStatusDeployed(&distribution),
+					resource.TestCheckResourceAttr(resourceName, "waiters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "waiters.0.delay", "2m"),
+					resource.TestCheckResourceAttr(resourceName, "waiters.0.poll_interval", "1m"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudFrontDistribution_preconditionFailed(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3750,6 +3806,57 @@ resource "aws_cloudfront_distribution" "test" {
 `, enabled, waitForDeployment)
 }
 
+func testAccDistributionConfig_waiters(delay, pollInterval string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "test" {
+  enabled = false
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+
+  waiters {
+    delay         = %[1]q
+    jitter        = "30s"
+    poll_interval = %[2]q
+  }
+}
+`, delay, pollInterval)
+}
+
 func testAccDistributionCacheBehaviorRealtimeLogBaseConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kinesis_stream" "test" {
@@ -14,9 +14,12 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Cluster": {
-			acctest.CtBasic:      testAccCluster_basic,
-			acctest.CtDisappears: testAccCluster_disappears,
-			"tags":               testAccCluster_tags,
+			acctest.CtBasic:         testAccCluster_basic,
+			acctest.CtDisappears:    testAccCluster_disappears,
+			"backupRetentionPolicy": testAccCluster_backupRetentionPolicy,
+			"hsmType":               testAccCluster_hsmType,
+			"modeInvalid":           testAccCluster_modeInvalid,
+			"tags":                  testAccCluster_tags,
 		},
 		"Hsm": {
 			"availabilityZone":   testAccHSM_AvailabilityZone,
@@ -6,13 +6,16 @@ package cloudhsmv2
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
 	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -28,6 +31,18 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	hsmTypeHSM1Medium  = "hsm1.medium"
+	hsmTypeHSM2MMedium = "hsm2m.medium"
+)
+
+func hsmType_Values() []string {
+	return []string{
+		hsmTypeHSM1Medium,
+		hsmTypeHSM2MMedium,
+	}
+}
+
 // @SDKResource("aws_cloudhsm_v2_cluster", name="Cluster")
 // @Tags(identifierAttribute="id")
 func resourceCluster() *schema.Resource {
@@ -48,6 +63,26 @@ func resourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"backup_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.BackupRetentionType](),
+						},
+						names.AttrValue: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 379),
+						},
+					},
+				},
+			},
 			"cluster_certificates": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -88,7 +123,14 @@ func resourceCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"hsm1.medium"}, false),
+				ValidateFunc: validation.StringInSlice(hsmType_Values(), false),
+			},
+			names.AttrMode: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ClusterMode](),
 			},
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -113,7 +155,10 @@ func resourceCluster() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceClusterCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -127,6 +172,14 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		TagList:   getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BackupRetentionPolicy = expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrMode); ok {
+		input.Mode = types.ClusterMode(v.(string))
+	}
+
 	if v, ok := d.GetOk("source_backup_identifier"); ok {
 		input.SourceBackupId = aws.String(v.(string))
 	}
@@ -167,12 +220,20 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading CloudHSMv2 Cluster (%s): %s", d.Id(), err)
 	}
 
+	if cluster.BackupRetentionPolicy != nil {
+		if err := d.Set("backup_retention_policy", []interface{}{flattenBackupRetentionPolicy(cluster.BackupRetentionPolicy)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting backup_retention_policy: %s", err)
+		}
+	} else {
+		d.Set("backup_retention_policy", nil)
+	}
 	if err := d.Set("cluster_certificates", flattenCertificates(cluster)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cluster_certificates: %s", err)
 	}
 	d.Set("cluster_id", cluster.ClusterId)
 	d.Set("cluster_state", cluster.State)
 	d.Set("hsm_type", cluster.HsmType)
+	d.Set(names.AttrMode, cluster.Mode)
 	d.Set("security_group_id", cluster.SecurityGroup)
 	d.Set("source_backup_identifier", cluster.SourceBackupId)
 	d.Set(names.AttrSubnetIDs, tfmaps.Values(cluster.SubnetMapping))
@@ -185,8 +246,22 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client(ctx)
 
-	// Tags only.
+	if d.HasChange("backup_retention_policy") {
+		if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &cloudhsmv2.ModifyClusterInput{
+				BackupRetentionPolicy: expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{})),
+				ClusterId:             aws.String(d.Id()),
+			}
+
+			_, err := conn.ModifyCluster(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+			}
+		}
+	}
 
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
@@ -215,6 +290,15 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+func resourceClusterCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Only hsm2m.medium clusters can be created in non-FIPS mode.
+	if hsmType, mode := diff.Get("hsm_type").(string), types.ClusterMode(diff.Get(names.AttrMode).(string)); mode == types.ClusterModeNonFips && hsmType != hsmTypeHSM2MMedium {
+		return fmt.Errorf("%s mode is not supported for HSM type %s, use %s", mode, hsmType, hsmTypeHSM2MMedium)
+	}
+
+	return nil
+}
+
 func findClusterByID(ctx context.Context, conn *cloudhsmv2.Client, id string) (*types.Cluster, error) {
 	input := &cloudhsmv2.DescribeClustersInput{
 		Filters: map[string][]string{
@@ -351,17 +435,62 @@ func waitClusterUninitialized(ctx context.Context, conn *cloudhsmv2.Client, id s
 	return nil, err
 }
 
+func expandBackupRetentionPolicy(tfMap map[string]interface{}) *types.BackupRetentionPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.BackupRetentionPolicy{}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = types.BackupRetentionType(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(int); ok && v != 0 {
+		apiObject.Value = aws.String(strconv.Itoa(v))
+	}
+
+	return apiObject
+}
+
+func flattenBackupRetentionPolicy(apiObject *types.BackupRetentionPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrType: apiObject.Type,
+	}
+
+	if v, err := strconv.Atoi(aws.ToString(apiObject.Value)); err == nil {
+		tfMap[names.AttrValue] = v
+	}
+
+	return tfMap
+}
+
+// flattenCertificates returns all certificates that the API has made available for the cluster.
+// The CSR and hardware certificates are needed to sign the cluster certificate during
+// initialization and the cluster certificate once the cluster is active, so every
+// non-empty value is exposed regardless of cluster state.
 func flattenCertificates(apiObject *types.Cluster) []map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
-	if apiObject, clusterState := apiObject.Certificates, apiObject.State; apiObject != nil {
-		if clusterState == types.ClusterStateUninitialized {
-			tfMap["cluster_csr"] = aws.ToString(apiObject.ClusterCsr)
-			tfMap["aws_hardware_certificate"] = aws.ToString(apiObject.AwsHardwareCertificate)
-			tfMap["hsm_certificate"] = aws.ToString(apiObject.HsmCertificate)
-			tfMap["manufacturer_hardware_certificate"] = aws.ToString(apiObject.ManufacturerHardwareCertificate)
-		} else if clusterState == types.ClusterStateActive {
-			tfMap["cluster_certificate"] = aws.ToString(apiObject.ClusterCertificate)
+	if apiObject := apiObject.Certificates; apiObject != nil {
+		if v := apiObject.AwsHardwareCertificate; v != nil {
+			tfMap["aws_hardware_certificate"] = aws.ToString(v)
+		}
+		if v := apiObject.ClusterCertificate; v != nil {
+			tfMap["cluster_certificate"] = aws.ToString(v)
+		}
+		if v := apiObject.ClusterCsr; v != nil {
+			tfMap["cluster_csr"] = aws.ToString(v)
+		}
+		if v := apiObject.HsmCertificate; v != nil {
+			tfMap["hsm_certificate"] = aws.ToString(v)
+		}
+		if v := apiObject.ManufacturerHardwareCertificate; v != nil {
+			tfMap["manufacturer_hardware_certificate"] = aws.ToString(v)
 		}
 	}
 
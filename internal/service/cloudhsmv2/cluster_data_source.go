@@ -59,6 +59,14 @@ func dataSourceCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"hsm_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrMode: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"security_group_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -102,6 +110,8 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting cluster_certificates: %s", err)
 	}
 	d.Set("cluster_state", cluster.State)
+	d.Set("hsm_type", cluster.HsmType)
+	d.Set(names.AttrMode, cluster.Mode)
 	d.Set("security_group_id", cluster.SecurityGroup)
 	d.Set(names.AttrSubnetIDs, tfmaps.Values(cluster.SubnetMapping))
 	d.Set(names.AttrVPCID, cluster.VpcId)
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudsearch_domains", name="Domains")
+func dataSourceDomains() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDomainsRead,
+
+		Schema: map[string]*schema.Schema{
+			"domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"document_service_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"processing": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"requires_index_documents": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"search_instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"search_instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"search_partition_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"search_service_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudSearchClient(ctx)
+
+	domains, err := findDomains(ctx, conn, &cloudsearch.DescribeDomainsInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domains: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	var domainNames []string
+	var tfList []interface{}
+
+	for _, v := range domains {
+		domainNames = append(domainNames, aws.ToString(v.DomainName))
+		tfList = append(tfList, flattenDomainStatus(v))
+	}
+
+	if err := d.Set("domains", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting domains: %s", err)
+	}
+	d.Set(names.AttrNames, domainNames)
+
+	return diags
+}
+
+// findDomains returns all domains that are not being deleted.
+func findDomains(ctx context.Context, conn *cloudsearch.Client, input *cloudsearch.DescribeDomainsInput) ([]types.DomainStatus, error) {
+	output, err := conn.DescribeDomains(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	var domains []types.DomainStatus
+
+	for _, v := range output.DomainStatusList {
+		if aws.ToBool(v.Deleted) {
+			continue
+		}
+
+		domains = append(domains, v)
+	}
+
+	return domains, nil
+}
+
+func flattenDomainStatus(apiObject types.DomainStatus) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrARN:              aws.ToString(apiObject.ARN),
+		"domain_id":                aws.ToString(apiObject.DomainId),
+		names.AttrName:             aws.ToString(apiObject.DomainName),
+		"processing":               aws.ToBool(apiObject.Processing),
+		"requires_index_documents": aws.ToBool(apiObject.RequiresIndexDocuments),
+		"search_instance_count":    aws.ToInt32(apiObject.SearchInstanceCount),
+		"search_instance_type":     aws.ToString(apiObject.SearchInstanceType),
+		"search_partition_count":   aws.ToInt32(apiObject.SearchPartitionCount),
+	}
+
+	if v := apiObject.DocService; v != nil {
+		tfMap["document_service_endpoint"] = aws.ToString(v.Endpoint)
+	}
+
+	if v := apiObject.SearchService; v != nil {
+		tfMap["search_service_endpoint"] = aws.ToString(v.Endpoint)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudSearchDomainsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudsearch_domains.test"
+	resourceName := "aws_cloudsearch_domain.test"
+	rName := testAccDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudSearchEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "domains.#", 1),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", resourceName, names.AttrName),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "domains.*", map[string]string{
+						names.AttrName: rName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), `
+data "aws_cloudsearch_domains" "test" {
+  depends_on = [aws_cloudsearch_domain.test]
+}
+`)
+}
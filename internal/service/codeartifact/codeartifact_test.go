@@ -32,6 +32,15 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 			"disappearsDomain":   testAccDomainPermissionsPolicy_Disappears_domain,
 			"ignoreEquivalent":   testAccDomainPermissionsPolicy_ignoreEquivalent,
 		},
+		"PackageGroup": {
+			acctest.CtBasic:       testAccPackageGroup_basic,
+			acctest.CtDisappears:  testAccPackageGroup_disappears,
+			"originConfiguration": testAccPackageGroup_originConfiguration,
+			"tags":                testAccPackageGroup_tags,
+		},
+		"PackageOriginConfiguration": {
+			acctest.CtBasic: testAccPackageOriginConfiguration_basic,
+		},
 		"Repository": {
 			acctest.CtBasic:      testAccRepository_basic,
 			"description":        testAccRepository_description,
@@ -7,11 +7,15 @@ package codeartifact
 var (
 	ResourceDomain                      = resourceDomain
 	ResourceDomainPermissionsPolicy     = resourceDomainPermissionsPolicy
+	ResourcePackageGroup                = resourcePackageGroup
+	ResourcePackageOriginConfiguration  = resourcePackageOriginConfiguration
 	ResourceRepository                  = resourceRepository
 	ResourceRepositoryPermissionsPolicy = resourceRepositoryPermissionsPolicy
 
 	FindDomainByTwoPartKey                        = findDomainByTwoPartKey
 	FindDomainPermissionsPolicyByTwoPartKey       = findDomainPermissionsPolicyByTwoPartKey
+	FindPackageBySixPartKey                       = findPackageBySixPartKey
+	FindPackageGroupByThreePartKey                = findPackageGroupByThreePartKey
 	FindRepositoryByThreePartKey                  = findRepositoryByThreePartKey
 	FindRepositoryPermissionsPolicyByThreePartKey = findRepositoryPermissionsPolicyByThreePartKey
 )
@@ -0,0 +1,463 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_codeartifact_package_group", name="Package Group")
+// @Tags(identifierAttribute="arn")
+func resourcePackageGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageGroupCreate,
+		ReadWithoutTimeout:   resourcePackageGroupRead,
+		UpdateWithoutTimeout: resourcePackageGroupUpdate,
+		DeleteWithoutTimeout: resourcePackageGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_info": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"origin_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_upstream": packageGroupOriginRestrictionSchema(),
+						"internal_upstream": packageGroupOriginRestrictionSchema(),
+						"publish":           packageGroupOriginRestrictionSchema(),
+					},
+				},
+			},
+			"parent_pattern": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 520),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func packageGroupOriginRestrictionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"effective_restriction_mode": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"repositories": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"restriction_mode": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.PackageGroupOriginRestrictionMode](),
+				},
+			},
+		},
+	}
+}
+
+func resourcePackageGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	pattern := d.Get("pattern").(string)
+	input := &codeartifact.CreatePackageGroupInput{
+		Domain:       aws.String(d.Get(names.AttrDomain).(string)),
+		PackageGroup: aws.String(pattern),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("contact_info"); ok {
+		input.ContactInfo = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("domain_owner"); ok {
+		input.DomainOwner = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePackageGroup(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeArtifact Package Group (%s): %s", pattern, err)
+	}
+
+	packageGroup := output.PackageGroup
+	id, err := flex.FlattenResourceId([]string{aws.ToString(packageGroup.DomainOwner), aws.ToString(packageGroup.DomainName), aws.ToString(packageGroup.Pattern)}, packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if v, ok := d.GetOk("origin_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := updatePackageGroupOriginConfiguration(ctx, conn, packageGroup.DomainOwner, packageGroup.DomainName, packageGroup.Pattern, nil, v.([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+	packageGroup, err := findPackageGroupByThreePartKey(ctx, conn, owner, domainName, pattern)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeArtifact Package Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	originConfiguration, err := flattenPackageGroupOriginConfiguration(ctx, conn, packageGroup)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group (%s) allowed repositories: %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, packageGroup.Arn)
+	d.Set("contact_info", packageGroup.ContactInfo)
+	if packageGroup.CreatedTime != nil {
+		d.Set(names.AttrCreatedTime, packageGroup.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set(names.AttrDescription, packageGroup.Description)
+	d.Set(names.AttrDomain, packageGroup.DomainName)
+	d.Set("domain_owner", packageGroup.DomainOwner)
+	if err := d.Set("origin_configuration", originConfiguration); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting origin_configuration: %s", err)
+	}
+	if packageGroup.Parent != nil {
+		d.Set("parent_pattern", packageGroup.Parent.Pattern)
+	} else {
+		d.Set("parent_pattern", nil)
+	}
+	d.Set("pattern", packageGroup.Pattern)
+
+	return diags
+}
+
+func resourcePackageGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+
+	if d.HasChanges("contact_info", names.AttrDescription) {
+		input := &codeartifact.UpdatePackageGroupInput{
+			ContactInfo:  aws.String(d.Get("contact_info").(string)),
+			Description:  aws.String(d.Get(names.AttrDescription).(string)),
+			Domain:       aws.String(domainName),
+			DomainOwner:  aws.String(owner),
+			PackageGroup: aws.String(pattern),
+		}
+
+		_, err := conn.UpdatePackageGroup(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("origin_configuration") {
+		o, n := d.GetChange("origin_configuration")
+
+		if err := updatePackageGroupOriginConfiguration(ctx, conn, aws.String(owner), aws.String(domainName), aws.String(pattern), o.([]interface{}), n.([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting CodeArtifact Package Group: %s", d.Id())
+	_, err = conn.DeletePackageGroup(ctx, &codeartifact.DeletePackageGroupInput{
+		Domain:       aws.String(parts[1]),
+		DomainOwner:  aws.String(parts[0]),
+		PackageGroup: aws.String(parts[2]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const (
+	packageGroupResourceIDPartCount = 3
+)
+
+func findPackageGroupByThreePartKey(ctx context.Context, conn *codeartifact.Client, owner, domainName, pattern string) (*types.PackageGroupDescription, error) {
+	input := &codeartifact.DescribePackageGroupInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(owner),
+		PackageGroup: aws.String(pattern),
+	}
+
+	output, err := conn.DescribePackageGroup(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PackageGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PackageGroup, nil
+}
+
+func findPackageGroupAllowedRepositories(ctx context.Context, conn *codeartifact.Client, input *codeartifact.ListAllowedRepositoriesForGroupInput) ([]string, error) {
+	var output []string
+
+	pages := codeartifact.NewListAllowedRepositoriesForGroupPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AllowedRepositories...)
+	}
+
+	return output, nil
+}
+
+// packageGroupOriginRestrictionTypes maps origin_configuration blocks to API restriction types.
+var packageGroupOriginRestrictionTypes = map[string]types.PackageGroupOriginRestrictionType{
+	"external_upstream": types.PackageGroupOriginRestrictionTypeExternalUpstream,
+	"internal_upstream": types.PackageGroupOriginRestrictionTypeInternalUpstream,
+	"publish":           types.PackageGroupOriginRestrictionTypePublish,
+}
+
+func updatePackageGroupOriginConfiguration(ctx context.Context, conn *codeartifact.Client, owner, domainName, pattern *string, o, n []interface{}) error {
+	input := &codeartifact.UpdatePackageGroupOriginConfigurationInput{
+		Domain:       domainName,
+		DomainOwner:  owner,
+		PackageGroup: pattern,
+		Restrictions: make(map[string]types.PackageGroupOriginRestrictionMode),
+	}
+
+	oldMap, newMap := packageGroupOriginConfigurationMap(o), packageGroupOriginConfigurationMap(n)
+
+	for key, restrictionType := range packageGroupOriginRestrictionTypes {
+		oldMode, oldRepositories := packageGroupOriginRestriction(oldMap, key)
+		newMode, newRepositories := packageGroupOriginRestriction(newMap, key)
+
+		if newMode != "" && newMode != oldMode {
+			input.Restrictions[string(restrictionType)] = types.PackageGroupOriginRestrictionMode(newMode)
+		}
+
+		for _, v := range newRepositories.Difference(oldRepositories).List() {
+			input.AddAllowedRepositories = append(input.AddAllowedRepositories, types.PackageGroupAllowedRepository{
+				OriginRestrictionType: restrictionType,
+				RepositoryName:        aws.String(v.(string)),
+			})
+		}
+
+		for _, v := range oldRepositories.Difference(newRepositories).List() {
+			input.RemoveAllowedRepositories = append(input.RemoveAllowedRepositories, types.PackageGroupAllowedRepository{
+				OriginRestrictionType: restrictionType,
+				RepositoryName:        aws.String(v.(string)),
+			})
+		}
+	}
+
+	if len(input.Restrictions) == 0 && len(input.AddAllowedRepositories) == 0 && len(input.RemoveAllowedRepositories) == 0 {
+		return nil
+	}
+
+	_, err := conn.UpdatePackageGroupOriginConfiguration(ctx, input)
+
+	return err
+}
+
+func packageGroupOriginConfigurationMap(tfList []interface{}) map[string]interface{} {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	return tfList[0].(map[string]interface{})
+}
+
+func packageGroupOriginRestriction(tfMap map[string]interface{}, key string) (string, *schema.Set) {
+	repositories := schema.NewSet(schema.HashString, nil)
+
+	if tfMap == nil {
+		return "", repositories
+	}
+
+	tfList, ok := tfMap[key].([]interface{})
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return "", repositories
+	}
+
+	tfMap = tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["repositories"].(*schema.Set); ok {
+		repositories = v
+	}
+
+	return tfMap["restriction_mode"].(string), repositories
+}
+
+func flattenPackageGroupOriginConfiguration(ctx context.Context, conn *codeartifact.Client, apiObject *types.PackageGroupDescription) ([]interface{}, error) {
+	if apiObject.OriginConfiguration == nil {
+		return nil, nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for key, restrictionType := range packageGroupOriginRestrictionTypes {
+		restriction, ok := apiObject.OriginConfiguration.Restrictions[string(restrictionType)]
+		if !ok {
+			continue
+		}
+
+		tfRestriction := map[string]interface{}{
+			"effective_restriction_mode": restriction.EffectiveMode,
+			"restriction_mode":           restriction.Mode,
+		}
+
+		if restriction.Mode == types.PackageGroupOriginRestrictionModeAllowSpecificRepositories {
+			input := &codeartifact.ListAllowedRepositoriesForGroupInput{
+				Domain:                apiObject.DomainName,
+				DomainOwner:           apiObject.DomainOwner,
+				OriginRestrictionType: restrictionType,
+				PackageGroup:          apiObject.Pattern,
+			}
+
+			repositories, err := findPackageGroupAllowedRepositories(ctx, conn, input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			tfRestriction["repositories"] = repositories
+		}
+
+		tfMap[key] = []interface{}{tfRestriction}
+	}
+
+	return []interface{}{tfMap}, nil
+}
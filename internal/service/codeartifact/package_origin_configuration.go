@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_codeartifact_package_origin_configuration", name="Package Origin Configuration")
+func resourcePackageOriginConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageOriginConfigurationPut,
+		ReadWithoutTimeout:   resourcePackageOriginConfigurationRead,
+		UpdateWithoutTimeout: resourcePackageOriginConfigurationPut,
+		DeleteWithoutTimeout: resourcePackageOriginConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrFormat: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.PackageFormat](),
+			},
+			names.AttrNamespace: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"package": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"restrictions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"publish": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.AllowPublish](),
+						},
+						"upstream": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.AllowUpstream](),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePackageOriginConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	input := &codeartifact.PutPackageOriginConfigurationInput{
+		Domain:       aws.String(d.Get(names.AttrDomain).(string)),
+		Format:       types.PackageFormat(d.Get(names.AttrFormat).(string)),
+		Package:      aws.String(d.Get("package").(string)),
+		Repository:   aws.String(d.Get("repository").(string)),
+		Restrictions: expandPackageOriginRestrictions(d.Get("restrictions").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("domain_owner"); ok {
+		input.DomainOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrNamespace); ok {
+		input.Namespace = aws.String(v.(string))
+	}
+
+	_, err := conn.PutPackageOriginConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting CodeArtifact Package (%s) origin configuration: %s", aws.ToString(input.Package), err)
+	}
+
+	if d.IsNewResource() {
+		owner := aws.ToString(input.DomainOwner)
+		if owner == "" {
+			owner = meta.(*conns.AWSClient).AccountID
+		}
+
+		id, err := flex.FlattenResourceId([]string{owner, aws.ToString(input.Domain), aws.ToString(input.Repository), string(input.Format), aws.ToString(input.Namespace), aws.ToString(input.Package)}, packageOriginConfigurationResourceIDPartCount, true)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.SetId(id)
+	}
+
+	return append(diags, resourcePackageOriginConfigurationRead(ctx, d, meta)...)
+}
+
+func resourcePackageOriginConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageOriginConfigurationResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, repositoryName, format, namespace, packageName := parts[0], parts[1], parts[2], parts[3], parts[4], parts[5]
+	pkg, err := findPackageBySixPartKey(ctx, conn, owner, domainName, repositoryName, format, namespace, packageName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeArtifact Package Origin Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Origin Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrDomain, domainName)
+	d.Set("domain_owner", owner)
+	d.Set(names.AttrFormat, pkg.Format)
+	d.Set(names.AttrNamespace, pkg.Namespace)
+	d.Set("package", pkg.Name)
+	d.Set("repository", repositoryName)
+	if pkg.OriginConfiguration != nil {
+		if err := d.Set("restrictions", flattenPackageOriginRestrictions(pkg.OriginConfiguration.Restrictions)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting restrictions: %s", err)
+		}
+	} else {
+		d.Set("restrictions", nil)
+	}
+
+	return diags
+}
+
+func resourcePackageOriginConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageOriginConfigurationResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Origin controls can't be removed, so restore the service defaults.
+	input := &codeartifact.PutPackageOriginConfigurationInput{
+		Domain:      aws.String(parts[1]),
+		DomainOwner: aws.String(parts[0]),
+		Format:      types.PackageFormat(parts[3]),
+		Package:     aws.String(parts[5]),
+		Repository:  aws.String(parts[2]),
+		Restrictions: &types.PackageOriginRestrictions{
+			Publish:  types.AllowPublishAllow,
+			Upstream: types.AllowUpstreamAllow,
+		},
+	}
+
+	if parts[4] != "" {
+		input.Namespace = aws.String(parts[4])
+	}
+
+	log.Printf("[DEBUG] Deleting CodeArtifact Package Origin Configuration: %s", d.Id())
+	_, err = conn.PutPackageOriginConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeArtifact Package Origin Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const (
+	packageOriginConfigurationResourceIDPartCount = 6
+)
+
+func findPackageBySixPartKey(ctx context.Context, conn *codeartifact.Client, owner, domainName, repositoryName, format, namespace, packageName string) (*types.PackageDescription, error) {
+	input := &codeartifact.DescribePackageInput{
+		Domain:      aws.String(domainName),
+		DomainOwner: aws.String(owner),
+		Format:      types.PackageFormat(format),
+		Package:     aws.String(packageName),
+		Repository:  aws.String(repositoryName),
+	}
+	if namespace != "" {
+		input.Namespace = aws.String(namespace)
+	}
+
+	output, err := conn.DescribePackage(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Package == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Package, nil
+}
+
+func expandPackageOriginRestrictions(tfList []interface{}) *types.PackageOriginRestrictions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.PackageOriginRestrictions{
+		Publish:  types.AllowPublish(tfMap["publish"].(string)),
+		Upstream: types.AllowUpstream(tfMap["upstream"].(string)),
+	}
+}
+
+func flattenPackageOriginRestrictions(apiObject *types.PackageOriginRestrictions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"publish":  apiObject.Publish,
+		"upstream": apiObject.Upstream,
+	}
+
+	return []interface{}{tfMap}
+}
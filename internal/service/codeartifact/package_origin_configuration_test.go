@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeartifact "github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The package must already exist in the repository, so these tests are
+// configured through environment variables.
+func testAccPackageOriginConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := acctest.SkipIfEnvVarNotSet(t, "CODEARTIFACT_DOMAIN")
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, "CODEARTIFACT_REPOSITORY")
+	packageName := acctest.SkipIfEnvVarNotSet(t, "CODEARTIFACT_NPM_PACKAGE")
+	resourceName := "aws_codeartifact_package_origin_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageOriginConfigurationConfig_basic(domainName, repositoryName, packageName, "BLOCK", "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageOriginConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "npm"),
+					resource.TestCheckResourceAttr(resourceName, "package", packageName),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.publish", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.upstream", "ALLOW"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageOriginConfigurationConfig_basic(domainName, repositoryName, packageName, "ALLOW", "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageOriginConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.publish", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.upstream", "BLOCK"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackageOriginConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactClient(ctx)
+
+		_, err := tfcodeartifact.FindPackageBySixPartKey(ctx, conn, rs.Primary.Attributes["domain_owner"], rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes["repository"], rs.Primary.Attributes[names.AttrFormat], rs.Primary.Attributes[names.AttrNamespace], rs.Primary.Attributes["package"])
+
+		return err
+	}
+}
+
+func testAccPackageOriginConfigurationConfig_basic(domainName, repositoryName, packageName, publish, upstream string) string {
+	return fmt.Sprintf(`
+resource "aws_codeartifact_package_origin_configuration" "test" {
+  domain     = %[1]q
+  repository = %[2]q
+  format     = "npm"
+  package    = %[3]q
+
+  restrictions {
+    publish  = %[4]q
+    upstream = %[5]q
+  }
+}
+`, domainName, repositoryName, packageName, publish, upstream)
+}
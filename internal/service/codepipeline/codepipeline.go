@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -217,6 +218,20 @@ func resourcePipeline() *schema.Resource {
 								validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_.@-]+`), ""),
 							),
 						},
+						"on_failure": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"result": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.Result](),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -450,7 +465,10 @@ func resourcePipeline() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePipelineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -576,6 +594,44 @@ func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+func resourcePipelineCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Changing pipeline_type is an in-place update, but V2-only features must be removed
+	// when downgrading to V1 and can only be added once the pipeline is (or becomes) V2.
+	if !d.NewValueKnown("pipeline_type") {
+		return nil
+	}
+
+	if pipelineType := types.PipelineType(d.Get("pipeline_type").(string)); pipelineType == types.PipelineTypeV2 {
+		return nil
+	}
+
+	if v, ok := d.GetOk("execution_mode"); ok && d.NewValueKnown("execution_mode") {
+		if executionMode := types.ExecutionMode(v.(string)); executionMode != types.ExecutionModeSuperseded {
+			return fmt.Errorf("execution_mode %q requires pipeline_type %q", executionMode, types.PipelineTypeV2)
+		}
+	}
+
+	for _, k := range []string{"trigger", "variable"} {
+		if v, ok := d.GetOk(k); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("%s requires pipeline_type %q", k, types.PipelineTypeV2)
+		}
+	}
+
+	for i, tfMapRaw := range d.Get(names.AttrStage).([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["on_failure"].([]interface{}); ok && len(v) > 0 {
+			return fmt.Errorf("stage.%d.on_failure requires pipeline_type %q", i, types.PipelineTypeV2)
+		}
+	}
+
+	return nil
+}
+
 func findPipelineByName(ctx context.Context, conn *codepipeline.Client, name string) (*codepipeline.GetPipelineOutput, error) {
 	input := &codepipeline.GetPipelineInput{
 		Name: aws.String(name),
@@ -795,6 +851,24 @@ func expandStageDeclaration(tfMap map[string]interface{}) *types.StageDeclaratio
 		apiObject.Name = aws.String(v)
 	}
 
+	if v, ok := tfMap["on_failure"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnFailure = expandFailureConditions(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandFailureConditions(tfMap map[string]interface{}) *types.FailureConditions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.FailureConditions{}
+
+	if v, ok := tfMap["result"].(string); ok && v != "" {
+		apiObject.Result = types.Result(v)
+	}
+
 	return apiObject
 }
 
@@ -1282,6 +1356,22 @@ func flattenStageDeclaration(d *schema.ResourceData, i int, apiObject types.Stag
 		tfMap[names.AttrName] = aws.ToString(v)
 	}
 
+	if v := apiObject.OnFailure; v != nil {
+		tfMap["on_failure"] = []interface{}{flattenFailureConditions(v)}
+	}
+
+	return tfMap
+}
+
+func flattenFailureConditions(apiObject *types.FailureConditions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"result": apiObject.Result,
+	}
+
 	return tfMap
 }
 
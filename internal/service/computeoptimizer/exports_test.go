@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+// Exports for use in tests only.
+var (
+	ResourceRecommendationPreferences = resourceRecommendationPreferences
+
+	FindRecommendationPreferencesByThreePartKey = findRecommendationPreferencesByThreePartKey
+)
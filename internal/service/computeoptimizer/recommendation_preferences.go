@@ -0,0 +1,530 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_computeoptimizer_recommendation_preferences", name="Recommendation Preferences")
+func resourceRecommendationPreferences() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecommendationPreferencesCreate,
+		ReadWithoutTimeout:   resourceRecommendationPreferencesRead,
+		UpdateWithoutTimeout: resourceRecommendationPreferencesUpdate,
+		DeleteWithoutTimeout: resourceRecommendationPreferencesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enhanced_infrastructure_metrics": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.EnhancedInfrastructureMetrics](),
+			},
+			"external_metrics_preference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrSource: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ExternalMetricsSource](),
+						},
+					},
+				},
+			},
+			"inferred_workload_types": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.InferredWorkloadTypesPreference](),
+			},
+			"look_back_period": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.LookBackPeriodPreference](),
+			},
+			"preferred_resource": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclude_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 1000,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"include_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 1000,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrName: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PreferredResourceName](),
+						},
+					},
+				},
+			},
+			names.AttrResourceType: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ResourceType](),
+			},
+			"savings_estimation_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.SavingsEstimationMode](),
+			},
+			names.AttrScope: {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ScopeName](),
+						},
+						names.AttrValue: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"utilization_preference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.CustomizableMetricName](),
+						},
+						"metric_parameters": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"headroom": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.CustomizableMetricHeadroom](),
+									},
+									"threshold": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.CustomizableMetricThreshold](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	recommendationPreferencesResourceIDPartCount = 3
+)
+
+// recommendationPreferenceAttributes maps each deletable recommendation preference to its attribute.
+var recommendationPreferenceAttributes = map[awstypes.RecommendationPreferenceName]string{
+	awstypes.RecommendationPreferenceNameEnhancedInfrastructureMetrics: "enhanced_infrastructure_metrics",
+	awstypes.RecommendationPreferenceNameExternalMetricsPreference:     "external_metrics_preference",
+	awstypes.RecommendationPreferenceNameInferredWorkloadTypes:         "inferred_workload_types",
+	awstypes.RecommendationPreferenceNameLookBackPeriodPreference:      "look_back_period",
+	awstypes.RecommendationPreferenceNamePreferredResources:            "preferred_resource",
+	awstypes.RecommendationPreferenceNameUtilizationPreferences:        "utilization_preference",
+}
+
+func resourceRecommendationPreferencesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+	resourceType := d.Get(names.AttrResourceType).(string)
+	scope := expandScope(d.Get(names.AttrScope).([]interface{})[0].(map[string]interface{}))
+	id := errs.Must(flex.FlattenResourceId([]string{resourceType, string(scope.Name), aws.ToString(scope.Value)}, recommendationPreferencesResourceIDPartCount, false))
+	input := expandPutRecommendationPreferencesInput(d)
+
+	_, err := conn.PutRecommendationPreferences(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Compute Optimizer Recommendation Preferences (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceRecommendationPreferencesRead(ctx, d, meta)...)
+}
+
+func resourceRecommendationPreferencesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), recommendationPreferencesResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resourceType, scopeName, scopeValue := parts[0], parts[1], parts[2]
+
+	output, err := findRecommendationPreferencesByThreePartKey(ctx, conn, resourceType, scopeName, scopeValue)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Compute Optimizer Recommendation Preferences (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Compute Optimizer Recommendation Preferences (%s): %s", d.Id(), err)
+	}
+
+	d.Set("enhanced_infrastructure_metrics", output.EnhancedInfrastructureMetrics)
+	if output.ExternalMetricsPreference != nil {
+		if err := d.Set("external_metrics_preference", []interface{}{map[string]interface{}{
+			names.AttrSource: string(output.ExternalMetricsPreference.Source),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting external_metrics_preference: %s", err)
+		}
+	} else {
+		d.Set("external_metrics_preference", nil)
+	}
+	d.Set("inferred_workload_types", output.InferredWorkloadTypes)
+	d.Set("look_back_period", output.LookBackPeriod)
+	if err := d.Set("preferred_resource", flattenEffectivePreferredResources(output.PreferredResources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting preferred_resource: %s", err)
+	}
+	d.Set(names.AttrResourceType, output.ResourceType)
+	d.Set("savings_estimation_mode", output.SavingsEstimationMode)
+	if err := d.Set(names.AttrScope, []interface{}{map[string]interface{}{
+		names.AttrName:  scopeName,
+		names.AttrValue: scopeValue,
+	}}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting scope: %s", err)
+	}
+	if err := d.Set("utilization_preference", flattenUtilizationPreferences(output.UtilizationPreferences)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting utilization_preference: %s", err)
+	}
+
+	return diags
+}
+
+func resourceRecommendationPreferencesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+	// Preferences removed from the configuration must be deleted explicitly.
+	var removed []awstypes.RecommendationPreferenceName
+	for name, k := range recommendationPreferenceAttributes {
+		if !d.HasChange(k) {
+			continue
+		}
+
+		if o, n := d.GetChange(k); !isZeroPreferenceValue(o) && isZeroPreferenceValue(n) {
+			removed = append(removed, name)
+		}
+	}
+
+	if len(removed) > 0 {
+		scope := expandScope(d.Get(names.AttrScope).([]interface{})[0].(map[string]interface{}))
+		input := &computeoptimizer.DeleteRecommendationPreferencesInput{
+			RecommendationPreferenceNames: removed,
+			ResourceType:                  awstypes.ResourceType(d.Get(names.AttrResourceType).(string)),
+			Scope:                         scope,
+		}
+
+		_, err := conn.DeleteRecommendationPreferences(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Compute Optimizer Recommendation Preferences (%s): %s", d.Id(), err)
+		}
+	}
+
+	input := expandPutRecommendationPreferencesInput(d)
+
+	_, err := conn.PutRecommendationPreferences(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Compute Optimizer Recommendation Preferences (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRecommendationPreferencesRead(ctx, d, meta)...)
+}
+
+func resourceRecommendationPreferencesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+	var preferenceNames []awstypes.RecommendationPreferenceName
+	for name, k := range recommendationPreferenceAttributes {
+		if !isZeroPreferenceValue(d.Get(k)) {
+			preferenceNames = append(preferenceNames, name)
+		}
+	}
+
+	if len(preferenceNames) == 0 {
+		return diags
+	}
+
+	log.Printf("[INFO] Deleting Compute Optimizer Recommendation Preferences: %s", d.Id())
+	_, err := conn.DeleteRecommendationPreferences(ctx, &computeoptimizer.DeleteRecommendationPreferencesInput{
+		RecommendationPreferenceNames: preferenceNames,
+		ResourceType:                  awstypes.ResourceType(d.Get(names.AttrResourceType).(string)),
+		Scope:                         expandScope(d.Get(names.AttrScope).([]interface{})[0].(map[string]interface{})),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Compute Optimizer Recommendation Preferences (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findRecommendationPreferencesByThreePartKey(ctx context.Context, conn *computeoptimizer.Client, resourceType, scopeName, scopeValue string) (*awstypes.RecommendationPreferencesDetail, error) {
+	input := &computeoptimizer.GetRecommendationPreferencesInput{
+		ResourceType: awstypes.ResourceType(resourceType),
+		Scope: &awstypes.Scope{
+			Name:  awstypes.ScopeName(scopeName),
+			Value: aws.String(scopeValue),
+		},
+	}
+
+	output, err := findRecommendationPreferences(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if v.Scope == nil || string(v.Scope.Name) != scopeName || aws.ToString(v.Scope.Value) != scopeValue {
+			continue
+		}
+
+		return &v, nil
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func findRecommendationPreferences(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetRecommendationPreferencesInput) ([]awstypes.RecommendationPreferencesDetail, error) {
+	var output []awstypes.RecommendationPreferencesDetail
+
+	pages := computeoptimizer.NewGetRecommendationPreferencesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RecommendationPreferencesDetails...)
+	}
+
+	return output, nil
+}
+
+func isZeroPreferenceValue(v interface{}) bool {
+	switch v := v.(type) {
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return v == nil
+	}
+}
+
+func expandPutRecommendationPreferencesInput(d *schema.ResourceData) *computeoptimizer.PutRecommendationPreferencesInput {
+	input := &computeoptimizer.PutRecommendationPreferencesInput{
+		ResourceType: awstypes.ResourceType(d.Get(names.AttrResourceType).(string)),
+		Scope:        expandScope(d.Get(names.AttrScope).([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("enhanced_infrastructure_metrics"); ok {
+		input.EnhancedInfrastructureMetrics = awstypes.EnhancedInfrastructureMetrics(v.(string))
+	}
+
+	if v, ok := d.GetOk("external_metrics_preference"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ExternalMetricsPreference = &awstypes.ExternalMetricsPreference{
+			Source: awstypes.ExternalMetricsSource(v.([]interface{})[0].(map[string]interface{})[names.AttrSource].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("inferred_workload_types"); ok {
+		input.InferredWorkloadTypes = awstypes.InferredWorkloadTypesPreference(v.(string))
+	}
+
+	if v, ok := d.GetOk("look_back_period"); ok {
+		input.LookBackPeriod = awstypes.LookBackPeriodPreference(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_resource"); ok && len(v.([]interface{})) > 0 {
+		input.PreferredResources = expandPreferredResources(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("savings_estimation_mode"); ok {
+		input.SavingsEstimationMode = awstypes.SavingsEstimationMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("utilization_preference"); ok && len(v.([]interface{})) > 0 {
+		input.UtilizationPreferences = expandUtilizationPreferences(v.([]interface{}))
+	}
+
+	return input
+}
+
+func expandScope(tfMap map[string]interface{}) *awstypes.Scope {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &awstypes.Scope{
+		Name:  awstypes.ScopeName(tfMap[names.AttrName].(string)),
+		Value: aws.String(tfMap[names.AttrValue].(string)),
+	}
+}
+
+func expandPreferredResources(tfList []interface{}) []awstypes.PreferredResource {
+	var apiObjects []awstypes.PreferredResource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.PreferredResource{
+			Name: awstypes.PreferredResourceName(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap["exclude_list"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ExcludeList = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["include_list"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.IncludeList = flex.ExpandStringValueSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandUtilizationPreferences(tfList []interface{}) []awstypes.UtilizationPreference {
+	var apiObjects []awstypes.UtilizationPreference
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.UtilizationPreference{
+			MetricName: awstypes.CustomizableMetricName(tfMap["metric_name"].(string)),
+		}
+
+		if v, ok := tfMap["metric_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.MetricParameters = &awstypes.CustomizableMetricParameters{
+				Headroom: awstypes.CustomizableMetricHeadroom(tfMap["headroom"].(string)),
+			}
+
+			if v, ok := tfMap["threshold"].(string); ok && v != "" {
+				apiObject.MetricParameters.Threshold = awstypes.CustomizableMetricThreshold(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEffectivePreferredResources(apiObjects []awstypes.EffectivePreferredResource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"exclude_list": apiObject.ExcludeList,
+			"include_list": apiObject.IncludeList,
+			names.AttrName: string(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenUtilizationPreferences(apiObjects []awstypes.UtilizationPreference) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"metric_name": string(apiObject.MetricName),
+		}
+
+		if v := apiObject.MetricParameters; v != nil {
+			tfMap["metric_parameters"] = []interface{}{map[string]interface{}{
+				"headroom":  string(v.Headroom),
+				"threshold": string(v.Threshold),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
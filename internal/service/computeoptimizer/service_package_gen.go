@@ -28,7 +28,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceRecommendationPreferences,
+			TypeName: "aws_computeoptimizer_recommendation_preferences",
+			Name:     "Recommendation Preferences",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -115,6 +115,7 @@ func TestAccConnect_serial(t *testing.T) {
 			acctest.CtDisappears: testAccSecurityProfile_disappears,
 			"tags":               testAccSecurityProfile_updateTags,
 			"permissions":        testAccSecurityProfile_updatePermissions,
+			"applications":       testAccSecurityProfile_updateApplications,
 			"dataSource_id":      testAccSecurityProfileDataSource_securityProfileID,
 			"dataSource_name":    testAccSecurityProfileDataSource_name,
 		},
@@ -52,7 +52,7 @@ func ResourceContactFlowModule() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     validation.StringIsJSON,
+				ValidateFunc:     validation.All(validation.StringIsJSON, validContactFlowModuleContent),
 				ConflictsWith:    []string{"filename"},
 				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 				StateFunc: func(v interface{}) string {
@@ -279,5 +279,8 @@ func resourceContactFlowModuleLoadFileContent(filename string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if err := validateContactFlowModuleContent(string(fileContent)); err != nil {
+		return "", fmt.Errorf("invalid contact flow module: %w", err)
+	}
 	return string(fileContent), nil
 }
@@ -41,6 +41,9 @@ const (
 	// ListRoutingProfilesMaxResults Valid Range: Minimum value of 1. Maximum value of 1000.
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListRoutingProfiles.html
 	ListRoutingProfilesMaxResults = 60
+	// ListSecurityProfileApplicationsMaxResults Valid Range: Minimum value of 1. Maximum value of 1000.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListSecurityProfileApplications.html
+	ListSecurityProfileApplicationsMaxResults = 60
 	// ListSecurityProfilePermissionsMaxResults Valid Range: Minimum value of 1. Maximum value of 1000.
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListSecurityProfilePermissions.html
 	ListSecurityProfilePermissionsMaxResults = 60
@@ -36,6 +36,30 @@ func ResourceSecurityProfile() *schema.Resource {
 		},
 		CustomizeDiff: verify.SetTagsDiff,
 		Schema: map[string]*schema.Schema{
+			"application": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_permissions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+						names.AttrNamespace: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+					},
+				},
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -91,6 +115,10 @@ func resourceSecurityProfileCreate(ctx context.Context, d *schema.ResourceData,
 		Tags:                getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("application"); ok && v.(*schema.Set).Len() > 0 {
+		input.Applications = expandApplications(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -163,6 +191,17 @@ func resourceSecurityProfileRead(ctx context.Context, d *schema.ResourceData, me
 		d.Set(names.AttrPermissions, flex.FlattenStringSet(permissions))
 	}
 
+	// reading applications requires a separate API call
+	applications, err := getSecurityProfileApplications(ctx, conn, instanceID, securityProfileID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "finding Connect Security Profile Applications for Security Profile (%s): %s", securityProfileID, err)
+	}
+
+	if err := d.Set("application", flattenApplications(applications)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting application: %s", err)
+	}
+
 	setTagsOut(ctx, resp.SecurityProfile.Tags)
 
 	return diags
@@ -184,6 +223,10 @@ func resourceSecurityProfileUpdate(ctx context.Context, d *schema.ResourceData,
 		SecurityProfileId: aws.String(securityProfileID),
 	}
 
+	if d.HasChange("application") {
+		input.Applications = expandApplications(d.Get("application").(*schema.Set).List())
+	}
+
 	if d.HasChange(names.AttrDescription) {
 		input.Description = aws.String(d.Get(names.AttrDescription).(string))
 	}
@@ -259,3 +302,73 @@ func getSecurityProfilePermissions(ctx context.Context, conn *connect.Connect, i
 
 	return result, nil
 }
+
+func getSecurityProfileApplications(ctx context.Context, conn *connect.Connect, instanceID, securityProfileID string) ([]*connect.Application, error) {
+	var result []*connect.Application
+
+	input := &connect.ListSecurityProfileApplicationsInput{
+		InstanceId:        aws.String(instanceID),
+		MaxResults:        aws.Int64(ListSecurityProfileApplicationsMaxResults),
+		SecurityProfileId: aws.String(securityProfileID),
+	}
+
+	err := conn.ListSecurityProfileApplicationsPagesWithContext(ctx, input, func(page *connect.ListSecurityProfileApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		result = append(result, page.Applications...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func expandApplications(tfList []interface{}) []*connect.Application {
+	apiObjects := make([]*connect.Application, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &connect.Application{}
+
+		if v, ok := tfMap["application_permissions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ApplicationPermissions = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap[names.AttrNamespace].(string); ok && v != "" {
+			apiObject.Namespace = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenApplications(apiObjects []*connect.Application) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"application_permissions": flex.FlattenStringSet(apiObject.ApplicationPermissions),
+			names.AttrNamespace:       aws.StringValue(apiObject.Namespace),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -121,6 +121,66 @@ func testAccSecurityProfile_updatePermissions(t *testing.T) {
 	})
 }
 
+func testAccSecurityProfile_updateApplications(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeSecurityProfileOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_security_profile.test"
+	// The namespace of a third-party application integrated with Amazon Connect.
+	namespace := acctest.SkipIfEnvVarNotSet(t, "CONNECT_SECURITY_PROFILE_APPLICATION_NAMESPACE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityProfileConfig_applications(rName, rName2, namespace),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "application.*", map[string]string{
+						"application_permissions.#": acctest.Ct1,
+						names.AttrNamespace:         namespace,
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "application.*.application_permissions.*", "ACCESS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Test removing applications
+				Config: testAccSecurityProfileConfig_basic(rName, rName2, "TestApplicationsUpdate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Test adding applications
+				Config: testAccSecurityProfileConfig_applications(rName, rName2, namespace),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "application.*", map[string]string{
+						names.AttrNamespace: namespace,
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccSecurityProfile_updateTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v connect.DescribeSecurityProfileOutput
@@ -313,6 +373,27 @@ resource "aws_connect_security_profile" "test" {
 `, rName2, label))
 }
 
+func testAccSecurityProfileConfig_applications(rName, rName2, namespace string) string {
+	return acctest.ConfigCompose(
+		testAccSecurityProfileConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_security_profile" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = "TestApplicationsUpdate"
+
+  application {
+    namespace               = %[2]q
+    application_permissions = ["ACCESS"]
+  }
+
+  tags = {
+    "Name" = "Test Security Profile"
+  }
+}
+`, rName2, namespace))
+}
+
 func testAccSecurityProfileConfig_tags(rName, rName2, label string) string {
 	return acctest.ConfigCompose(
 		testAccSecurityProfileConfig_base(rName),
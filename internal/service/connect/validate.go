@@ -4,6 +4,7 @@
 package connect
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/YakDriver/regexache"
@@ -24,3 +25,62 @@ func validPhoneNumberPrefix(v interface{}, k string) (ws []string, errors []erro
 	}
 	return
 }
+
+type contactFlowModuleContent struct {
+	Actions []struct {
+		Identifier string `json:"Identifier"`
+		Type       string `json:"Type"`
+	} `json:"Actions"`
+	StartAction string `json:"StartAction"`
+	Version     string `json:"Version"`
+}
+
+// validateContactFlowModuleContent checks that a flow module document has the
+// structure the Amazon Connect Flow language requires, so that malformed
+// content is reported before the API rejects it.
+func validateContactFlowModuleContent(content string) error {
+	var doc contactFlowModuleContent
+
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	if doc.Version == "" {
+		return fmt.Errorf("missing Version")
+	}
+
+	if len(doc.Actions) == 0 {
+		return fmt.Errorf("missing Actions")
+	}
+
+	identifiers := make(map[string]struct{}, len(doc.Actions))
+	for i, action := range doc.Actions {
+		if action.Identifier == "" {
+			return fmt.Errorf("Actions[%d]: missing Identifier", i)
+		}
+		if action.Type == "" {
+			return fmt.Errorf("Actions[%d] (%s): missing Type", i, action.Identifier)
+		}
+		if _, ok := identifiers[action.Identifier]; ok {
+			return fmt.Errorf("Actions[%d]: duplicate Identifier %q", i, action.Identifier)
+		}
+		identifiers[action.Identifier] = struct{}{}
+	}
+
+	if doc.StartAction == "" {
+		return fmt.Errorf("missing StartAction")
+	}
+
+	if _, ok := identifiers[doc.StartAction]; !ok {
+		return fmt.Errorf("StartAction %q does not match any action Identifier", doc.StartAction)
+	}
+
+	return nil
+}
+
+func validContactFlowModuleContent(v interface{}, k string) (ws []string, errors []error) {
+	if err := validateContactFlowModuleContent(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid contact flow module: %w", k, err))
+	}
+	return
+}
@@ -64,3 +64,32 @@ func TestValidPhoneNumberPrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidContactFlowModuleContent(t *testing.T) {
+	t.Parallel()
+
+	validContents := []string{
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"MessageParticipant"},{"Identifier":"b","Type":"EndFlowModuleExecution"}]}`,
+	}
+	for _, v := range validContents {
+		_, errors := validContactFlowModuleContent(v, names.AttrContent)
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid contact flow module content: %q", v, errors)
+		}
+	}
+
+	invalidContents := []string{
+		`not json`,
+		`{}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[]}`,
+		`{"Version":"2019-10-30","StartAction":"c","Actions":[{"Identifier":"a","Type":"MessageParticipant"}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a"}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"MessageParticipant"},{"Identifier":"a","Type":"EndFlowModuleExecution"}]}`,
+	}
+	for _, v := range invalidContents {
+		_, errors := validContactFlowModuleContent(v, names.AttrContent)
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid contact flow module content", v)
+		}
+	}
+}
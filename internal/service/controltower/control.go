@@ -5,16 +5,20 @@ package controltower
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
 	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -23,6 +27,7 @@ import (
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_controltower_control", name="Control")
@@ -30,6 +35,7 @@ func resourceControl() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceControlCreate,
 		ReadWithoutTimeout:   resourceControlRead,
+		UpdateWithoutTimeout: resourceControlUpdate,
 		DeleteWithoutTimeout: resourceControlDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -38,16 +44,26 @@ func resourceControl() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"control_identifier": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrParameters: enabledParametersSchema(),
 			"target_identifier": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -55,7 +71,48 @@ func resourceControl() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 		},
+
+		CustomizeDiff: resourceControlCustomizeDiff,
+	}
+}
+
+// enabledParametersSchema returns the schema shared by enabled controls and enabled baselines.
+// Each parameter value is a JSON-encoded document.
+func enabledParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrKey: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrValue: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsJSON,
+					StateFunc: func(v interface{}) string {
+						json, _ := structure.NormalizeJsonString(v)
+						return json
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceControlCustomizeDiff plans a reset of the enabled control when Control Tower reports that it has drifted.
+func resourceControlCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
 	}
+
+	if v := d.Get("drift_status").(string); v == string(types.DriftStatusDrifted) {
+		return d.SetNewComputed("drift_status")
+	}
+
+	return nil
 }
 
 func resourceControlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -71,7 +128,16 @@ func resourceControlCreate(ctx context.Context, d *schema.ResourceData, meta int
 		TargetIdentifier:  aws.String(targetIdentifier),
 	}
 
-	output, err := conn.EnableControl(ctx, input)
+	if v, ok := d.GetOk(names.AttrParameters); ok && v.(*schema.Set).Len() > 0 {
+		parameters, err := expandEnabledControlParameters(v.(*schema.Set).List())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Parameters = parameters
+	}
+
+	operationID, err := enableControl(ctx, conn, input, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating ControlTower Control (%s): %s", id, err)
@@ -79,7 +145,7 @@ func resourceControlCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(id)
 
-	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitOperationSucceeded(ctx, conn, operationID, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Control (%s) create: %s", d.Id(), err)
 	}
 
@@ -109,12 +175,99 @@ func resourceControlRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading ControlTower Control (%s): %s", d.Id(), err)
 	}
 
+	d.Set(names.AttrARN, output.Arn)
 	d.Set("control_identifier", output.ControlIdentifier)
+	if output.DriftStatusSummary != nil {
+		d.Set("drift_status", output.DriftStatusSummary.DriftStatus)
+	} else {
+		d.Set("drift_status", nil)
+	}
 	d.Set("target_identifier", targetIdentifier)
 
+	enabledControl, err := findEnabledControlByARN(ctx, conn, aws.ToString(output.Arn))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ControlTower Control (%s): %s", d.Id(), err)
+	}
+
+	parameters, err := flattenEnabledControlParameterSummaries(enabledControl.Parameters)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := d.Set(names.AttrParameters, parameters); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+	}
+
 	return diags
 }
 
+func resourceControlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
+
+	parameters, err := expandEnabledControlParameters(d.Get(names.AttrParameters).(*schema.Set).List())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// A drifted control can't be updated in place, so reset it by disabling and re-enabling it.
+	if o, _ := d.GetChange("drift_status"); o.(string) == string(types.DriftStatusDrifted) {
+		controlIdentifier, targetIdentifier := d.Get("control_identifier").(string), d.Get("target_identifier").(string)
+
+		operationID, err := disableControl(ctx, conn, &controltower.DisableControlInput{
+			ControlIdentifier: aws.String(controlIdentifier),
+			TargetIdentifier:  aws.String(targetIdentifier),
+		}, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "resetting ControlTower Control (%s): disabling: %s", d.Id(), err)
+		}
+
+		if _, err := waitOperationSucceeded(ctx, conn, operationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Control (%s) disable: %s", d.Id(), err)
+		}
+
+		operationID, err = enableControl(ctx, conn, &controltower.EnableControlInput{
+			ControlIdentifier: aws.String(controlIdentifier),
+			Parameters:        parameters,
+			TargetIdentifier:  aws.String(targetIdentifier),
+		}, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "resetting ControlTower Control (%s): enabling: %s", d.Id(), err)
+		}
+
+		if _, err := waitOperationSucceeded(ctx, conn, operationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Control (%s) enable: %s", d.Id(), err)
+		}
+
+		return append(diags, resourceControlRead(ctx, d, meta)...)
+	}
+
+	if d.HasChange(names.AttrParameters) {
+		input := &controltower.UpdateEnabledControlInput{
+			EnabledControlIdentifier: aws.String(d.Get(names.AttrARN).(string)),
+			Parameters:               parameters,
+		}
+
+		outputRaw, err := tfresource.RetryWhenIsA[*types.ConflictException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateEnabledControl(ctx, input)
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ControlTower Control (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(outputRaw.(*controltower.UpdateEnabledControlOutput).OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Control (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceControlRead(ctx, d, meta)...)
+}
+
 func resourceControlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -128,16 +281,16 @@ func resourceControlDelete(ctx context.Context, d *schema.ResourceData, meta int
 	targetIdentifier, controlIdentifier := parts[0], parts[1]
 
 	log.Printf("[DEBUG] Deleting ControlTower Control: %s", d.Id())
-	output, err := conn.DisableControl(ctx, &controltower.DisableControlInput{
+	operationID, err := disableControl(ctx, conn, &controltower.DisableControlInput{
 		ControlIdentifier: aws.String(controlIdentifier),
 		TargetIdentifier:  aws.String(targetIdentifier),
-	})
+	}, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting ControlTower Control (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitOperationSucceeded(ctx, conn, operationID, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Control (%s) delete: %s", d.Id(), err)
 	}
 
@@ -148,6 +301,32 @@ const (
 	controlResourceIDPartCount = 2
 )
 
+// enableControl enables a control, queuing behind any operation already in progress on the target.
+func enableControl(ctx context.Context, conn *controltower.Client, input *controltower.EnableControlInput, timeout time.Duration) (string, error) {
+	outputRaw, err := tfresource.RetryWhenIsA[*types.ConflictException](ctx, timeout, func() (interface{}, error) {
+		return conn.EnableControl(ctx, input)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(outputRaw.(*controltower.EnableControlOutput).OperationIdentifier), nil
+}
+
+// disableControl disables a control, queuing behind any operation already in progress on the target.
+func disableControl(ctx context.Context, conn *controltower.Client, input *controltower.DisableControlInput, timeout time.Duration) (string, error) {
+	outputRaw, err := tfresource.RetryWhenIsA[*types.ConflictException](ctx, timeout, func() (interface{}, error) {
+		return conn.DisableControl(ctx, input)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(outputRaw.(*controltower.DisableControlOutput).OperationIdentifier), nil
+}
+
 func findEnabledControlByTwoPartKey(ctx context.Context, conn *controltower.Client, targetIdentifier, controlIdentifier string) (*types.EnabledControlSummary, error) {
 	input := &controltower.ListEnabledControlsInput{
 		TargetIdentifier: aws.String(targetIdentifier),
@@ -197,6 +376,31 @@ func findEnabledControls(ctx context.Context, conn *controltower.Client, input *
 	return output, nil
 }
 
+func findEnabledControlByARN(ctx context.Context, conn *controltower.Client, arn string) (*types.EnabledControlDetails, error) {
+	input := &controltower.GetEnabledControlInput{
+		EnabledControlIdentifier: aws.String(arn),
+	}
+
+	output, err := conn.GetEnabledControl(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EnabledControlDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EnabledControlDetails, nil
+}
+
 func findControlOperationByID(ctx context.Context, conn *controltower.Client, id string) (*types.ControlOperation, error) {
 	input := &controltower.GetControlOperationInput{
 		OperationIdentifier: aws.String(id),
@@ -248,11 +452,91 @@ func waitOperationSucceeded(ctx context.Context, conn *controltower.Client, id s
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*controltower.GetControlOperationOutput); ok {
-		tfresource.SetLastError(err, errors.New(aws.ToString(output.ControlOperation.StatusMessage)))
+	if output, ok := outputRaw.(*types.ControlOperation); ok {
+		if status := output.Status; status == types.ControlOperationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+		}
 
-		return output.ControlOperation, err
+		return output, err
 	}
 
 	return nil, err
 }
+
+func expandParameterValue(s string) (document.Interface, error) {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	return document.NewLazyDocument(v), nil
+}
+
+func flattenParameterValue(apiObject document.Interface) (string, error) {
+	if apiObject == nil {
+		return "", nil
+	}
+
+	var v interface{}
+
+	if err := apiObject.UnmarshalSmithyDocument(&v); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func expandEnabledControlParameters(tfList []interface{}) ([]types.EnabledControlParameter, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	var apiObjects []types.EnabledControlParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		value, err := expandParameterValue(tfMap[names.AttrValue].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		apiObjects = append(apiObjects, types.EnabledControlParameter{
+			Key:   aws.String(tfMap[names.AttrKey].(string)),
+			Value: value,
+		})
+	}
+
+	return apiObjects, nil
+}
+
+func flattenEnabledControlParameterSummaries(apiObjects []types.EnabledControlParameterSummary) ([]interface{}, error) {
+	if len(apiObjects) == 0 {
+		return nil, nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		value, err := flattenParameterValue(apiObject.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrKey:   aws.ToString(apiObject.Key),
+			names.AttrValue: value,
+		})
+	}
+
+	return tfList, nil
+}
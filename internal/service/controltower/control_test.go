@@ -25,6 +25,7 @@ func TestAccControlTowerControl_serial(t *testing.T) {
 		"Control": {
 			acctest.CtBasic:      testAccControl_basic,
 			acctest.CtDisappears: testAccControl_disappears,
+			"parameters":         testAccControl_parameters,
 		},
 	}
 
@@ -88,6 +89,56 @@ func testAccControl_disappears(t *testing.T) {
 	})
 }
 
+func testAccControl_parameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	var control types.EnabledControlSummary
+	resourceName := "aws_controltower_control.test"
+	controlName := "CT.MULTISERVICE.PV.1"
+	ouName := "Security"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ControlTowerServiceID),
+		CheckDestroy:             testAccCheckControlDestroy(ctx),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig_parameters(controlName, ouName, "us-east-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(ctx, resourceName, &control),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "drift_status"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						names.AttrKey:   "AllowedRegions",
+						names.AttrValue: `["us-east-1"]`,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccControlConfig_parameters(controlName, ouName, "us-west-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(ctx, resourceName, &control),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						names.AttrKey:   "AllowedRegions",
+						names.AttrValue: `["us-west-2"]`,
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckControlExists(ctx context.Context, n string, v *types.EnabledControlSummary) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -156,3 +207,30 @@ resource "aws_controltower_control" "test" {
 }
 `, controlName, ouName)
 }
+
+func testAccControlConfig_parameters(controlName, ouName, allowedRegion string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_organizations_organization" "test" {}
+
+data "aws_organizations_organizational_units" "test" {
+  parent_id = data.aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_controltower_control" "test" {
+  control_identifier = "arn:${data.aws_partition.current.partition}:controltower:${data.aws_region.current.name}::control/%[1]s"
+  target_identifier = [
+    for x in data.aws_organizations_organizational_units.test.children :
+    x.arn if x.name == "%[2]s"
+  ][0]
+
+  parameters {
+    key   = "AllowedRegions"
+    value = jsonencode([%[3]q])
+  }
+}
+`, controlName, ouName, allowedRegion)
+}
@@ -13,6 +13,11 @@ func TestAccControlTower_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"EnabledBaseline": {
+			acctest.CtBasic:      testAccEnabledBaseline_basic,
+			acctest.CtDisappears: testAccEnabledBaseline_disappears,
+			"tags":               testAccEnabledBaseline_tags,
+		},
 		"LandingZone": {
 			acctest.CtBasic:      testAccLandingZone_basic,
 			acctest.CtDisappears: testAccLandingZone_disappears,
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controltower_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcontroltower "github.com/hashicorp/terraform-provider-aws/internal/service/controltower"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The AWSControlTowerBaseline baseline requires the ARN of the account's
+// IdentityCenterBaseline enabled baseline as a parameter.
+const envVarIdentityCenterEnabledBaselineARN = "CONTROLTOWER_IDENTITY_CENTER_ENABLED_BASELINE_ARN"
+
+func testAccEnabledBaseline_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var enabledBaseline types.EnabledBaselineDetails
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_controltower_enabled_baseline.test"
+	identityCenterEnabledBaselineARN := acctest.SkipIfEnvVarNotSet(t, envVarIdentityCenterEnabledBaselineARN)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ControlTowerServiceID),
+		CheckDestroy:             testAccCheckEnabledBaselineDestroy(ctx),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnabledBaselineConfig_basic(rName, identityCenterEnabledBaselineARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnabledBaselineExists(ctx, resourceName, &enabledBaseline),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "controltower", regexache.MustCompile(`enabledbaseline/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "baseline_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "baseline_version", "4.0"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						names.AttrKey:   "IdentityCenterEnabledBaselineArn",
+						names.AttrValue: fmt.Sprintf("%q", identityCenterEnabledBaselineARN),
+					}),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "target_identifier", "aws_organizations_organizational_unit.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEnabledBaseline_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var enabledBaseline types.EnabledBaselineDetails
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_controltower_enabled_baseline.test"
+	identityCenterEnabledBaselineARN := acctest.SkipIfEnvVarNotSet(t, envVarIdentityCenterEnabledBaselineARN)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ControlTowerServiceID),
+		CheckDestroy:             testAccCheckEnabledBaselineDestroy(ctx),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnabledBaselineConfig_basic(rName, identityCenterEnabledBaselineARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnabledBaselineExists(ctx, resourceName, &enabledBaseline),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcontroltower.ResourceEnabledBaseline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccEnabledBaseline_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var enabledBaseline types.EnabledBaselineDetails
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_controltower_enabled_baseline.test"
+	identityCenterEnabledBaselineARN := acctest.SkipIfEnvVarNotSet(t, envVarIdentityCenterEnabledBaselineARN)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ControlTowerServiceID),
+		CheckDestroy:             testAccCheckEnabledBaselineDestroy(ctx),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnabledBaselineConfig_tags1(rName, identityCenterEnabledBaselineARN, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnabledBaselineExists(ctx, resourceName, &enabledBaseline),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnabledBaselineConfig_tags2(rName, identityCenterEnabledBaselineARN, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnabledBaselineExists(ctx, resourceName, &enabledBaseline),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccEnabledBaselineConfig_tags1(rName, identityCenterEnabledBaselineARN, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnabledBaselineExists(ctx, resourceName, &enabledBaseline),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnabledBaselineExists(ctx context.Context, n string, v *types.EnabledBaselineDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerClient(ctx)
+
+		output, err := tfcontroltower.FindEnabledBaselineByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEnabledBaselineDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_controltower_enabled_baseline" {
+				continue
+			}
+
+			_, err := tfcontroltower.FindEnabledBaselineByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ControlTower Enabled Baseline %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEnabledBaselineConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = data.aws_organizations_organization.test.roots[0].id
+}
+`, rName)
+}
+
+func testAccEnabledBaselineConfig_basic(rName, identityCenterEnabledBaselineARN string) string {
+	return acctest.ConfigCompose(testAccEnabledBaselineConfig_base(rName), fmt.Sprintf(`
+resource "aws_controltower_enabled_baseline" "test" {
+  baseline_identifier = "arn:${data.aws_partition.current.partition}:controltower:${data.aws_region.current.name}::baseline/17BSJV3IGJ2QSGA2"
+  baseline_version    = "4.0"
+  target_identifier   = aws_organizations_organizational_unit.test.arn
+
+  parameters {
+    key   = "IdentityCenterEnabledBaselineArn"
+    value = jsonencode(%[1]q)
+  }
+}
+`, identityCenterEnabledBaselineARN))
+}
+
+func testAccEnabledBaselineConfig_tags1(rName, identityCenterEnabledBaselineARN, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccEnabledBaselineConfig_base(rName), fmt.Sprintf(`
+resource "aws_controltower_enabled_baseline" "test" {
+  baseline_identifier = "arn:${data.aws_partition.current.partition}:controltower:${data.aws_region.current.name}::baseline/17BSJV3IGJ2QSGA2"
+  baseline_version    = "4.0"
+  target_identifier   = aws_organizations_organizational_unit.test.arn
+
+  parameters {
+    key   = "IdentityCenterEnabledBaselineArn"
+    value = jsonencode(%[1]q)
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, identityCenterEnabledBaselineARN, tagKey1, tagValue1))
+}
+
+func testAccEnabledBaselineConfig_tags2(rName, identityCenterEnabledBaselineARN, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccEnabledBaselineConfig_base(rName), fmt.Sprintf(`
+resource "aws_controltower_enabled_baseline" "test" {
+  baseline_identifier = "arn:${data.aws_partition.current.partition}:controltower:${data.aws_region.current.name}::baseline/17BSJV3IGJ2QSGA2"
+  baseline_version    = "4.0"
+  target_identifier   = aws_organizations_organizational_unit.test.arn
+
+  parameters {
+    key   = "IdentityCenterEnabledBaselineArn"
+    value = jsonencode(%[1]q)
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, identityCenterEnabledBaselineARN, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -5,9 +5,11 @@ package controltower
 
 // Exports for use in tests only.
 var (
-	ResourceControl     = resourceControl
-	ResourceLandingZone = resourceLandingZone
+	ResourceControl         = resourceControl
+	ResourceEnabledBaseline = resourceEnabledBaseline
+	ResourceLandingZone     = resourceLandingZone
 
+	FindEnabledBaselineByARN       = findEnabledBaselineByARN
 	FindEnabledControlByTwoPartKey = findEnabledControlByTwoPartKey
 	FindLandingZoneByID            = findLandingZoneByID
 )
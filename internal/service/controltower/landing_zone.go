@@ -186,13 +186,16 @@ func resourceLandingZoneUpdate(ctx context.Context, d *schema.ResourceData, meta
 			Version:               aws.String(d.Get(names.AttrVersion).(string)),
 		}
 
-		output, err := conn.UpdateLandingZone(ctx, input)
+		// Version updates can't start while another landing zone operation is in progress.
+		outputRaw, err := tfresource.RetryWhenIsA[*types.ConflictException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateLandingZone(ctx, input)
+		})
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating ControlTower Landing Zone (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitLandingZoneOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitLandingZoneOperationSucceeded(ctx, conn, aws.ToString(outputRaw.(*controltower.UpdateLandingZoneOutput).OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Landing Zone (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -205,16 +208,22 @@ func resourceLandingZoneDelete(ctx context.Context, d *schema.ResourceData, meta
 	conn := meta.(*conns.AWSClient).ControlTowerClient(ctx)
 
 	log.Printf("[DEBUG] Deleting ControlTower Landing Zone: %s", d.Id())
-	output, err := conn.DeleteLandingZone(ctx, &controltower.DeleteLandingZoneInput{
-		LandingZoneIdentifier: aws.String(d.Id()),
+	outputRaw, err := tfresource.RetryWhenIsA[*types.ConflictException](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteLandingZone(ctx, &controltower.DeleteLandingZoneInput{
+			LandingZoneIdentifier: aws.String(d.Id()),
+		})
 	})
 
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting ControlTower Landing Zone: %s", err)
+		return sdkdiag.AppendErrorf(diags, "deleting ControlTower Landing Zone (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitLandingZoneOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutDelete)); err != nil {
-		sdkdiag.AppendErrorf(diags, "waiting for ControlTower Landing Zone (%s) delete: %s", d.Id(), err)
+	if _, err := waitLandingZoneOperationSucceeded(ctx, conn, aws.ToString(outputRaw.(*controltower.DeleteLandingZoneOutput).OperationIdentifier), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Landing Zone (%s) delete: %s", d.Id(), err)
 	}
 
 	return diags
@@ -40,6 +40,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_controltower_control",
 			Name:     "Control",
 		},
+		{
+			Factory:  resourceEnabledBaseline,
+			TypeName: "aws_controltower_enabled_baseline",
+			Name:     "Enabled Baseline",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceLandingZone,
 			TypeName: "aws_controltower_landing_zone",
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -270,6 +271,88 @@ func TestAccDAXCluster_EndpointEncryption_enabled(t *testing.T) {
 	})
 }
 
+func TestAccDAXCluster_maintenanceWindow(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dc awstypes.Cluster
+	rString := sdkacctest.RandString(10)
+	resourceName := "aws_dax_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DAXServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_maintenanceWindow(rString, "sun:05:00-sun:09:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window", "sun:05:00-sun:09:00"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterConfig_maintenanceWindow(rString, "Tue:02:00-Tue:04:00"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window", "tue:02:00-tue:04:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDAXCluster_EndpointEncryption_update(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var dc awstypes.Cluster
+	rString := sdkacctest.RandString(10)
+	resourceName := "aws_dax_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DAXServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_endpointEncryption(rString, "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_encryption_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPort, "8111"),
+				),
+			},
+			// The endpoint encryption type can only be set at creation.
+			{
+				Config: testAccClusterConfig_endpointEncryption(rString, "TLS"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_encryption_type", "TLS"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPort, "9111"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDAXCluster_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dc awstypes.Cluster
@@ -416,6 +499,18 @@ resource "aws_dax_cluster" "test" {
 `, baseConfig, rString)
 }
 
+func testAccClusterConfig_maintenanceWindow(rString, maintenanceWindow string) string {
+	return fmt.Sprintf(`%s
+resource "aws_dax_cluster" "test" {
+  cluster_name       = "tf-%s"
+  iam_role_arn       = aws_iam_role.test.arn
+  node_type          = "dax.t3.small"
+  replication_factor = 1
+  maintenance_window = %q
+}
+`, baseConfig, rString, maintenanceWindow)
+}
+
 func testAccClusterConfig_encryption(rString string, enabled bool) string {
 	return fmt.Sprintf(`%s
 resource "aws_dax_cluster" "test" {
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package detective
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_detective_datasource_package")
+func ResourceDatasourcePackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatasourcePackageCreate,
+		ReadWithoutTimeout:   resourceDatasourcePackageRead,
+		DeleteWithoutTimeout: resourceDatasourcePackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"datasource_package": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// DETECTIVE_CORE is always enabled for a behavior graph.
+				ValidateFunc: validation.StringInSlice([]string{
+					detective.DatasourcePackageEksAudit,
+					detective.DatasourcePackageAsffSecurityhubFinding,
+				}, false),
+			},
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"ingest_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDatasourcePackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveConn(ctx)
+
+	graphARN := d.Get("graph_arn").(string)
+	datasourcePackage := d.Get("datasource_package").(string)
+	id := datasourcePackageCreateResourceID(graphARN, datasourcePackage)
+	input := &detective.UpdateDatasourcePackagesInput{
+		DatasourcePackages: aws.StringSlice([]string{datasourcePackage}),
+		GraphArn:           aws.String(graphARN),
+	}
+
+	_, err := conn.UpdateDatasourcePackagesWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "enabling Detective Datasource Package (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDatasourcePackageRead(ctx, d, meta)...)
+}
+
+func resourceDatasourcePackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveConn(ctx)
+
+	graphARN, datasourcePackage, err := DatasourcePackageParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	detail, err := FindDatasourcePackageByTwoPartKey(ctx, conn, graphARN, datasourcePackage)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Datasource Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Detective Datasource Package (%s): %s", d.Id(), err)
+	}
+
+	d.Set("datasource_package", datasourcePackage)
+	d.Set("graph_arn", graphARN)
+	d.Set("ingest_state", detail.DatasourcePackageIngestState)
+
+	return diags
+}
+
+func resourceDatasourcePackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The Detective API has no operation to stop an optional datasource package.
+	log.Printf("[WARN] Detective Datasource Package (%s) cannot be disabled via the API, removing from state only", d.Id())
+
+	return diags
+}
+
+const datasourcePackageResourceIDSeparator = "/"
+
+func datasourcePackageCreateResourceID(graphARN, datasourcePackage string) string {
+	parts := []string{graphARN, datasourcePackage}
+	id := strings.Join(parts, datasourcePackageResourceIDSeparator)
+
+	return id
+}
+
+func DatasourcePackageParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, datasourcePackageResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected graph_arn%[2]sdatasource_package", id, datasourcePackageResourceIDSeparator)
+}
+
+func FindDatasourcePackageByTwoPartKey(ctx context.Context, conn *detective.Detective, graphARN, datasourcePackage string) (*detective.DatasourcePackageIngestDetail, error) {
+	input := &detective.ListDatasourcePackagesInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	output, err := findDatasourcePackages(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	detail, ok := output[datasourcePackage]
+
+	if !ok || detail == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if state := aws.StringValue(detail.DatasourcePackageIngestState); state == detective.DatasourcePackageIngestStateDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return detail, nil
+}
+
+func findDatasourcePackages(ctx context.Context, conn *detective.Detective, input *detective.ListDatasourcePackagesInput) (map[string]*detective.DatasourcePackageIngestDetail, error) {
+	output := make(map[string]*detective.DatasourcePackageIngestDetail)
+
+	err := conn.ListDatasourcePackagesPagesWithContext(ctx, input, func(page *detective.ListDatasourcePackagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for k, v := range page.DatasourcePackages {
+			output[k] = v
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
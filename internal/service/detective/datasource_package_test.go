@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package detective_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdetective "github.com/hashicorp/terraform-provider-aws/internal/service/detective"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDatasourcePackage_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var detail detective.DatasourcePackageIngestDetail
+	graphResourceName := "aws_detective_graph.test"
+	resourceName := "aws_detective_datasource_package.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DetectiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Detective Datasource Packages cannot be disabled separately.
+		// Ensure parent resource is destroyed instead.
+		CheckDestroy: testAccCheckGraphDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourcePackageConfig_basic(detective.DatasourcePackageEksAudit),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasourcePackageExists(ctx, resourceName, &detail),
+					resource.TestCheckResourceAttr(resourceName, "datasource_package", detective.DatasourcePackageEksAudit),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", graphResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ingest_state", detective.DatasourcePackageIngestStateStarted),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatasourcePackageExists(ctx context.Context, n string, v *detective.DatasourcePackageIngestDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn(ctx)
+
+		graphARN, datasourcePackage, err := tfdetective.DatasourcePackageParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := tfdetective.FindDatasourcePackageByTwoPartKey(ctx, conn, graphARN, datasourcePackage)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDatasourcePackageConfig_basic(datasourcePackage string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {}
+
+resource "aws_detective_datasource_package" "test" {
+  datasource_package = %[1]q
+  graph_arn          = aws_detective_graph.test.id
+}
+`, datasourcePackage)
+}
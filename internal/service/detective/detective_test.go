@@ -14,6 +14,9 @@ func TestAccDetective_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DatasourcePackage": {
+			acctest.CtBasic: testAccDatasourcePackage_basic,
+		},
 		"Graph": {
 			acctest.CtBasic:      testAccGraph_basic,
 			acctest.CtDisappears: testAccGraph_disappears,
@@ -27,6 +30,9 @@ func TestAccDetective_serial(t *testing.T) {
 			"disappear":     testAccMember_disappears,
 			"message":       testAccMember_message,
 		},
+		"MembersDataSource": {
+			acctest.CtBasic: testAccMembersDataSource_basic,
+		},
 		"OrganizationAdminAccount": {
 			acctest.CtBasic:      testAccOrganizationAdminAccount_basic,
 			acctest.CtDisappears: testAccOrganizationAdminAccount_disappears,
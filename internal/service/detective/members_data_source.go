@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package detective
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_detective_members")
+func DataSourceMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAccountID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"administrator_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disabled_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invitation_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invited_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(detective.MemberStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveConn(ctx)
+
+	graphARN := d.Get("graph_arn").(string)
+	input := &detective.ListMembersInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	filter := tfslices.PredicateTrue[*detective.MemberDetail]()
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		status := v.(string)
+		filter = func(v *detective.MemberDetail) bool {
+			return aws.StringValue(v.Status) == status
+		}
+	}
+
+	members, err := findMembers(ctx, conn, input, filter)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Detective Members (%s): %s", graphARN, err)
+	}
+
+	d.SetId(graphARN)
+	if err := d.Set("members", flattenMemberDetails(members)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting members: %s", err)
+	}
+
+	return diags
+}
+
+func flattenMemberDetails(apiObjects []*detective.MemberDetail) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrAccountID: aws.StringValue(apiObject.AccountId),
+			"administrator_id":  aws.StringValue(apiObject.AdministratorId),
+			"disabled_reason":   aws.StringValue(apiObject.DisabledReason),
+			"email_address":     aws.StringValue(apiObject.EmailAddress),
+			"invitation_type":   aws.StringValue(apiObject.InvitationType),
+			names.AttrStatus:    aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.InvitedTime; v != nil {
+			tfMap["invited_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.UpdatedTime; v != nil {
+			tfMap["updated_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package detective_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccMembersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_detective_members.test"
+	resourceName := "aws_detective_member.test"
+	email := testAccMemberFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckMemberDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.DetectiveServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembersDataSourceConfig_basic(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "graph_arn", resourceName, "graph_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "members.0.account_id", resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "members.0.administrator_id", resourceName, "administrator_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "members.0.email_address", resourceName, "email_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.status", detective.MemberStatusInvited),
+				),
+			},
+		},
+	})
+}
+
+func testAccMembersDataSourceConfig_basic(email string) string {
+	return acctest.ConfigCompose(testAccMemberConfig_basic(email), `
+data "aws_detective_members" "test" {
+  graph_arn = aws_detective_member.test.graph_arn
+  status    = "INVITED"
+}
+`)
+}
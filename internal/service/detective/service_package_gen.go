@@ -26,11 +26,20 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceMembers,
+			TypeName: "aws_detective_members",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceDatasourcePackage,
+			TypeName: "aws_detective_datasource_package",
+		},
 		{
 			Factory:  ResourceGraph,
 			TypeName: "aws_detective_graph",
@@ -194,6 +194,53 @@ func FindGatewayAssociationProposalByID(ctx context.Context, conn *directconnect
 	return proposal, nil
 }
 
+// FindRequestedGatewayAssociationProposal returns the single proposal in the "requested" state
+// for the specified Direct Connect gateway that was made by the specified associated gateway owner account.
+func FindRequestedGatewayAssociationProposal(ctx context.Context, conn *directconnect.DirectConnect, directConnectGatewayID, associatedGatewayOwnerAccount string) (*directconnect.GatewayAssociationProposal, error) {
+	input := &directconnect.DescribeDirectConnectGatewayAssociationProposalsInput{
+		DirectConnectGatewayId: aws.String(directConnectGatewayID),
+	}
+	var output []*directconnect.GatewayAssociationProposal
+
+	err := describeGatewayAssociationProposalsPages(ctx, conn, input, func(page *directconnect.DescribeDirectConnectGatewayAssociationProposalsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DirectConnectGatewayAssociationProposals {
+			if v == nil || v.AssociatedGateway == nil {
+				continue
+			}
+
+			if aws.StringValue(v.ProposalState) != directconnect.GatewayAssociationProposalStateRequested {
+				continue
+			}
+
+			if aws.StringValue(v.AssociatedGateway.OwnerAccount) != associatedGatewayOwnerAccount {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
 func FindHostedConnectionByID(ctx context.Context, conn *directconnect.DirectConnect, id string) (*directconnect.Connection, error) {
 	input := &directconnect.DescribeHostedConnectionsInput{
 		ConnectionId: aws.String(id),
@@ -21,6 +21,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	gatewayAssociationProposalAcceptTimeout = 5 * time.Minute
+)
+
 // @SDKResource("aws_dx_gateway_association")
 func ResourceGatewayAssociation() *schema.Resource {
 	return &schema.Resource{
@@ -66,7 +70,6 @@ func ResourceGatewayAssociation() *schema.Resource {
 				ForceNew:      true,
 				ValidateFunc:  verify.ValidAccountID,
 				ConflictsWith: []string{"associated_gateway_id"},
-				RequiredWith:  []string{"proposal_id"},
 				AtLeastOneOf:  []string{"associated_gateway_id", "associated_gateway_owner_account_id", "proposal_id"},
 			},
 
@@ -94,6 +97,7 @@ func ResourceGatewayAssociation() *schema.Resource {
 			"proposal_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ConflictsWith: []string{"associated_gateway_id", "vpn_gateway_id"},
 				AtLeastOneOf:  []string{"associated_gateway_id", "associated_gateway_owner_account_id", "proposal_id"},
 			},
@@ -124,6 +128,20 @@ func resourceGatewayAssociationCreate(ctx context.Context, d *schema.ResourceDat
 
 	if associatedGatewayOwnerAccount := d.Get("associated_gateway_owner_account_id").(string); associatedGatewayOwnerAccount != "" {
 		proposalID := d.Get("proposal_id").(string)
+
+		// If no proposal ID is configured, accept the single outstanding proposal made by the associated gateway owner account.
+		if proposalID == "" {
+			outputRaw, err := tfresource.RetryWhenNotFound(ctx, gatewayAssociationProposalAcceptTimeout, func() (interface{}, error) {
+				return FindRequestedGatewayAssociationProposal(ctx, conn, directConnectGatewayID, associatedGatewayOwnerAccount)
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Direct Connect Gateway Association Proposal (%s/%s): %s", directConnectGatewayID, associatedGatewayOwnerAccount, err)
+			}
+
+			proposalID = aws.StringValue(outputRaw.(*directconnect.GatewayAssociationProposal).ProposalId)
+		}
+
 		input := &directconnect.AcceptDirectConnectGatewayAssociationProposalInput{
 			AssociatedGatewayOwnerAccount: aws.String(associatedGatewayOwnerAccount),
 			DirectConnectGatewayId:        aws.String(directConnectGatewayID),
@@ -144,6 +162,7 @@ func resourceGatewayAssociationCreate(ctx context.Context, d *schema.ResourceDat
 		// For historical reasons the resource ID isn't set to the association ID returned from the API.
 		associationID = aws.StringValue(output.DirectConnectGatewayAssociation.AssociationId)
 		d.SetId(GatewayAssociationCreateResourceID(directConnectGatewayID, aws.StringValue(output.DirectConnectGatewayAssociation.AssociatedGateway.Id)))
+		d.Set("proposal_id", proposalID)
 	} else {
 		associatedGatewayID := d.Get("associated_gateway_id").(string)
 		input := &directconnect.CreateDirectConnectGatewayAssociationInput{
@@ -212,31 +231,33 @@ func resourceGatewayAssociationUpdate(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DirectConnectConn(ctx)
 
-	associationID := d.Get("dx_gateway_association_id").(string)
-	input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
-		AssociationId: aws.String(associationID),
-	}
+	if d.HasChange("allowed_prefixes") {
+		associationID := d.Get("dx_gateway_association_id").(string)
+		input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
+			AssociationId: aws.String(associationID),
+		}
 
-	oraw, nraw := d.GetChange("allowed_prefixes")
-	o, n := oraw.(*schema.Set), nraw.(*schema.Set)
+		oraw, nraw := d.GetChange("allowed_prefixes")
+		o, n := oraw.(*schema.Set), nraw.(*schema.Set)
 
-	if add := n.Difference(o); add.Len() > 0 {
-		input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add.List())
-	}
+		if add := n.Difference(o); add.Len() > 0 {
+			input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add.List())
+		}
 
-	if del := o.Difference(n); del.Len() > 0 {
-		input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del.List())
-	}
+		if del := o.Difference(n); del.Len() > 0 {
+			input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del.List())
+		}
 
-	log.Printf("[DEBUG] Updating Direct Connect Gateway Association: %s", input)
-	_, err := conn.UpdateDirectConnectGatewayAssociationWithContext(ctx, input)
+		log.Printf("[DEBUG] Updating Direct Connect Gateway Association: %s", input)
+		_, err := conn.UpdateDirectConnectGatewayAssociationWithContext(ctx, input)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Direct Connect Gateway Association (%s): %s", d.Id(), err)
-	}
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Direct Connect Gateway Association (%s): %s", d.Id(), err)
+		}
 
-	if _, err := waitGatewayAssociationUpdated(ctx, conn, associationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association (%s) to update: %s", d.Id(), err)
+		if _, err := waitGatewayAssociationUpdated(ctx, conn, associationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association (%s) to update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceGatewayAssociationRead(ctx, d, meta)...)
@@ -121,6 +121,38 @@ func TestAccDirectConnectGatewayAssociation_basicVPNGatewayCrossAccount(t *testi
 	})
 }
 
+func TestAccDirectConnectGatewayAssociation_autoAcceptProposalVPNGatewayCrossAccount(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dx_gateway_association.test"
+	resourceNameDxGw := "aws_dx_gateway.test"
+	resourceNameGap := "aws_dx_gateway_association_proposal.test"
+	resourceNameVgw := "aws_vpn_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	var ga directconnect.GatewayAssociation
+	var gap directconnect.GatewayAssociationProposal
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAlternateAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckGatewayAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayAssociationConfig_autoAcceptProposalVPNCrossAccount(rName, rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayAssociationExists(ctx, resourceName, &ga, &gap),
+					resource.TestCheckResourceAttrPair(resourceName, "associated_gateway_id", resourceNameVgw, names.AttrID),
+					acctest.CheckResourceAttrAccountID(resourceName, "associated_gateway_owner_account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "dx_gateway_association_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "dx_gateway_id", resourceNameDxGw, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "proposal_id", resourceNameGap, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDirectConnectGatewayAssociation_basicTransitGatewaySingleAccount(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_dx_gateway_association.test"
@@ -562,6 +594,29 @@ resource "aws_dx_gateway_association" "test" {
 `)
 }
 
+func testAccGatewayAssociationConfig_autoAcceptProposalVPNCrossAccount(rName string, rBgpAsn int) string {
+	return acctest.ConfigCompose(
+		testAccGatewayAssociationConfigBase_vpnGatewayCrossAccount(rName, rBgpAsn),
+		`
+# Creator
+resource "aws_dx_gateway_association_proposal" "test" {
+  dx_gateway_id               = aws_dx_gateway.test.id
+  dx_gateway_owner_account_id = aws_dx_gateway.test.owner_account_id
+  associated_gateway_id       = aws_vpn_gateway_attachment.test.vpn_gateway_id
+}
+
+# Accepter
+resource "aws_dx_gateway_association" "test" {
+  provider = "awsalternate"
+
+  dx_gateway_id                       = aws_dx_gateway.test.id
+  associated_gateway_owner_account_id = data.aws_caller_identity.creator.account_id
+
+  depends_on = [aws_dx_gateway_association_proposal.test]
+}
+`)
+}
+
 func testAccGatewayAssociationConfig_basicVPNCrossAccountUpdatedProposal(rName string, rBgpAsn int) string {
 	return acctest.ConfigCompose(
 		testAccGatewayAssociationConfigBase_vpnGatewayCrossAccount(rName, rBgpAsn),
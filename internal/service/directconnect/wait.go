@@ -96,7 +96,7 @@ func waitGatewayDeleted(ctx context.Context, conn *directconnect.DirectConnect,
 
 func waitGatewayAssociationCreated(ctx context.Context, conn *directconnect.DirectConnect, id string, timeout time.Duration) (*directconnect.GatewayAssociation, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{directconnect.GatewayAssociationStateAssociating},
+		Pending: []string{directconnect.GatewayAssociationStateAssociating, directconnect.GatewayAssociationStateUpdating},
 		Target:  []string{directconnect.GatewayAssociationStateAssociated},
 		Refresh: statusGatewayAssociationState(ctx, conn, id),
 		Timeout: timeout,
@@ -115,10 +115,11 @@ func waitGatewayAssociationCreated(ctx context.Context, conn *directconnect.Dire
 
 func waitGatewayAssociationUpdated(ctx context.Context, conn *directconnect.DirectConnect, id string, timeout time.Duration) (*directconnect.GatewayAssociation, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{directconnect.GatewayAssociationStateUpdating},
-		Target:  []string{directconnect.GatewayAssociationStateAssociated},
-		Refresh: statusGatewayAssociationState(ctx, conn, id),
-		Timeout: timeout,
+		Pending:                   []string{directconnect.GatewayAssociationStateAssociating, directconnect.GatewayAssociationStateUpdating},
+		Target:                    []string{directconnect.GatewayAssociationStateAssociated},
+		Refresh:                   statusGatewayAssociationState(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -44,6 +44,12 @@ func resourceLifecyclePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"default_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DefaultPolicyTypeValues](),
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -132,6 +138,30 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"copy_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"create_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 7),
+						},
+						"cross_region_copy_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 3,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_region": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
 						"event_source": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -174,6 +204,47 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_boot_volumes": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"exclude_tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"exclude_volume_types": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 6,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"extend_deletion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"policy_language": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PolicyLanguageValues](),
+						},
+						names.AttrResourceType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ResourceTypeValues](),
+						},
 						"resource_types": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -183,6 +254,12 @@ func resourceLifecyclePolicy() *schema.Resource {
 								ValidateDiagFunc: enum.Validate[awstypes.ResourceTypeValues](),
 							},
 						},
+						"retain_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(2, 14),
+						},
 						"resource_locations": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -223,6 +300,48 @@ func resourceLifecyclePolicy() *schema.Resource {
 							MaxItems: 4,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"archive_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"archive_retain_rule": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"retention_archive_tier": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"count": {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntBetween(1, 1000),
+																		},
+																		names.AttrInterval: {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntAtLeast(1),
+																		},
+																		"interval_unit": {
+																			Type:             schema.TypeString,
+																			Optional:         true,
+																			ValidateDiagFunc: enum.Validate[awstypes.RetentionIntervalUnitValues](),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 									"copy_tags": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -257,6 +376,56 @@ func resourceLifecyclePolicy() *schema.Resource {
 													Computed:         true,
 													ValidateDiagFunc: enum.Validate[awstypes.LocationValues](),
 												},
+												"scripts": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"execute_operation_on_script_failure": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																Computed: true,
+															},
+															"execution_handler": {
+																Type:     schema.TypeString,
+																Required: true,
+																ValidateFunc: validation.All(
+																	validation.StringLenBetween(0, 200),
+																	validation.StringMatch(regexache.MustCompile(`^([a-zA-Z0-9_\-.]{3,128}|[a-zA-Z0-9_\-.:/]{3,200}|[A-Z0-9_]+)$`), ""),
+																),
+															},
+															"execution_handler_service": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																Computed:         true,
+																ValidateDiagFunc: enum.Validate[awstypes.ExecutionHandlerServiceValues](),
+															},
+															"execution_timeout": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntBetween(10, 120),
+															},
+															"maximum_retry_count": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntBetween(0, 3),
+															},
+															"stages": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Computed: true,
+																MaxItems: 2,
+																Elem: &schema.Schema{
+																	Type:             schema.TypeString,
+																	ValidateDiagFunc: enum.Validate[awstypes.StageValues](),
+																},
+															},
+														},
+													},
+												},
 												"times": {
 													Type:     schema.TypeList,
 													Optional: true,
@@ -500,6 +669,10 @@ func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData,
 		Tags:             getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("default_policy"); ok {
+		input.DefaultPolicy = awstypes.DefaultPolicyTypeValues(v.(string))
+	}
+
 	out, err := tfresource.RetryWhenIsA[*awstypes.InvalidRequestException](ctx, createRetryTimeout, func() (interface{}, error) {
 		return conn.CreateLifecyclePolicy(ctx, &input)
 	})
@@ -531,6 +704,11 @@ func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.Set(names.AttrARN, out.Policy.PolicyArn)
+	if aws.ToBool(out.Policy.DefaultPolicy) {
+		d.Set("default_policy", out.Policy.PolicyDetails.ResourceType)
+	} else {
+		d.Set("default_policy", nil)
+	}
 	d.Set(names.AttrDescription, out.Policy.Description)
 	d.Set(names.AttrExecutionRoleARN, out.Policy.ExecutionRoleArn)
 	d.Set(names.AttrState, out.Policy.State)
@@ -651,6 +829,34 @@ func expandPolicyDetails(cfg []interface{}) *awstypes.PolicyDetails {
 	if v, ok := m[names.AttrParameters].([]interface{}); ok && len(v) > 0 {
 		policyDetails.Parameters = expandParameters(v, policyType)
 	}
+	if v, ok := m["policy_language"].(string); ok && v != "" {
+		policyDetails.PolicyLanguage = awstypes.PolicyLanguageValues(v)
+	}
+	if v, ok := m[names.AttrResourceType].(string); ok && v != "" {
+		policyDetails.ResourceType = awstypes.ResourceTypeValues(v)
+	}
+
+	// The following arguments are only valid for default policies, which use the simplified policy language.
+	if policyDetails.PolicyLanguage == awstypes.PolicyLanguageValuesSimplified {
+		if v, ok := m["copy_tags"].(bool); ok {
+			policyDetails.CopyTags = aws.Bool(v)
+		}
+		if v, ok := m["create_interval"].(int); ok && v > 0 {
+			policyDetails.CreateInterval = aws.Int32(int32(v))
+		}
+		if v, ok := m["extend_deletion"].(bool); ok {
+			policyDetails.ExtendDeletion = aws.Bool(v)
+		}
+		if v, ok := m["retain_interval"].(int); ok && v > 0 {
+			policyDetails.RetainInterval = aws.Int32(int32(v))
+		}
+	}
+	if v, ok := m["cross_region_copy_target"].(*schema.Set); ok && v.Len() > 0 {
+		policyDetails.CrossRegionCopyTargets = expandCrossRegionCopyTargets(v.List())
+	}
+	if v, ok := m["exclusions"].([]interface{}); ok && len(v) > 0 {
+		policyDetails.Exclusions = expandExclusions(v)
+	}
 
 	return policyDetails
 }
@@ -664,6 +870,17 @@ func flattenPolicyDetails(policyDetails *awstypes.PolicyDetails) []map[string]in
 	result[names.AttrSchedule] = flattenSchedules(policyDetails.Schedules)
 	result["target_tags"] = flattenTags(policyDetails.TargetTags)
 	result["policy_type"] = string(policyDetails.PolicyType)
+	result["policy_language"] = string(policyDetails.PolicyLanguage)
+	result[names.AttrResourceType] = string(policyDetails.ResourceType)
+	result["copy_tags"] = aws.ToBool(policyDetails.CopyTags)
+	result["create_interval"] = aws.ToInt32(policyDetails.CreateInterval)
+	result["extend_deletion"] = aws.ToBool(policyDetails.ExtendDeletion)
+	result["retain_interval"] = aws.ToInt32(policyDetails.RetainInterval)
+	result["cross_region_copy_target"] = flattenCrossRegionCopyTargets(policyDetails.CrossRegionCopyTargets)
+
+	if policyDetails.Exclusions != nil {
+		result["exclusions"] = flattenExclusions(policyDetails.Exclusions)
+	}
 
 	if policyDetails.Parameters != nil {
 		result[names.AttrParameters] = flattenParameters(policyDetails.Parameters)
@@ -677,6 +894,9 @@ func expandSchedules(cfg []interface{}) []awstypes.Schedule {
 	for i, c := range cfg {
 		schedule := awstypes.Schedule{}
 		m := c.(map[string]interface{})
+		if v, ok := m["archive_rule"]; ok {
+			schedule.ArchiveRule = expandArchiveRule(v.([]interface{}))
+		}
 		if v, ok := m["copy_tags"]; ok {
 			schedule.CopyTags = aws.Bool(v.(bool))
 		}
@@ -726,6 +946,10 @@ func flattenSchedules(schedules []awstypes.Schedule) []map[string]interface{} {
 		m["tags_to_add"] = flattenTags(s.TagsToAdd)
 		m["variable_tags"] = flattenTags(s.VariableTags)
 
+		if s.ArchiveRule != nil {
+			m["archive_rule"] = flattenArchiveRule(s.ArchiveRule)
+		}
+
 		if s.DeprecateRule != nil {
 			m["deprecate_rule"] = flattenDeprecateRule(s.DeprecateRule)
 		}
@@ -1064,6 +1288,10 @@ func expandCreateRule(cfg []interface{}) *awstypes.CreateRule {
 		createRule.IntervalUnit = "" // sets interval unit to empty string so that all fields related to interval are ignored
 	}
 
+	if v, ok := c["scripts"].([]interface{}); ok && len(v) > 0 {
+		createRule.Scripts = expandScripts(v)
+	}
+
 	return createRule
 }
 
@@ -1087,6 +1315,201 @@ func flattenCreateRule(createRule *awstypes.CreateRule) []map[string]interface{}
 		result["cron_expression"] = aws.ToString(createRule.CronExpression)
 	}
 
+	if createRule.Scripts != nil {
+		result["scripts"] = flattenScripts(createRule.Scripts)
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandScripts(cfg []interface{}) []awstypes.Script {
+	scripts := make([]awstypes.Script, 0, len(cfg))
+	for _, c := range cfg {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		script := awstypes.Script{}
+
+		if v, ok := m["execute_operation_on_script_failure"].(bool); ok {
+			script.ExecuteOperationOnScriptFailure = aws.Bool(v)
+		}
+
+		if v, ok := m["execution_handler"].(string); ok && v != "" {
+			script.ExecutionHandler = aws.String(v)
+		}
+
+		if v, ok := m["execution_handler_service"].(string); ok && v != "" {
+			script.ExecutionHandlerService = awstypes.ExecutionHandlerServiceValues(v)
+		}
+
+		if v, ok := m["execution_timeout"].(int); ok && v > 0 {
+			script.ExecutionTimeout = aws.Int32(int32(v))
+		}
+
+		if v, ok := m["maximum_retry_count"].(int); ok && v > 0 {
+			script.MaximumRetryCount = aws.Int32(int32(v))
+		}
+
+		if v, ok := m["stages"].([]interface{}); ok && len(v) > 0 {
+			script.Stages = flex.ExpandStringyValueList[awstypes.StageValues](v)
+		}
+
+		scripts = append(scripts, script)
+	}
+
+	return scripts
+}
+
+func flattenScripts(scripts []awstypes.Script) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(scripts))
+	for i, s := range scripts {
+		m := make(map[string]interface{})
+		m["execute_operation_on_script_failure"] = aws.ToBool(s.ExecuteOperationOnScriptFailure)
+		m["execution_handler"] = aws.ToString(s.ExecutionHandler)
+		m["execution_handler_service"] = string(s.ExecutionHandlerService)
+		m["execution_timeout"] = aws.ToInt32(s.ExecutionTimeout)
+		m["maximum_retry_count"] = aws.ToInt32(s.MaximumRetryCount)
+		m["stages"] = flex.FlattenStringyValueList(s.Stages)
+
+		result[i] = m
+	}
+
+	return result
+}
+
+func expandArchiveRule(cfg []interface{}) *awstypes.ArchiveRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	rule := &awstypes.ArchiveRule{}
+
+	if v, ok := m["archive_retain_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rule.RetainRule = &awstypes.ArchiveRetainRule{}
+
+		if v, ok := v[0].(map[string]interface{})["retention_archive_tier"].([]interface{}); ok && len(v) > 0 {
+			rule.RetainRule.RetentionArchiveTier = expandRetentionArchiveTier(v)
+		}
+	}
+
+	return rule
+}
+
+func flattenArchiveRule(rule *awstypes.ArchiveRule) []map[string]interface{} {
+	result := make(map[string]interface{})
+
+	if rule.RetainRule != nil {
+		retainRule := make(map[string]interface{})
+
+		if rule.RetainRule.RetentionArchiveTier != nil {
+			retainRule["retention_archive_tier"] = flattenRetentionArchiveTier(rule.RetainRule.RetentionArchiveTier)
+		}
+
+		result["archive_retain_rule"] = []map[string]interface{}{retainRule}
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandRetentionArchiveTier(cfg []interface{}) *awstypes.RetentionArchiveTier {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	tier := &awstypes.RetentionArchiveTier{}
+
+	if v, ok := m["count"].(int); ok && v > 0 {
+		tier.Count = aws.Int32(int32(v))
+	}
+
+	if v, ok := m[names.AttrInterval].(int); ok && v > 0 {
+		tier.Interval = aws.Int32(int32(v))
+	}
+
+	if v, ok := m["interval_unit"].(string); ok && v != "" {
+		tier.IntervalUnit = awstypes.RetentionIntervalUnitValues(v)
+	}
+
+	return tier
+}
+
+func flattenRetentionArchiveTier(tier *awstypes.RetentionArchiveTier) []map[string]interface{} {
+	result := make(map[string]interface{})
+	result["count"] = aws.ToInt32(tier.Count)
+	result[names.AttrInterval] = aws.ToInt32(tier.Interval)
+	result["interval_unit"] = string(tier.IntervalUnit)
+
+	return []map[string]interface{}{result}
+}
+
+func expandCrossRegionCopyTargets(l []interface{}) []awstypes.CrossRegionCopyTarget {
+	if len(l) == 0 {
+		return nil
+	}
+
+	var targets []awstypes.CrossRegionCopyTarget
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		target := awstypes.CrossRegionCopyTarget{}
+
+		if v, ok := m["target_region"].(string); ok && v != "" {
+			target.TargetRegion = aws.String(v)
+		}
+
+		targets = append(targets, target)
+	}
+
+	return targets
+}
+
+func flattenCrossRegionCopyTargets(targets []awstypes.CrossRegionCopyTarget) []interface{} {
+	result := make([]interface{}, 0, len(targets))
+
+	for _, target := range targets {
+		m := map[string]interface{}{
+			"target_region": aws.ToString(target.TargetRegion),
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func expandExclusions(cfg []interface{}) *awstypes.Exclusions {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	exclusions := &awstypes.Exclusions{}
+
+	if v, ok := m["exclude_boot_volumes"].(bool); ok {
+		exclusions.ExcludeBootVolumes = aws.Bool(v)
+	}
+
+	if v, ok := m["exclude_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeTags = expandTags(v)
+	}
+
+	if v, ok := m["exclude_volume_types"].([]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeVolumeTypes = flex.ExpandStringValueList(v)
+	}
+
+	return exclusions
+}
+
+func flattenExclusions(exclusions *awstypes.Exclusions) []map[string]interface{} {
+	result := make(map[string]interface{})
+	result["exclude_boot_volumes"] = aws.ToBool(exclusions.ExcludeBootVolumes)
+	result["exclude_tags"] = flattenTags(exclusions.ExcludeTags)
+	result["exclude_volume_types"] = flex.FlattenStringValueList(exclusions.ExcludeVolumeTypes)
+
 	return []map[string]interface{}{result}
 }
 
@@ -61,6 +61,7 @@ const (
 	engineNameS3                         = "s3"
 	engineNameSQLServer                  = "sqlserver"
 	engineNameSybase                     = "sybase"
+	engineNameTimestream                 = "timestream"
 )
 
 func engineName_Values() []string {
@@ -93,6 +94,7 @@ func engineName_Values() []string {
 		engineNameS3,
 		engineNameSQLServer,
 		engineNameSybase,
+		engineNameTimestream,
 	}
 }
 
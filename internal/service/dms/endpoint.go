@@ -757,6 +757,40 @@ func ResourceEndpoint() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"timestream_settings": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cdc_inserts_and_updates": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						names.AttrDatabaseName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"enable_magnetic_store_writes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"magnetic_duration": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 73000),
+						},
+						"memory_duration": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 8766),
+						},
+					},
+				},
+			},
 			names.AttrUsername: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -908,7 +942,7 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	case engineNameRedis:
 		input.RedisSettings = expandRedisSettings(d.Get("redis_settings").([]interface{})[0].(map[string]interface{}))
-	case engineNameRedshift:
+	case engineNameRedshift, engineNameRedshiftServerless:
 		var settings = &dms.RedshiftSettings{
 			DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 		}
@@ -1010,6 +1044,8 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	case engineNameS3:
 		input.S3Settings = expandS3Settings(d.Get("s3_settings").([]interface{})[0].(map[string]interface{}))
+	case engineNameTimestream:
+		input.TimestreamSettings = expandTimestreamSettings(d.Get("timestream_settings").([]interface{})[0].(map[string]interface{}))
 	default:
 		expandTopLevelConnectionInfo(d, input)
 	}
@@ -1252,7 +1288,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					input.RedisSettings = expandRedisSettings(d.Get("redis_settings").([]interface{})[0].(map[string]interface{}))
 					input.EngineName = aws.String(engineName)
 				}
-			case engineNameRedshift:
+			case engineNameRedshift, engineNameRedshiftServerless:
 				if d.HasChanges(
 					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName,
 					"redshift_settings", "secrets_manager_access_role_arn",
@@ -1271,7 +1307,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 							Port:         aws.Int64(int64(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 						}
-						input.EngineName = aws.String(engineName) // Must be included (should be 'redshift')
+						input.EngineName = aws.String(engineName) // Must be included (should be 'redshift' or 'redshift-serverless')
 
 						// Update connection info in top-level namespace as well
 						expandTopLevelConnectionInfoModify(d, input)
@@ -1378,6 +1414,11 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					input.S3Settings = expandS3Settings(d.Get("s3_settings").([]interface{})[0].(map[string]interface{}))
 					input.EngineName = aws.String(engineName)
 				}
+			case engineNameTimestream:
+				if d.HasChanges("timestream_settings") {
+					input.TimestreamSettings = expandTimestreamSettings(d.Get("timestream_settings").([]interface{})[0].(map[string]interface{}))
+					input.EngineName = aws.String(engineName)
+				}
 			default:
 				if d.HasChange(names.AttrDatabaseName) {
 					input.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
@@ -1467,6 +1508,10 @@ func requireEngineSettingsCustomizeDiff(_ context.Context, diff *schema.Resource
 		if v, ok := diff.GetOk("s3_settings"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
 			return fmt.Errorf("s3_settings must be set when engine_name = %q", engineName)
 		}
+	case engineNameTimestream:
+		if v, ok := diff.GetOk("timestream_settings"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+			return fmt.Errorf("timestream_settings must be set when engine_name = %q", engineName)
+		}
 	}
 
 	return nil
@@ -1489,7 +1534,7 @@ func validateS3SSEKMSKeyCustomizeDiff(_ context.Context, d *schema.ResourceDiff,
 }
 
 func validateRedshiftSSEKMSKeyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
-	if d.Get("engine_name").(string) == engineNameRedshift {
+	if engineName := d.Get("engine_name").(string); engineName == engineNameRedshift || engineName == engineNameRedshiftServerless {
 		return validateSSEKMSKey("redshift_settings", d)
 	}
 	return nil
@@ -1623,7 +1668,7 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		if err := d.Set("redis_settings", []interface{}{tfMap}); err != nil {
 			return fmt.Errorf("setting redis_settings: %w", err)
 		}
-	case engineNameRedshift:
+	case engineNameRedshift, engineNameRedshiftServerless:
 		if endpoint.RedshiftSettings != nil {
 			d.Set(names.AttrUsername, endpoint.RedshiftSettings.Username)
 			d.Set("server_name", endpoint.RedshiftSettings.ServerName)
@@ -1674,6 +1719,10 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		if err := d.Set("s3_settings", flattenS3Settings(endpoint.S3Settings)); err != nil {
 			return fmt.Errorf("setting s3_settings for DMS: %s", err)
 		}
+	case engineNameTimestream:
+		if err := d.Set("timestream_settings", flattenTimestreamSettings(endpoint.TimestreamSettings)); err != nil {
+			return fmt.Errorf("setting timestream_settings: %w", err)
+		}
 	default:
 		d.Set(names.AttrDatabaseName, endpoint.DatabaseName)
 		d.Set(names.AttrPort, endpoint.Port)
@@ -2528,6 +2577,66 @@ func flattenS3Settings(apiObject *dms.S3Settings) []map[string]interface{} {
 	return []map[string]interface{}{tfMap}
 }
 
+func expandTimestreamSettings(tfMap map[string]interface{}) *dms.TimestreamSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.TimestreamSettings{}
+
+	if v, ok := tfMap["cdc_inserts_and_updates"].(bool); ok {
+		apiObject.CdcInsertsAndUpdates = aws.Bool(v)
+	}
+
+	if v, ok := tfMap[names.AttrDatabaseName].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["enable_magnetic_store_writes"].(bool); ok {
+		apiObject.EnableMagneticStoreWrites = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["magnetic_duration"].(int); ok && v != 0 {
+		apiObject.MagneticDuration = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["memory_duration"].(int); ok && v != 0 {
+		apiObject.MemoryDuration = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenTimestreamSettings(apiObject *dms.TimestreamSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CdcInsertsAndUpdates; v != nil {
+		tfMap["cdc_inserts_and_updates"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.DatabaseName; v != nil {
+		tfMap[names.AttrDatabaseName] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EnableMagneticStoreWrites; v != nil {
+		tfMap["enable_magnetic_store_writes"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.MagneticDuration; v != nil {
+		tfMap["magnetic_duration"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MemoryDuration; v != nil {
+		tfMap["memory_duration"] = aws.Int64Value(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func suppressExtraConnectionAttributesDiffs(_, old, new string, d *schema.ResourceData) bool {
 	if d.Id() != "" {
 		o := extraConnectionAttributesToSet(old)
@@ -561,6 +561,34 @@ func DataSourceEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"timestream_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cdc_inserts_and_updates": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrDatabaseName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_magnetic_store_writes": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"magnetic_duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrUsername: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -1997,6 +1997,48 @@ func TestAccDMSEndpoint_redis(t *testing.T) {
 	})
 }
 
+func TestAccDMSEndpoint_timestream(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_timestream(rName, 1, 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint_arn"),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.cdc_inserts_and_updates", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "timestream_settings.0.database_name", "aws_timestreamwrite_database.test", names.AttrDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.enable_magnetic_store_writes", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.magnetic_duration", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.memory_duration", "24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEndpointConfig_timestream(rName, 7, 48),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.magnetic_duration", "7"),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.memory_duration", "48"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDMSEndpoint_Redshift_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_dms_endpoint.test"
@@ -4458,6 +4500,26 @@ resource "aws_dms_endpoint" "test" {
 `, rName)
 }
 
+func testAccEndpointConfig_timestream(rName string, magneticDuration, memoryDuration int) string {
+	return fmt.Sprintf(`
+resource "aws_timestreamwrite_database" "test" {
+  database_name = %[1]q
+}
+
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "target"
+  engine_name   = "timestream"
+
+  timestream_settings {
+    database_name     = aws_timestreamwrite_database.test.database_name
+    magnetic_duration = %[2]d
+    memory_duration   = %[3]d
+  }
+}
+`, rName, magneticDuration, memoryDuration)
+}
+
 func testAccEndpointConfig_redshiftBase(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"), fmt.Sprintf(`
 resource "aws_redshift_cluster" "test" {
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EBSSnapshotBlockPublicAccess_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic: testAccEBSSnapshotBlockPublicAccess_basic,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccEBSSnapshotBlockPublicAccess_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ebs_snapshot_block_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotBlockPublicAccessDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotBlockPublicAccessConfig_basic(string(types.SnapshotBlockPublicAccessStateBlockAllSharing)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotBlockPublicAccessState(ctx, types.SnapshotBlockPublicAccessStateBlockAllSharing),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.SnapshotBlockPublicAccessStateBlockAllSharing)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEBSSnapshotBlockPublicAccessConfig_basic(string(types.SnapshotBlockPublicAccessStateBlockNewSharing)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSSnapshotBlockPublicAccessState(ctx, types.SnapshotBlockPublicAccessStateBlockNewSharing),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.SnapshotBlockPublicAccessStateBlockNewSharing)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEBSSnapshotBlockPublicAccessState(ctx context.Context, want types.SnapshotBlockPublicAccessState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindSnapshotBlockPublicAccessState(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if output != want {
+			return fmt.Errorf("EBS Snapshot Block Public Access state is %s, want %s", output, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEBSSnapshotBlockPublicAccessDestroy(ctx context.Context) resource.TestCheckFunc {
+	return testAccCheckEBSSnapshotBlockPublicAccessState(ctx, types.SnapshotBlockPublicAccessStateUnblocked)
+}
+
+func testAccEBSSnapshotBlockPublicAccessConfig_basic(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ebs_snapshot_block_public_access" "test" {
+  state = %[1]q
+}
+`, state)
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ebs_snapshot_tier", name="EBS Snapshot Tier")
+func resourceEBSSnapshotTier() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEBSSnapshotTierCreate,
+		ReadWithoutTimeout:   resourceEBSSnapshotTierRead,
+		UpdateWithoutTimeout: resourceEBSSnapshotTierUpdate,
+		DeleteWithoutTimeout: resourceEBSSnapshotTierDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ebsSnapshotArchivedTimeout),
+			Update: schema.DefaultTimeout(ebsSnapshotArchivedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"archival_complete_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_tiering_operation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_tiering_operation_status_detail": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_tiering_progress": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_tiering_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"permanent_restore": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"temporary_restore_days"},
+			},
+			"restore_expiry_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"storage_tier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(enum.Slice(append(awstypes.TargetStorageTier.Values(""), TargetStorageTierStandard)...), false),
+			},
+			"temporary_restore_days": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, 180),
+				ConflictsWith: []string{"permanent_restore"},
+			},
+		},
+	}
+}
+
+func resourceEBSSnapshotTierCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	snapshotID := d.Get("snapshot_id").(string)
+	output, err := findSnapshotTierStatusBySnapshotID(ctx, conn, snapshotID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot (%s) Storage Tier: %s", snapshotID, err)
+	}
+
+	d.SetId(snapshotID)
+
+	if err := modifyEBSSnapshotTier(ctx, conn, d, string(output.StorageTier), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return append(diags, resourceEBSSnapshotTierRead(ctx, d, meta)...)
+}
+
+func resourceEBSSnapshotTierRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	output, err := findSnapshotTierStatusBySnapshotID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Snapshot Tier %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot (%s) Storage Tier: %s", d.Id(), err)
+	}
+
+	if v := output.ArchivalCompleteTime; v != nil {
+		d.Set("archival_complete_time", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("archival_complete_time", nil)
+	}
+	d.Set("last_tiering_operation_status", output.LastTieringOperationStatus)
+	d.Set("last_tiering_operation_status_detail", output.LastTieringOperationStatusDetail)
+	d.Set("last_tiering_progress", output.LastTieringProgress)
+	if v := output.LastTieringStartTime; v != nil {
+		d.Set("last_tiering_start_time", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("last_tiering_start_time", nil)
+	}
+	if v := output.RestoreExpiryTime; v != nil {
+		d.Set("restore_expiry_time", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("restore_expiry_time", nil)
+	}
+	d.Set("snapshot_id", output.SnapshotId)
+
+	// Restoring a snapshot from the archive tier takes 24-72 hours.
+	// Report the requested tier while the restore is in progress so as not to plan another restore.
+	switch output.LastTieringOperationStatus {
+	case awstypes.TieringOperationStatusTemporaryRestoreInProgress, awstypes.TieringOperationStatusPermanentRestoreInProgress:
+		d.Set("storage_tier", TargetStorageTierStandard)
+	default:
+		d.Set("storage_tier", output.StorageTier)
+	}
+
+	return diags
+}
+
+func resourceEBSSnapshotTierUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChange("storage_tier") {
+		o, _ := d.GetChange("storage_tier")
+
+		if err := modifyEBSSnapshotTier(ctx, conn, d, o.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceEBSSnapshotTierRead(ctx, d, meta)...)
+}
+
+func resourceEBSSnapshotTierDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[WARN] EBS Snapshot Tier (%s) not restored, removing from state", d.Id())
+
+	return diags
+}
+
+// modifyEBSSnapshotTier moves the snapshot to the configured storage tier if it is not already there.
+func modifyEBSSnapshotTier(ctx context.Context, conn *ec2.Client, d *schema.ResourceData, currentTier string, timeout time.Duration) error {
+	tier := d.Get("storage_tier").(string)
+
+	if tier == currentTier {
+		return nil
+	}
+
+	if tier == string(awstypes.TargetStorageTierArchive) {
+		_, err := conn.ModifySnapshotTier(ctx, &ec2.ModifySnapshotTierInput{
+			SnapshotId:  aws.String(d.Id()),
+			StorageTier: awstypes.TargetStorageTier(tier),
+		})
+
+		if err != nil {
+			return fmt.Errorf("updating EBS Snapshot (%s) Storage Tier: %w", d.Id(), err)
+		}
+
+		if _, err := waitEBSSnapshotTierArchive(ctx, conn, d.Id(), timeout); err != nil {
+			return fmt.Errorf("waiting for EBS Snapshot (%s) Storage Tier archive: %w", d.Id(), err)
+		}
+
+		return nil
+	}
+
+	input := &ec2.RestoreSnapshotTierInput{
+		SnapshotId: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("permanent_restore"); ok {
+		input.PermanentRestore = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("temporary_restore_days"); ok {
+		input.TemporaryRestoreDays = aws.Int32(int32(v.(int)))
+	}
+
+	_, err := conn.RestoreSnapshotTier(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("restoring EBS Snapshot (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitEBSSnapshotTierRestoreStarted(ctx, conn, d.Id(), timeout); err != nil {
+		return fmt.Errorf("waiting for EBS Snapshot (%s) restore start: %w", d.Id(), err)
+	}
+
+	return nil
+}
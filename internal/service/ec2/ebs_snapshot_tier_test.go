@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EBSSnapshotTier_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ebs_snapshot_tier.test"
+	snapshotResourceName := "aws_ebs_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotTierConfig_basic(rName, "archive"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", snapshotResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "storage_tier", "archive"),
+					resource.TestCheckResourceAttr(resourceName, "last_tiering_operation_status", "archival-completed"),
+					resource.TestCheckResourceAttrSet(resourceName, "archival_complete_time"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"permanent_restore", "temporary_restore_days"},
+			},
+			{
+				Config: testAccEBSSnapshotTierConfig_temporaryRestore(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "storage_tier", "standard"),
+					resource.TestCheckResourceAttr(resourceName, "last_tiering_operation_status", "temporary-restore-in-progress"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEBSSnapshotTierConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [storage_tier]
+  }
+}
+`, rName))
+}
+
+func testAccEBSSnapshotTierConfig_basic(rName, tier string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotTierConfig_base(rName), fmt.Sprintf(`
+resource "aws_ebs_snapshot_tier" "test" {
+  snapshot_id  = aws_ebs_snapshot.test.id
+  storage_tier = %[1]q
+}
+`, tier))
+}
+
+func testAccEBSSnapshotTierConfig_temporaryRestore(rName string, days int) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotTierConfig_base(rName), fmt.Sprintf(`
+resource "aws_ebs_snapshot_tier" "test" {
+  snapshot_id            = aws_ebs_snapshot.test.id
+  storage_tier           = "standard"
+  temporary_restore_days = %[1]d
+}
+`, days))
+}
@@ -68,6 +68,10 @@ func resourceEBSVolume() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"fast_restored": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"final_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -122,6 +126,13 @@ func resourceEBSVolume() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"volume_initialization_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(100, 300),
+				RequiredWith: []string{names.AttrSnapshotID},
+			},
 		},
 	}
 }
@@ -172,6 +183,10 @@ func resourceEBSVolumeCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.VolumeType = awstypes.VolumeType(value.(string))
 	}
 
+	if value, ok := d.GetOk("volume_initialization_rate"); ok {
+		input.VolumeInitializationRate = aws.Int32(int32(value.(int)))
+	}
+
 	output, err := conn.CreateVolume(ctx, input)
 
 	if err != nil {
@@ -213,6 +228,7 @@ func resourceEBSVolumeRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set(names.AttrARN, arn.String())
 	d.Set(names.AttrAvailabilityZone, volume.AvailabilityZone)
 	d.Set(names.AttrEncrypted, volume.Encrypted)
+	d.Set("fast_restored", volume.FastRestored)
 	d.Set(names.AttrIOPS, volume.Iops)
 	d.Set(names.AttrKMSKeyID, volume.KmsKeyId)
 	d.Set("multi_attach_enabled", volume.MultiAttachEnabled)
@@ -221,6 +237,7 @@ func resourceEBSVolumeRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set(names.AttrSnapshotID, volume.SnapshotId)
 	d.Set(names.AttrThroughput, volume.Throughput)
 	d.Set(names.AttrType, volume.VolumeType)
+	d.Set("volume_initialization_rate", volume.VolumeInitializationRate)
 
 	setTagsOutV2(ctx, volume.Tags)
 
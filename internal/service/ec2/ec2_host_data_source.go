@@ -11,10 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -43,6 +45,38 @@ func dataSourceHost() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"available_capacity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"available_instance_capacity": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"available_capacity": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									names.AttrInstanceType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"total_capacity": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"available_vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrAvailabilityZone: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -57,10 +91,19 @@ func dataSourceHost() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"host_maintenance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"host_recovery": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"instance_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"instance_family": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -124,11 +167,22 @@ func dataSourceHostRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set(names.AttrARN, arn)
 	d.Set("asset_id", host.AssetId)
 	d.Set("auto_placement", host.AutoPlacement)
+	if host.AvailableCapacity != nil {
+		if err := d.Set("available_capacity", []interface{}{flattenAvailableCapacity(host.AvailableCapacity)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting available_capacity: %s", err)
+		}
+	} else {
+		d.Set("available_capacity", nil)
+	}
 	d.Set(names.AttrAvailabilityZone, host.AvailabilityZone)
 	d.Set("cores", host.HostProperties.Cores)
 	d.Set("host_id", host.HostId)
+	d.Set("host_maintenance", host.HostMaintenance)
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
+	d.Set("instance_ids", tfslices.ApplyToAll(host.Instances, func(v awstypes.HostInstance) string {
+		return aws.ToString(v.InstanceId)
+	}))
 	d.Set(names.AttrInstanceType, host.HostProperties.InstanceType)
 	d.Set("outpost_arn", host.OutpostArn)
 	d.Set(names.AttrOwnerID, host.OwnerId)
@@ -139,3 +193,47 @@ func dataSourceHostRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	return diags
 }
+
+func flattenAvailableCapacity(apiObject *awstypes.AvailableCapacity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"available_instance_capacity": flattenInstanceCapacities(apiObject.AvailableInstanceCapacity),
+	}
+
+	if v := apiObject.AvailableVCpus; v != nil {
+		tfMap["available_vcpus"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
+func flattenInstanceCapacities(apiObjects []awstypes.InstanceCapacity) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AvailableCapacity; v != nil {
+			tfMap["available_capacity"] = aws.ToInt32(v)
+		}
+
+		if v := apiObject.InstanceType; v != nil {
+			tfMap[names.AttrInstanceType] = aws.ToString(v)
+		}
+
+		if v := apiObject.TotalCapacity; v != nil {
+			tfMap["total_capacity"] = aws.ToInt32(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
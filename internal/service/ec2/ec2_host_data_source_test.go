@@ -29,11 +29,19 @@ func TestAccEC2HostDataSource_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
 					resource.TestCheckResourceAttrPair(dataSourceName, "auto_placement", resourceName, "auto_placement"),
+					resource.TestCheckResourceAttr(dataSourceName, "available_capacity.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "available_capacity.0.available_vcpus"),
+					resource.TestCheckResourceAttr(dataSourceName, "available_capacity.0.available_instance_capacity.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "available_capacity.0.available_instance_capacity.0.instance_type", "c5.large"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "available_capacity.0.available_instance_capacity.0.available_capacity"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "available_capacity.0.available_instance_capacity.0.total_capacity"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAvailabilityZone, resourceName, names.AttrAvailabilityZone),
 					resource.TestCheckResourceAttrSet(dataSourceName, "cores"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "host_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_maintenance", resourceName, "host_maintenance"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "host_recovery", resourceName, "host_recovery"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "instance_family", resourceName, "instance_family"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_ids.#", acctest.Ct0),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrInstanceType, resourceName, names.AttrInstanceType),
 					resource.TestCheckResourceAttrPair(dataSourceName, "outpost_arn", resourceName, "outpost_arn"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrOwnerID, resourceName, names.AttrOwnerID),
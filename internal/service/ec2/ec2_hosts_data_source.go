@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_hosts", name="Hosts")
+func dataSourceHosts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceHostsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrFilter: customFiltersSchema(),
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags: tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.DescribeHostsInput{}
+
+	if tags, tagsOk := d.GetOk(names.AttrTags); tagsOk {
+		input.Filter = append(input.Filter, newTagFilterListV2(
+			TagsV2(tftags.New(ctx, tags.(map[string]interface{}))),
+		)...)
+	}
+
+	if filters, filtersOk := d.GetOk(names.AttrFilter); filtersOk {
+		input.Filter = append(input.Filter,
+			newCustomFilterListV2(filters.(*schema.Set))...)
+	}
+
+	if len(input.Filter) == 0 {
+		input.Filter = nil
+	}
+
+	output, err := findHosts(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Hosts: %s", err)
+	}
+
+	var hostIDs []string
+
+	for _, v := range output {
+		// Released hosts remain visible for a time.
+		if state := v.State; state == awstypes.AllocationStateReleased || state == awstypes.AllocationStateReleasedPermanentFailure {
+			continue
+		}
+
+		hostIDs = append(hostIDs, aws.ToString(v.HostId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrIDs, hostIDs)
+
+	return diags
+}
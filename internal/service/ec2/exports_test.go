@@ -22,6 +22,7 @@ var (
 	ResourceEBSEncryptionByDefault                   = resourceEBSEncryptionByDefault
 	ResourceEBSFastSnapshotRestore                   = newEBSFastSnapshotRestoreResource
 	ResourceEBSSnapshot                              = resourceEBSSnapshot
+	ResourceEBSSnapshotBlockPublicAccess             = resourceEBSSnapshotBlockPublicAccess
 	ResourceEBSSnapshotCopy                          = resourceEBSSnapshotCopy
 	ResourceEBSSnapshotImport                        = resourceEBSSnapshotImport
 	ResourceEBSVolume                                = resourceEBSVolume
@@ -129,6 +130,7 @@ var (
 	FindRouteTableAssociationByID                              = findRouteTableAssociationByID
 	FindRouteTableByID                                         = findRouteTableByID
 	FindSnapshot                                               = findSnapshot
+	FindSnapshotBlockPublicAccessState                         = findSnapshotBlockPublicAccessState
 	FindSnapshotByID                                           = findSnapshotByID
 	FindSpotDatafeedSubscription                               = findSpotDatafeedSubscription
 	FindSpotFleetRequestByID                                   = findSpotFleetRequestByID
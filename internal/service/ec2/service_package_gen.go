@@ -72,6 +72,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory: newSecurityGroupEgressRulesExclusiveResource,
+			Name:    "Security Group Egress Rules Exclusive",
+		},
 		{
 			Factory: newSecurityGroupIngressRuleResource,
 			Name:    "Security Group Ingress Rule",
@@ -79,6 +83,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory: newSecurityGroupIngressRulesExclusiveResource,
+			Name:    "Security Group Ingress Rules Exclusive",
+		},
 	}
 }
 
@@ -163,6 +171,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Host",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceHosts,
+			TypeName: "aws_ec2_hosts",
+			Name:     "Hosts",
+		},
 		{
 			Factory:  dataSourceInstanceType,
 			TypeName: "aws_ec2_instance_type",
@@ -247,6 +260,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ec2_spot_price",
 			Name:     "Spot Price",
 		},
+		{
+			Factory:  DataSourceTrafficMirrorFilters,
+			TypeName: "aws_ec2_traffic_mirror_filters",
+		},
+		{
+			Factory:  DataSourceTrafficMirrorSessions,
+			TypeName: "aws_ec2_traffic_mirror_sessions",
+		},
 		{
 			Factory:  dataSourceTransitGateway,
 			TypeName: "aws_ec2_transit_gateway",
@@ -594,6 +615,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceEBSSnapshotBlockPublicAccess,
+			TypeName: "aws_ebs_snapshot_block_public_access",
+			Name:     "EBS Snapshot Block Public Access",
+		},
 		{
 			Factory:  resourceEBSSnapshotCopy,
 			TypeName: "aws_ebs_snapshot_copy",
@@ -610,6 +636,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceEBSSnapshotTier,
+			TypeName: "aws_ebs_snapshot_tier",
+			Name:     "EBS Snapshot Tier",
+		},
 		{
 			Factory:  resourceEBSVolume,
 			TypeName: "aws_ebs_volume",
@@ -884,6 +884,22 @@ func statusImageBlockPublicAccess(ctx context.Context, conn *ec2.Client) retry.S
 	}
 }
 
+func statusSnapshotBlockPublicAccess(ctx context.Context, conn *ec2.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSnapshotBlockPublicAccessState(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output), nil
+	}
+}
+
 func statusTransitGateway(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findTransitGatewayByID(ctx, conn, id)
@@ -1150,6 +1166,22 @@ func statusSnapshotStorageTier(ctx context.Context, conn *ec2.Client, id string)
 	}
 }
 
+func statusSnapshotTieringOperation(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSnapshotTierStatusBySnapshotID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.LastTieringOperationStatus), nil
+	}
+}
+
 func statusInstanceConnectEndpoint(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findInstanceConnectEndpointByID(ctx, conn, id)
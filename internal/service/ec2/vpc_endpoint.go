@@ -16,7 +16,9 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -159,6 +161,13 @@ func resourceVPCEndpoint() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"service_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
 			names.AttrState: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -191,7 +200,10 @@ func resourceVPCEndpoint() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceVPCEndpointCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -242,6 +254,10 @@ func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.SecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("service_region"); ok {
+		input.ServiceRegion = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk(names.AttrSubnetIDs); ok && v.(*schema.Set).Len() > 0 {
 		input.SubnetIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -331,6 +347,7 @@ func resourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("route_table_ids", vpce.RouteTableIds)
 	d.Set(names.AttrSecurityGroupIDs, flattenSecurityGroupIdentifiers(vpce.Groups))
 	d.Set(names.AttrServiceName, serviceName)
+	d.Set("service_region", vpce.ServiceRegion)
 	d.Set(names.AttrState, vpce.State)
 	d.Set(names.AttrSubnetIDs, vpce.SubnetIds)
 	// VPC endpoints don't have types in GovCloud, so set type to default if empty
@@ -400,7 +417,17 @@ func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if d.HasChange(names.AttrIPAddressType) {
-			input.IpAddressType = awstypes.IpAddressType(d.Get(names.AttrIPAddressType).(string))
+			ipAddressType := awstypes.IpAddressType(d.Get(names.AttrIPAddressType).(string))
+			input.IpAddressType = ipAddressType
+
+			// The DNS record IP type must be compatible with the new IP address type.
+			// If it's not explicitly configured, default it to match the IP address type.
+			if input.DnsOptions == nil || input.DnsOptions.DnsRecordIpType == "" {
+				if input.DnsOptions == nil {
+					input.DnsOptions = &awstypes.DnsOptionsSpecification{}
+				}
+				input.DnsOptions.DnsRecordIpType = awstypes.DnsRecordIpType(ipAddressType)
+			}
 		}
 
 		privateDNSEnabled := d.Get("private_dns_enabled").(bool)
@@ -472,6 +499,53 @@ func resourceVPCEndpointDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceVPCEndpointCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	ipAddressType := awstypes.IpAddressType(diff.Get(names.AttrIPAddressType).(string))
+	var dnsRecordIPType awstypes.DnsRecordIpType
+
+	if v := diff.GetRawConfig().GetAttr("dns_options"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		if v := v.Index(cty.NumberIntVal(0)).GetAttr("dns_record_ip_type"); v.IsKnown() && !v.IsNull() {
+			dnsRecordIPType = awstypes.DnsRecordIpType(v.AsString())
+		}
+	}
+
+	if ipAddressType != "" && dnsRecordIPType != "" {
+		if err := validDNSRecordIPTypeForIPAddressType(dnsRecordIPType, ipAddressType); err != nil {
+			return err
+		}
+	}
+
+	// The DNS record IP type changes along with the IP address type unless explicitly configured.
+	if diff.Id() != "" && diff.HasChange(names.AttrIPAddressType) && dnsRecordIPType == "" {
+		if err := diff.SetNewComputed("dns_options"); err != nil {
+			return fmt.Errorf("setting dns_options to computed: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// validDNSRecordIPTypeForIPAddressType returns an error if the specified DNS record IP type
+// cannot be used with a VPC endpoint of the specified IP address type.
+func validDNSRecordIPTypeForIPAddressType(dnsRecordIPType awstypes.DnsRecordIpType, ipAddressType awstypes.IpAddressType) error {
+	if dnsRecordIPType == awstypes.DnsRecordIpTypeServiceDefined {
+		return nil
+	}
+
+	switch ipAddressType {
+	case awstypes.IpAddressTypeIpv4:
+		if dnsRecordIPType != awstypes.DnsRecordIpTypeIpv4 {
+			return fmt.Errorf(`dns_options.0.dns_record_ip_type must be "%s" or "%s" when ip_address_type is "%s"`, awstypes.DnsRecordIpTypeIpv4, awstypes.DnsRecordIpTypeServiceDefined, ipAddressType)
+		}
+	case awstypes.IpAddressTypeIpv6:
+		if dnsRecordIPType != awstypes.DnsRecordIpTypeIpv6 {
+			return fmt.Errorf(`dns_options.0.dns_record_ip_type must be "%s" or "%s" when ip_address_type is "%s"`, awstypes.DnsRecordIpTypeIpv6, awstypes.DnsRecordIpTypeServiceDefined, ipAddressType)
+		}
+	}
+
+	return nil
+}
+
 func vpcEndpointAccept(ctx context.Context, conn *ec2.Client, vpceID, serviceName string, timeout time.Duration) error {
 	serviceConfiguration, err := findVPCEndpointServiceConfigurationByServiceName(ctx, conn, serviceName)
 
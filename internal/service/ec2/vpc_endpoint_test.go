@@ -474,6 +474,64 @@ func TestAccVPCEndpoint_ipAddressType(t *testing.T) {
 	})
 }
 
+func TestAccVPCEndpoint_ipAddressTypeDNSRecordIPTypeInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCEndpointConfig_ipAddressTypeDNSRecordIPType(rName, "ipv4", "ipv6"),
+				ExpectError: regexache.MustCompile(`dns_options.0.dns_record_ip_type must be "ipv4" or "service-defined" when ip_address_type is "ipv4"`),
+			},
+			{
+				Config:      testAccVPCEndpointConfig_ipAddressTypeDNSRecordIPType(rName, "ipv4", "dualstack"),
+				ExpectError: regexache.MustCompile(`dns_options.0.dns_record_ip_type must be "ipv4" or "service-defined" when ip_address_type is "ipv4"`),
+			},
+		},
+	})
+}
+
+func TestAccVPCEndpoint_crossRegionService(t *testing.T) {
+	ctx := acctest.Context(t)
+	var endpoint awstypes.VpcEndpoint
+	resourceName := "aws_vpc_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	// The service must be in the alternate Region and must allow connections from the current Region.
+	serviceName := acctest.SkipIfEnvVarNotSet(t, "VPC_ENDPOINT_CROSS_REGION_SERVICE_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointConfig_crossRegionService(rName, serviceName, acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCEndpointExists(ctx, resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, names.AttrServiceName, serviceName),
+					resource.TestCheckResourceAttr(resourceName, "service_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "vpc_endpoint_type", "Interface"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_accept"},
+			},
+		},
+	})
+}
+
 func TestAccVPCEndpoint_interfaceWithSubnetAndSecurityGroup(t *testing.T) {
 	ctx := acctest.Context(t)
 	var endpoint awstypes.VpcEndpoint
@@ -959,6 +1017,50 @@ resource "aws_vpc_endpoint" "test" {
 `, rName, addressType))
 }
 
+func testAccVPCEndpointConfig_ipAddressTypeDNSRecordIPType(rName, ipAddressType, dnsRecordIPType string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_region" "current" {}
+
+resource "aws_vpc_endpoint" "test" {
+  vpc_id            = aws_vpc.test.id
+  service_name      = "com.amazonaws.${data.aws_region.current.name}.ec2"
+  vpc_endpoint_type = "Interface"
+  ip_address_type   = %[2]q
+
+  dns_options {
+    dns_record_ip_type = %[3]q
+  }
+}
+`, rName, ipAddressType, dnsRecordIPType)
+}
+
+func testAccVPCEndpointConfig_crossRegionService(rName, serviceName, serviceRegion string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointConfig_vpcBase(rName), fmt.Sprintf(`
+resource "aws_vpc_endpoint" "test" {
+  vpc_id              = aws_vpc.test.id
+  service_name        = %[2]q
+  service_region      = %[3]q
+  vpc_endpoint_type   = "Interface"
+  private_dns_enabled = false
+  auto_accept         = true
+  subnet_ids          = [aws_subnet.test[0].id]
+  security_group_ids  = [aws_security_group.test[0].id]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, serviceName, serviceRegion))
+}
+
 func testAccVPCEndpointConfig_gatewayPolicy(rName, policy string) string {
 	return fmt.Sprintf(`
 data "aws_vpc_endpoint_service" "test" {
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -82,7 +83,10 @@ func ResourceVPCPeeringConnection() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceVPCPeeringConnectionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -117,10 +121,6 @@ func resourceVPCPeeringConnectionCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if v, ok := d.GetOk("peer_region"); ok {
-		if _, ok := d.GetOk("auto_accept"); ok {
-			return sdkdiag.AppendErrorf(diags, "`peer_region` cannot be set whilst `auto_accept` is `true` when creating an EC2 VPC Peering Connection")
-		}
-
 		input.PeerRegion = aws.String(v.(string))
 	}
 
@@ -262,6 +262,25 @@ func resourceVPCPeeringConnectionDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+func resourceVPCPeeringConnectionCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	peerRegion := diff.Get("peer_region").(string)
+
+	if diff.Id() == "" && peerRegion != "" {
+		if _, ok := diff.GetOk("auto_accept"); ok {
+			return errors.New("`peer_region` cannot be set whilst `auto_accept` is `true` when creating an EC2 VPC Peering Connection")
+		}
+	}
+
+	// For inter-Region peering connections the accepter VPC's options can only be modified from the accepter's Region.
+	if peerRegion != "" && peerRegion != meta.(*conns.AWSClient).Region {
+		if v := diff.GetRawConfig().GetAttr("accepter"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			return fmt.Errorf("`accepter` cannot be set for an inter-Region EC2 VPC Peering Connection (peer Region %s). Use the aws_vpc_peering_connection_accepter or aws_vpc_peering_connection_options resource in the accepter's Region", peerRegion)
+		}
+	}
+
+	return nil
+}
+
 func acceptVPCPeeringConnection(ctx context.Context, conn *ec2.EC2, vpcPeeringConnectionID string, timeout time.Duration) (*ec2.VpcPeeringConnection, error) {
 	log.Printf("[INFO] Accepting EC2 VPC Peering Connection: %s", vpcPeeringConnectionID)
 	_, err := conn.AcceptVpcPeeringConnectionWithContext(ctx, &ec2.AcceptVpcPeeringConnectionInput{
@@ -285,13 +304,17 @@ func acceptVPCPeeringConnection(ctx context.Context, conn *ec2.EC2, vpcPeeringCo
 func modifyVPCPeeringConnectionOptions(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData, vpcPeeringConnection *ec2.VpcPeeringConnection, checkActive bool) error {
 	var accepterPeeringConnectionOptions, requesterPeeringConnectionOptions *ec2.PeeringConnectionOptionsRequest
 
-	if key := "accepter"; d.HasChange(key) {
+	// When both VPCs are in the same account and Region a single request can modify both sides' options,
+	// so send both whenever either changes and the options converge in one apply.
+	both := d.HasChanges("accepter", "requester") && vpcPeeringConnectionIsIntraAccountIntraRegion(vpcPeeringConnection)
+
+	if key := "accepter"; d.HasChange(key) || both {
 		if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			accepterPeeringConnectionOptions = expandPeeringConnectionOptionsRequest(v.([]interface{})[0].(map[string]interface{}))
 		}
 	}
 
-	if key := "requester"; d.HasChange(key) {
+	if key := "requester"; d.HasChange(key) || both {
 		if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			requesterPeeringConnectionOptions = expandPeeringConnectionOptionsRequest(v.([]interface{})[0].(map[string]interface{}))
 		}
@@ -354,6 +377,16 @@ func modifyVPCPeeringConnectionOptions(ctx context.Context, conn *ec2.EC2, d *sc
 	return nil
 }
 
+func vpcPeeringConnectionIsIntraAccountIntraRegion(vpcPeeringConnection *ec2.VpcPeeringConnection) bool {
+	accepter, requester := vpcPeeringConnection.AccepterVpcInfo, vpcPeeringConnection.RequesterVpcInfo
+
+	if accepter == nil || requester == nil {
+		return false
+	}
+
+	return aws.StringValue(accepter.OwnerId) == aws.StringValue(requester.OwnerId) && aws.StringValue(accepter.Region) == aws.StringValue(requester.Region)
+}
+
 func vpcPeeringConnectionOptionsEqual(o1 *ec2.VpcPeeringConnectionOptionsDescription, o2 *ec2.PeeringConnectionOptionsRequest) bool {
 	return aws.BoolValue(o1.AllowDnsResolutionFromRemoteVpc) == aws.BoolValue(o2.AllowDnsResolutionFromRemoteVpc)
 }
@@ -227,6 +227,52 @@ func TestAccVPCPeeringConnection_options(t *testing.T) {
 	})
 }
 
+func TestAccVPCPeeringConnection_optionsBothSides(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_accepterRequesterOptionsBothSides(rName, true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accepter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "accepter.0.allow_remote_vpc_dns_resolution", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "requester.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "requester.0.allow_remote_vpc_dns_resolution", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccVPCPeeringConnectionConfig_accepterRequesterOptionsBothSides(rName, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accepter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "accepter.0.allow_remote_vpc_dns_resolution", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "requester.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "requester.0.allow_remote_vpc_dns_resolution", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccVPCPeeringConnectionConfig_accepterRequesterOptionsBothSides(rName, false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accepter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "accepter.0.allow_remote_vpc_dns_resolution", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "requester.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "requester.0.allow_remote_vpc_dns_resolution", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCPeeringConnection_failedState(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -266,6 +312,28 @@ func TestAccVPCPeeringConnection_peerRegionAutoAccept(t *testing.T) {
 	})
 }
 
+func TestAccVPCPeeringConnection_peerRegionAccepterOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionConfig_alternateRegionAccepterOptions(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile("`accepter` cannot be set for an inter-Region EC2 VPC Peering Connection"),
+			},
+		},
+	})
+}
+
 func TestAccVPCPeeringConnection_region(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.VpcPeeringConnection
@@ -558,6 +626,46 @@ resource "aws_vpc_peering_connection" "test" {
 `, rName)
 }
 
+func testAccVPCPeeringConnectionConfig_accepterRequesterOptionsBothSides(rName string, accepterDNSResolution, requesterDNSResolution bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block           = "10.1.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+
+  accepter {
+    allow_remote_vpc_dns_resolution = %[2]t
+  }
+
+  requester {
+    allow_remote_vpc_dns_resolution = %[3]t
+  }
+}
+`, rName, accepterDNSResolution, requesterDNSResolution)
+}
+
 func testAccVPCPeeringConnectionConfig_failedState(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -620,6 +728,42 @@ resource "aws_vpc_peering_connection" "test" {
 `, rName, autoAccept, acctest.AlternateRegion()))
 }
 
+func testAccVPCPeeringConnectionConfig_alternateRegionAccepterOptions(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  provider = "awsalternate"
+
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  peer_region = %[2]q
+
+  accepter {
+    allow_remote_vpc_dns_resolution = true
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, acctest.AlternateRegion()))
+}
+
 func testAccVPCPeeringConnectionConfig_autoAccept(rName string, autoAccept bool) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
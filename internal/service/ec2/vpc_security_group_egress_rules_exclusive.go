@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// @FrameworkResource("aws_vpc_security_group_egress_rules_exclusive", name="Security Group Egress Rules Exclusive")
+func newSecurityGroupEgressRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupEgressRulesExclusiveResource{}
+	r.securityGroupRules = r
+
+	return r, nil
+}
+
+type securityGroupEgressRulesExclusiveResource struct {
+	securityGroupRulesExclusiveResource
+}
+
+func (*securityGroupEgressRulesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_egress_rules_exclusive"
+}
+
+func (r *securityGroupEgressRulesExclusiveResource) authorize(ctx context.Context, groupID string, ipPermissions []*ec2.IpPermission) error {
+	conn := r.Meta().EC2Conn(ctx)
+
+	_, err := conn.AuthorizeSecurityGroupEgressWithContext(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+		GroupId:       aws.String(groupID),
+		IpPermissions: ipPermissions,
+	})
+
+	return err
+}
+
+func (r *securityGroupEgressRulesExclusiveResource) revoke(ctx context.Context, groupID string, securityGroupRuleIDs []string) error {
+	conn := r.Meta().EC2Conn(ctx)
+
+	_, err := conn.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{
+		GroupId:              aws.String(groupID),
+		SecurityGroupRuleIds: aws.StringSlice(securityGroupRuleIDs),
+	})
+
+	return err
+}
+
+func (r *securityGroupEgressRulesExclusiveResource) updateDescriptions(ctx context.Context, groupID string, descriptions []*ec2.SecurityGroupRuleDescription) error {
+	conn := r.Meta().EC2Conn(ctx)
+
+	_, err := conn.UpdateSecurityGroupRuleDescriptionsEgressWithContext(ctx, &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+		GroupId:                       aws.String(groupID),
+		SecurityGroupRuleDescriptions: descriptions,
+	})
+
+	return err
+}
+
+func (*securityGroupEgressRulesExclusiveResource) isEgress() bool {
+	return true
+}
@@ -0,0 +1,484 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_ingress_rules_exclusive", name="Security Group Ingress Rules Exclusive")
+func newSecurityGroupIngressRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupIngressRulesExclusiveResource{}
+	r.securityGroupRules = r
+
+	return r, nil
+}
+
+type securityGroupIngressRulesExclusiveResource struct {
+	securityGroupRulesExclusiveResource
+}
+
+func (*securityGroupIngressRulesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_ingress_rules_exclusive"
+}
+
+func (r *securityGroupIngressRulesExclusiveResource) authorize(ctx context.Context, groupID string, ipPermissions []*ec2.IpPermission) error {
+	conn := r.Meta().EC2Conn(ctx)
+
+	_, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:       aws.String(groupID),
+		IpPermissions: ipPermissions,
+	})
+
+	return err
+}
+
+func (r *securityGroupIngressRulesExclusiveResource) revoke(ctx context.Context, groupID string, securityGroupRuleIDs []string) error {
+	conn := r.Meta().EC2Conn(ctx)
+
+	_, err := conn.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+		GroupId:              aws.String(groupID),
+		SecurityGroupRuleIds: aws.StringSlice(securityGroupRuleIDs),
+	})
+
+	return err
+}
+
+func (r *securityGroupIngressRulesExclusiveResource) updateDescriptions(ctx context.Context, groupID string, descriptions []*ec2.SecurityGroupRuleDescription) error {
+	conn := r.Meta().EC2Conn(ctx)
+
+	_, err := conn.UpdateSecurityGroupRuleDescriptionsIngressWithContext(ctx, &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+		GroupId:                       aws.String(groupID),
+		SecurityGroupRuleDescriptions: descriptions,
+	})
+
+	return err
+}
+
+func (*securityGroupIngressRulesExclusiveResource) isEgress() bool {
+	return false
+}
+
+// Base structure and methods for exclusive management of VPC security group rules.
+
+// securityGroupRulesExclusiveBatchSize is the maximum number of rules authorized or revoked in a single API call.
+const securityGroupRulesExclusiveBatchSize = 100
+
+type securityGroupRules interface {
+	authorize(context.Context, string, []*ec2.IpPermission) error
+	revoke(context.Context, string, []string) error
+	updateDescriptions(context.Context, string, []*ec2.SecurityGroupRuleDescription) error
+	isEgress() bool
+}
+
+type securityGroupRulesExclusiveResource struct {
+	securityGroupRules
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	sourceAttributeNames := []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"}
+	exactlyOneSourceOf := func(name string) validator.String {
+		var expressions []path.Expression
+		for _, v := range sourceAttributeNames {
+			if v != name {
+				expressions = append(expressions, path.MatchRelative().AtParent().AtName(v))
+			}
+		}
+
+		return stringvalidator.ExactlyOneOf(expressions...)
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[securityGroupRulesExclusiveRuleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr_ipv4": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.IPv4CIDRNetworkAddress(),
+								exactlyOneSourceOf("cidr_ipv4"),
+							},
+						},
+						"cidr_ipv6": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.IPv6CIDRNetworkAddress(),
+								exactlyOneSourceOf("cidr_ipv6"),
+							},
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"from_port": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(-1, 65535),
+							},
+						},
+						"ip_protocol": schema.StringAttribute{
+							CustomType: ipProtocolType{},
+							Required:   true,
+						},
+						"prefix_list_id": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								exactlyOneSourceOf("prefix_list_id"),
+							},
+						},
+						"referenced_security_group_id": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								exactlyOneSourceOf("referenced_security_group_id"),
+							},
+						},
+						"to_port": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(-1, 65535),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := data.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := data.SecurityGroupID.ValueString()
+	if err := r.syncRules(ctx, groupID, rules); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating VPC Security Group (%s) exclusive rules", groupID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	groupID := data.SecurityGroupID.ValueString()
+	_, err := FindSecurityGroupByID(ctx, conn, groupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s)", groupID), err.Error())
+
+		return
+	}
+
+	output, err := r.findRules(ctx, groupID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s) rules", groupID), err.Error())
+
+		return
+	}
+
+	// Keep the configured representation of rules that match remote rules, e.g. null ports.
+	current := make(map[string]*securityGroupRulesExclusiveRuleModel)
+	if !data.Rules.IsNull() && !data.Rules.IsUnknown() {
+		rules, diags := data.Rules.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for _, v := range rules {
+			current[v.key()] = v
+		}
+	}
+
+	rules := make([]*securityGroupRulesExclusiveRuleModel, 0, len(output))
+	for _, v := range output {
+		rule := flattenSecurityGroupRulesExclusiveRule(ctx, v, r.Meta().AccountID)
+		if v, ok := current[rule.key()]; ok {
+			rule = v
+		}
+
+		rules = append(rules, rule)
+	}
+
+	data.Rules = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, rules)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Rules.Equal(old.Rules) {
+		rules, diags := new.Rules.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		groupID := new.SecurityGroupID.ValueString()
+		if err := r.syncRules(ctx, groupID, rules); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating VPC Security Group (%s) exclusive rules", groupID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Removing the resource only stops exclusive management; the security group's rules are left as they are.
+	tflog.Debug(ctx, "removing VPC Security Group exclusive rules from state", map[string]interface{}{
+		"security_group_id": data.SecurityGroupID.ValueString(),
+	})
+}
+
+func (r *securityGroupRulesExclusiveResource) findRules(ctx context.Context, groupID string) ([]*ec2.SecurityGroupRule, error) {
+	conn := r.Meta().EC2Conn(ctx)
+
+	output, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	isEgress := r.securityGroupRules.isEgress()
+
+	return tfslices.Filter(output, func(v *ec2.SecurityGroupRule) bool {
+		return aws.BoolValue(v.IsEgress) == isEgress
+	}), nil
+}
+
+// syncRules makes the security group's rules in this direction exactly rules.
+// The current rules are listed once and only the differences are applied, in batches.
+// Missing rules are authorized before unwanted rules are revoked so that traffic isn't interrupted,
+// and rules that differ only in description are updated in place.
+func (r *securityGroupRulesExclusiveResource) syncRules(ctx context.Context, groupID string, rules []*securityGroupRulesExclusiveRuleModel) error {
+	output, err := r.findRules(ctx, groupID)
+
+	if err != nil {
+		return err
+	}
+
+	want := make(map[string]*securityGroupRulesExclusiveRuleModel, len(rules))
+	for _, v := range rules {
+		want[v.permissionKey()] = v
+	}
+
+	var revokeIDs []string
+	var descriptions []*ec2.SecurityGroupRuleDescription
+	for _, v := range output {
+		key := flattenSecurityGroupRulesExclusiveRule(ctx, v, r.Meta().AccountID).permissionKey()
+
+		rule, ok := want[key]
+		if !ok {
+			revokeIDs = append(revokeIDs, aws.StringValue(v.SecurityGroupRuleId))
+			continue
+		}
+
+		delete(want, key)
+
+		if description := rule.Description.ValueString(); description != aws.StringValue(v.Description) {
+			descriptions = append(descriptions, &ec2.SecurityGroupRuleDescription{
+				Description:         aws.String(description),
+				SecurityGroupRuleId: v.SecurityGroupRuleId,
+			})
+		}
+	}
+
+	var ipPermissions []*ec2.IpPermission
+	for _, v := range want {
+		ipPermissions = append(ipPermissions, v.expandIPPermission(ctx))
+	}
+
+	for _, chunk := range tfslices.Chunks(ipPermissions, securityGroupRulesExclusiveBatchSize) {
+		if err := r.securityGroupRules.authorize(ctx, groupID, chunk); err != nil {
+			return fmt.Errorf("authorizing rules: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(descriptions, securityGroupRulesExclusiveBatchSize) {
+		if err := r.securityGroupRules.updateDescriptions(ctx, groupID, chunk); err != nil {
+			return fmt.Errorf("updating rule descriptions: %w", err)
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(revokeIDs, securityGroupRulesExclusiveBatchSize) {
+		if err := r.securityGroupRules.revoke(ctx, groupID, chunk); err != nil {
+			return fmt.Errorf("revoking rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func flattenSecurityGroupRulesExclusiveRule(ctx context.Context, apiObject *ec2.SecurityGroupRule, accountID string) *securityGroupRulesExclusiveRuleModel {
+	return &securityGroupRulesExclusiveRuleModel{
+		CIDRIPv4:                  fwflex.StringToFramework(ctx, apiObject.CidrIpv4),
+		CIDRIPv6:                  fwflex.StringToFramework(ctx, apiObject.CidrIpv6),
+		Description:               fwflex.StringToFramework(ctx, apiObject.Description),
+		FromPort:                  fwflex.Int64ToFramework(ctx, apiObject.FromPort),
+		IPProtocol:                fwflex.StringToFrameworkValuable[ipProtocol](ctx, apiObject.IpProtocol),
+		PrefixListID:              fwflex.StringToFramework(ctx, apiObject.PrefixListId),
+		ReferencedSecurityGroupID: flattenReferencedSecurityGroup(ctx, apiObject.ReferencedGroupInfo, accountID),
+		ToPort:                    fwflex.Int64ToFramework(ctx, apiObject.ToPort),
+	}
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	ID              types.String                                                         `tfsdk:"id"`
+	Rules           fwtypes.SetNestedObjectValueOf[securityGroupRulesExclusiveRuleModel] `tfsdk:"rule"`
+	SecurityGroupID types.String                                                         `tfsdk:"security_group_id"`
+}
+
+func (model *securityGroupRulesExclusiveResourceModel) InitFromID() error {
+	model.SecurityGroupID = model.ID
+
+	return nil
+}
+
+func (model *securityGroupRulesExclusiveResourceModel) setID() {
+	model.ID = model.SecurityGroupID
+}
+
+type securityGroupRulesExclusiveRuleModel struct {
+	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
+	Description               types.String `tfsdk:"description"`
+	FromPort                  types.Int64  `tfsdk:"from_port"`
+	IPProtocol                ipProtocol   `tfsdk:"ip_protocol"`
+	PrefixListID              types.String `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
+	ToPort                    types.Int64  `tfsdk:"to_port"`
+}
+
+// key returns a normalized identity for the rule, including its description.
+func (model *securityGroupRulesExclusiveRuleModel) key() string {
+	return model.permissionKey() + "|" + model.Description.ValueString()
+}
+
+// permissionKey returns a normalized identity for the traffic the rule permits, used to match configured rules against remote ones.
+// AWS treats rules that differ only in description as duplicates.
+func (model *securityGroupRulesExclusiveRuleModel) permissionKey() string {
+	protocol := protocolForValue(model.IPProtocol.ValueString())
+
+	// Null ports and all-traffic rules are reported as -1.
+	fromPort, toPort := int64(-1), int64(-1)
+	if protocol != "-1" {
+		if !model.FromPort.IsNull() {
+			fromPort = model.FromPort.ValueInt64()
+		}
+		if !model.ToPort.IsNull() {
+			toPort = model.ToPort.ValueInt64()
+		}
+	}
+
+	return strings.Join([]string{
+		protocol,
+		fmt.Sprint(fromPort),
+		fmt.Sprint(toPort),
+		model.CIDRIPv4.ValueString(),
+		model.CIDRIPv6.ValueString(),
+		model.PrefixListID.ValueString(),
+		model.ReferencedSecurityGroupID.ValueString(),
+	}, "|")
+}
+
+func (model *securityGroupRulesExclusiveRuleModel) expandIPPermission(ctx context.Context) *ec2.IpPermission {
+	data := &securityGroupRuleResourceModel{
+		CIDRIPv4:                  model.CIDRIPv4,
+		CIDRIPv6:                  model.CIDRIPv6,
+		Description:               model.Description,
+		FromPort:                  model.FromPort,
+		IPProtocol:                model.IPProtocol,
+		PrefixListID:              model.PrefixListID,
+		ReferencedSecurityGroupID: model.ReferencedSecurityGroupID,
+		ToPort:                    model.ToPort,
+	}
+
+	return data.expandIPPermission(ctx)
+}
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupIngressRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, resourceName, false, 2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, "aws_security_group.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"from_port":   "80",
+						"ip_protocol": "tcp",
+						"to_port":     "80",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"cidr_ipv6":   "::/0",
+						"description": "HTTPS",
+						"from_port":   "443",
+						"ip_protocol": "tcp",
+						"to_port":     "443",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupIngressRulesExclusive_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, resourceName, false, 2),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupIngressRulesExclusiveConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, resourceName, false, 2),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"cidr_ipv6":   "::/0",
+						"description": "HTTPS updated",
+						"from_port":   "443",
+						"ip_protocol": "tcp",
+						"to_port":     "443",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"ip_protocol": "icmp",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupIngressRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, resourceName, false, 2),
+					testAccCheckSecurityGroupIngressRulesExclusiveAuthorizeRule(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupIngressRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, resourceName, false, 2),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupIngressRulesExclusive_descriptionOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleIDs1, ruleIDs2 []string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRulesExclusiveConfig_description(rName, "HTTPS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveRuleIDs(ctx, resourceName, false, &ruleIDs1),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.description", "HTTPS"),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupIngressRulesExclusiveConfig_description(rName, "HTTPS updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveRuleIDs(ctx, resourceName, false, &ruleIDs2),
+					testAccCheckSecurityGroupRulesExclusiveRuleIDsEqual(&ruleIDs1, &ruleIDs2),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.description", "HTTPS updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupIngressRulesExclusive_removeFromState(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rules_exclusive.test"
+	securityGroupResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, resourceName, false, 2),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupRuleConfig_base(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Destroying the resource leaves the rules in place.
+					testAccCheckSecurityGroupRulesExclusiveCount(ctx, securityGroupResourceName, false, 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesExclusiveCount(ctx context.Context, n string, isEgress bool, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		got, err := testAccSecurityGroupRulesExclusiveCount(ctx, rs.Primary.ID, isEgress)
+
+		if err != nil {
+			return err
+		}
+
+		if got != want {
+			return fmt.Errorf("VPC Security Group (%s) has %d rules, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesExclusiveRuleIDs(ctx context.Context, n string, isEgress bool, v *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var ids []string
+		for _, v := range output {
+			if aws.BoolValue(v.IsEgress) == isEgress {
+				ids = append(ids, aws.StringValue(v.SecurityGroupRuleId))
+			}
+		}
+		slices.Sort(ids)
+
+		*v = ids
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesExclusiveRuleIDsEqual(ids1, ids2 *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !slices.Equal(*ids1, *ids2) {
+			return fmt.Errorf("VPC Security Group rules replaced: %v != %v", *ids1, *ids2)
+		}
+
+		return nil
+	}
+}
+
+func testAccSecurityGroupRulesExclusiveCount(ctx context.Context, groupID string, isEgress bool) (int, error) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, v := range output {
+		if aws.BoolValue(v.IsEgress) == isEgress {
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+func testAccCheckSecurityGroupIngressRulesExclusiveAuthorizeRule(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: aws.String(rs.Primary.ID),
+			IpPermissions: []*ec2.IpPermission{{
+				FromPort:   aws.Int64(22),
+				IpProtocol: aws.String("tcp"),
+				IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("192.168.0.0/16")}},
+				ToPort:     aws.Int64(22),
+			}},
+		})
+
+		return err
+	}
+}
+
+func testAccVPCSecurityGroupIngressRulesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rules_exclusive" "test" {
+  security_group_id = aws_security_group.test.id
+
+  rule {
+    cidr_ipv4   = "10.0.0.0/8"
+    from_port   = 80
+    ip_protocol = "tcp"
+    to_port     = 80
+  }
+
+  rule {
+    cidr_ipv6   = "::/0"
+    description = "HTTPS"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupIngressRulesExclusiveConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rules_exclusive" "test" {
+  security_group_id = aws_security_group.test.id
+
+  rule {
+    cidr_ipv4   = "10.0.0.0/8"
+    ip_protocol = "icmp"
+  }
+
+  rule {
+    cidr_ipv6   = "::/0"
+    description = "HTTPS updated"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupIngressRulesExclusiveConfig_description(rName, description string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpc_security_group_ingress_rules_exclusive" "test" {
+  security_group_id = aws_security_group.test.id
+
+  rule {
+    cidr_ipv4   = "10.0.0.0/8"
+    description = %[1]q
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+}
+`, description))
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCTrafficMirrorFiltersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorFilter(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorFiltersDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ec2_traffic_mirror_filters.by_tags", "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr("data.aws_ec2_traffic_mirror_filters.by_filter", "ids.#", acctest.Ct2),
+					resource.TestCheckResourceAttr("data.aws_ec2_traffic_mirror_filters.empty", "ids.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCTrafficMirrorFiltersDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test1" {
+  tags = {
+    Name     = %[1]q
+    OtherTag = "some-value"
+  }
+}
+
+resource "aws_ec2_traffic_mirror_filter" "test2" {
+  tags = {
+    Name     = %[1]q
+    OtherTag = "some-other-value"
+  }
+}
+
+data "aws_ec2_traffic_mirror_filters" "by_tags" {
+  tags = {
+    OtherTag = "some-value"
+  }
+
+  depends_on = [aws_ec2_traffic_mirror_filter.test1, aws_ec2_traffic_mirror_filter.test2]
+}
+
+data "aws_ec2_traffic_mirror_filters" "by_filter" {
+  filter {
+    name   = "traffic-mirror-filter-id"
+    values = [aws_ec2_traffic_mirror_filter.test1.id, aws_ec2_traffic_mirror_filter.test2.id]
+  }
+}
+
+data "aws_ec2_traffic_mirror_filters" "empty" {
+  tags = {
+    Name     = %[1]q
+    OtherTag = "no-such-value"
+  }
+
+  depends_on = [aws_ec2_traffic_mirror_filter.test1, aws_ec2_traffic_mirror_filter.test2]
+}
+`, rName)
+}
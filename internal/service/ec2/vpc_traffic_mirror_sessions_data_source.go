@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_traffic_mirror_sessions")
+func DataSourceTrafficMirrorSessions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTrafficMirrorSessionsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrFilter: customFiltersSchema(),
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrNetworkInterfaceID: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"traffic_mirror_filter_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"traffic_mirror_target_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceTrafficMirrorSessionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.DescribeTrafficMirrorSessionsInput{
+		Filters: newAttributeFilterList(
+			map[string]string{
+				"network-interface-id":     d.Get(names.AttrNetworkInterfaceID).(string),
+				"traffic-mirror-filter-id": d.Get("traffic_mirror_filter_id").(string),
+				"traffic-mirror-target-id": d.Get("traffic_mirror_target_id").(string),
+			},
+		),
+	}
+
+	if tags, ok := d.GetOk(names.AttrTags); ok {
+		input.Filters = append(input.Filters, newTagFilterList(
+			Tags(tftags.New(ctx, tags.(map[string]interface{}))),
+		)...)
+	}
+
+	input.Filters = append(input.Filters, newCustomFilterList(
+		d.Get(names.AttrFilter).(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindTrafficMirrorSessions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Traffic Mirror Sessions: %s", err)
+	}
+
+	var trafficMirrorSessionIDs []string
+
+	for _, v := range output {
+		trafficMirrorSessionIDs = append(trafficMirrorSessionIDs, aws.StringValue(v.TrafficMirrorSessionId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrIDs, trafficMirrorSessionIDs)
+
+	return diags
+}
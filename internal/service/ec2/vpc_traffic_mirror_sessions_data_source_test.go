@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCTrafficMirrorSessionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	session := sdkacctest.RandIntRange(1, 32766)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorSession(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorSessionsDataSourceConfig_basic(rName, session),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ec2_traffic_mirror_sessions.by_target", "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair("data.aws_ec2_traffic_mirror_sessions.by_target", "ids.0", "aws_ec2_traffic_mirror_session.test", names.AttrID),
+					resource.TestCheckResourceAttr("data.aws_ec2_traffic_mirror_sessions.by_tags", "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr("data.aws_ec2_traffic_mirror_sessions.empty", "ids.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCTrafficMirrorSessionsDataSourceConfig_basic(rName string, session int) string {
+	return acctest.ConfigCompose(testAccTrafficMirrorSessionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  traffic_mirror_target_id = aws_ec2_traffic_mirror_target.test.id
+  network_interface_id     = aws_instance.test.primary_network_interface_id
+  session_number           = %[2]d
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_traffic_mirror_sessions" "by_target" {
+  traffic_mirror_target_id = aws_ec2_traffic_mirror_target.test.id
+
+  depends_on = [aws_ec2_traffic_mirror_session.test]
+}
+
+data "aws_ec2_traffic_mirror_sessions" "by_tags" {
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_ec2_traffic_mirror_session.test]
+}
+
+data "aws_ec2_traffic_mirror_sessions" "empty" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  network_interface_id     = aws_instance.test.primary_network_interface_id
+
+  filter {
+    name   = "session-number"
+    values = ["%[3]d"]
+  }
+
+  depends_on = [aws_ec2_traffic_mirror_session.test]
+}
+`, rName, session, session%32766+1))
+}
@@ -1211,6 +1211,18 @@ func waitImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client, targ
 	return err
 }
 
+func waitSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2.Client, target awstypes.SnapshotBlockPublicAccessState, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Target:  enum.Slice(target),
+		Refresh: statusSnapshotBlockPublicAccess(ctx, conn),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 func waitVPNConnectionCreated(ctx context.Context, conn *ec2.Client, id string) (*awstypes.VpnConnection, error) {
 	const (
 		timeout = 40 * time.Minute
@@ -2457,6 +2469,31 @@ func waitEBSSnapshotTierArchive(ctx context.Context, conn *ec2.Client, id string
 	return nil, err
 }
 
+func waitEBSSnapshotTierRestoreStarted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.SnapshotTierStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TieringOperationStatusArchivalCompleted),
+		Target: enum.Slice(
+			awstypes.TieringOperationStatusTemporaryRestoreInProgress,
+			awstypes.TieringOperationStatusTemporaryRestoreCompleted,
+			awstypes.TieringOperationStatusPermanentRestoreInProgress,
+			awstypes.TieringOperationStatusPermanentRestoreCompleted,
+		),
+		Refresh: statusSnapshotTieringOperation(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SnapshotTierStatus); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.LastTieringOperationStatusDetail)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitInstanceConnectEndpointCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Ec2InstanceConnectEndpoint, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.Ec2InstanceConnectEndpointStateCreateInProgress),
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecrpublic
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecrpublic/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ecrpublic_registry")
+func DataSourceRegistry() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRegistryRead,
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"aliases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_registry_alias": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary_registry_alias": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registry_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"verified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRPublicClient(ctx)
+
+	registries, err := findRegistries(ctx, conn, &ecrpublic.DescribeRegistriesInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Public Registries: %s", err)
+	}
+
+	if v, ok := d.GetOk("alias"); ok {
+		alias := v.(string)
+		registries = tfslices.Filter(registries, func(v awstypes.Registry) bool {
+			return tfslices.Any(v.Aliases, func(v awstypes.RegistryAlias) bool {
+				return aws.ToString(v.Name) == alias
+			})
+		})
+	}
+
+	registry, err := tfresource.AssertSingleValueResult(registries)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("ECR Public Registry", err))
+	}
+
+	d.SetId(aws.ToString(registry.RegistryId))
+	if err := d.Set("aliases", flattenRegistryAliases(registry.Aliases)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting aliases: %s", err)
+	}
+	d.Set(names.AttrARN, registry.RegistryArn)
+	d.Set("registry_id", registry.RegistryId)
+	d.Set("registry_uri", registry.RegistryUri)
+	d.Set("verified", registry.Verified)
+
+	return diags
+}
+
+func findRegistries(ctx context.Context, conn *ecrpublic.Client, input *ecrpublic.DescribeRegistriesInput) ([]awstypes.Registry, error) {
+	var output []awstypes.Registry
+
+	pages := ecrpublic.NewDescribeRegistriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Registries...)
+	}
+
+	return output, nil
+}
+
+func flattenRegistryAliases(apiObjects []awstypes.RegistryAlias) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"default_registry_alias": aws.ToBool(apiObject.DefaultRegistryAlias),
+			names.AttrName:           aws.ToString(apiObject.Name),
+			"primary_registry_alias": aws.ToBool(apiObject.PrimaryRegistryAlias),
+			names.AttrStatus:         string(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecrpublic_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRPublicRegistryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecrpublic_registry.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, names.USEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRPublicServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "registry_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "registry_uri"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "aliases.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRPublicRegistryDataSource_alias(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecrpublic_registry.test"
+	aliasDataSourceName := "data.aws_ecrpublic_registry.by_alias"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, names.USEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRPublicServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryDataSourceConfig_alias,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(aliasDataSourceName, "registry_id", dataSourceName, "registry_id"),
+					resource.TestCheckResourceAttrPair(aliasDataSourceName, "registry_uri", dataSourceName, "registry_uri"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRegistryDataSourceConfig_basic = `
+data "aws_ecrpublic_registry" "test" {}
+`
+
+const testAccRegistryDataSourceConfig_alias = `
+data "aws_ecrpublic_registry" "test" {}
+
+data "aws_ecrpublic_registry" "by_alias" {
+  alias = data.aws_ecrpublic_registry.test.aliases[0].name
+}
+`
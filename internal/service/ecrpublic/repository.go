@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -25,6 +26,7 @@ import (
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	homedir "github.com/mitchellh/go-homedir"
 )
 
 // @SDKResource("aws_ecrpublic_repository", name="Repository")
@@ -80,9 +82,20 @@ func ResourceRepository() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(0, 1024),
 						},
 						"logo_image_blob": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"catalog_data.0.logo_image_file"},
+						},
+						"logo_image_file": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"catalog_data.0.logo_image_blob"},
+						},
+						"logo_image_hash": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"catalog_data.0.logo_image_file"},
 						},
 						"operating_systems": {
 							Type:     schema.TypeSet,
@@ -134,7 +147,12 @@ func resourceRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if v, ok := d.GetOk("catalog_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.CatalogData = expandRepositoryCatalogData(v.([]interface{})[0].(map[string]interface{}))
+		catalogData, err := expandRepositoryCatalogData(v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ECR Public repository: %s", err)
+		}
+
+		input.CatalogData = catalogData
 	}
 
 	out, err := conn.CreateRepository(ctx, &input)
@@ -225,8 +243,10 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, meta in
 		flatCatalogData := flattenRepositoryCatalogData(catalogOut)
 		if catalogData, ok := d.GetOk("catalog_data"); ok && len(catalogData.([]interface{})) > 0 && catalogData.([]interface{})[0] != nil {
 			catalogDataMap := catalogData.([]interface{})[0].(map[string]interface{})
-			if v, ok := catalogDataMap["logo_image_blob"].(string); ok && len(v) > 0 {
-				flatCatalogData["logo_image_blob"] = v
+			for _, k := range []string{"logo_image_blob", "logo_image_file", "logo_image_hash"} {
+				if v, ok := catalogDataMap[k].(string); ok && len(v) > 0 {
+					flatCatalogData[k] = v
+				}
 			}
 		}
 		d.Set("catalog_data", []interface{}{flatCatalogData})
@@ -339,9 +359,9 @@ func flattenRepositoryCatalogData(apiObject *ecrpublic.GetRepositoryCatalogDataO
 	return tfMap
 }
 
-func expandRepositoryCatalogData(tfMap map[string]interface{}) *awstypes.RepositoryCatalogDataInput {
+func expandRepositoryCatalogData(tfMap map[string]interface{}) (*awstypes.RepositoryCatalogDataInput, error) {
 	if tfMap == nil {
-		return nil
+		return nil, nil
 	}
 
 	repositoryCatalogDataInput := &awstypes.RepositoryCatalogDataInput{}
@@ -366,6 +386,15 @@ func expandRepositoryCatalogData(tfMap map[string]interface{}) *awstypes.Reposit
 		repositoryCatalogDataInput.LogoImageBlob = itypes.MustBase64Decode(v)
 	}
 
+	if v, ok := tfMap["logo_image_file"].(string); ok && v != "" {
+		logoImage, err := readFileContents(v)
+		if err != nil {
+			return nil, fmt.Errorf("reading logo image file (%s): %w", v, err)
+		}
+
+		repositoryCatalogDataInput.LogoImageBlob = logoImage
+	}
+
 	if v, ok := tfMap["operating_systems"].(*schema.Set); ok {
 		operatingSystems := make([]string, v.Len())
 		for i, val := range v.List() {
@@ -378,19 +407,24 @@ func expandRepositoryCatalogData(tfMap map[string]interface{}) *awstypes.Reposit
 		repositoryCatalogDataInput.UsageText = aws.String(v)
 	}
 
-	return repositoryCatalogDataInput
+	return repositoryCatalogDataInput, nil
 }
 
 func resourceRepositoryUpdateCatalogData(ctx context.Context, conn *ecrpublic.Client, d *schema.ResourceData) error {
 	if d.HasChange("catalog_data") {
 		if v, ok := d.GetOk("catalog_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			catalogData, err := expandRepositoryCatalogData(v.([]interface{})[0].(map[string]interface{}))
+			if err != nil {
+				return err
+			}
+
 			input := ecrpublic.PutRepositoryCatalogDataInput{
 				RepositoryName: aws.String(d.Id()),
 				RegistryId:     aws.String(d.Get("registry_id").(string)),
-				CatalogData:    expandRepositoryCatalogData(v.([]interface{})[0].(map[string]interface{})),
+				CatalogData:    catalogData,
 			}
 
-			_, err := conn.PutRepositoryCatalogData(ctx, &input)
+			_, err = conn.PutRepositoryCatalogData(ctx, &input)
 
 			if err != nil {
 				return fmt.Errorf("updating catalog data for repository(%s): %s", d.Id(), err)
@@ -400,3 +434,17 @@ func resourceRepositoryUpdateCatalogData(ctx context.Context, conn *ecrpublic.Cl
 
 	return nil
 }
+
+func readFileContents(v string) ([]byte, error) {
+	filename, err := homedir.Expand(v)
+	if err != nil {
+		return nil, err
+	}
+
+	fileContent, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return fileContent, nil
+}
@@ -312,6 +312,37 @@ func TestAccECRPublicRepository_CatalogData_logoImageBlob(t *testing.T) {
 	})
 }
 
+func TestAccECRPublicRepository_CatalogData_logoImageFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Repository
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecrpublic_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, names.USEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRPublicServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryConfig_catalogDataLogoImageFile(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "catalog_data.0.logo_image_file", "test-fixtures/terraform_logo.png"),
+					resource.TestCheckResourceAttrSet(resourceName, "catalog_data.0.logo_image_hash"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"catalog_data.0.logo_image_file", "catalog_data.0.logo_image_hash"},
+			},
+		},
+	})
+}
+
 func TestAccECRPublicRepository_Basic_forceDestroy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Repository
@@ -539,3 +570,15 @@ resource "aws_ecrpublic_repository" "test" {
 }
 `, rName)
 }
+
+func testAccRepositoryConfig_catalogDataLogoImageFile(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecrpublic_repository" "test" {
+  repository_name = %q
+  catalog_data {
+    logo_image_file = "test-fixtures/terraform_logo.png"
+    logo_image_hash = filebase64sha256("test-fixtures/terraform_logo.png")
+  }
+}
+`, rName)
+}
@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceAuthorizationToken,
 			TypeName: "aws_ecrpublic_authorization_token",
 		},
+		{
+			Factory:  DataSourceRegistry,
+			TypeName: "aws_ecrpublic_registry",
+		},
 	}
 }
 
@@ -276,6 +276,11 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 		if v, ok := d.GetOk("service_connect_defaults"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.ServiceConnectDefaults = expandClusterServiceConnectDefaultsRequest(v.([]interface{})[0].(map[string]interface{}))
+		} else if d.HasChange("service_connect_defaults") {
+			// An empty namespace removes the cluster's Service Connect defaults.
+			input.ServiceConnectDefaults = &ecs.ClusterServiceConnectDefaultsRequest{
+				Namespace: aws.String(""),
+			}
 		}
 
 		if v, ok := d.GetOk("setting"); ok {
@@ -166,6 +166,13 @@ func TestAccECSCluster_serviceConnectDefaults(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "service_connect_defaults.0.namespace", namespace2ResourceName, names.AttrARN),
 				),
 			},
+			{
+				Config: testAccClusterConfig_serviceConnectDefaultsRemoved(rName, ns),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_defaults.#", acctest.Ct0),
+				),
+			},
 		},
 	})
 }
@@ -367,6 +374,20 @@ resource "aws_ecs_cluster" "test" {
 `, rName, ns, idx)
 }
 
+func testAccClusterConfig_serviceConnectDefaultsRemoved(rName, ns string) string {
+	return fmt.Sprintf(`
+resource "aws_service_discovery_http_namespace" "test" {
+  count = 2
+
+  name = "%[2]s-${count.index}"
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+`, rName, ns)
+}
+
 func testAccClusterConfig_containerInsights(rName, value string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package efs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_efs_mount_targets", name="Mount Targets")
+func ResourceMountTargets() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMountTargetsCreate,
+		ReadWithoutTimeout:   resourceMountTargetsRead,
+		UpdateWithoutTimeout: resourceMountTargetsUpdate,
+		DeleteWithoutTimeout: resourceMountTargetsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDNSName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_names": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrFileSystemID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mount_target": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrIPAddress: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mount_target_dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrNetworkInterfaceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSubnetID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrSecurityGroups: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrSubnetIDs: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceMountTargetsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fsID := d.Get(names.AttrFileSystemID).(string)
+
+	if err := syncMountTargets(ctx, d, meta, fsID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EFS Mount Targets (%s): %s", fsID, err)
+	}
+
+	d.SetId(fsID)
+
+	return append(diags, resourceMountTargetsRead(ctx, d, meta)...)
+}
+
+func resourceMountTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EFSConn(ctx)
+
+	mts, err := FindMountTargetsByFileSystemID(ctx, conn, d.Id())
+
+	if err == nil && len(mts) == 0 {
+		err = tfresource.NewEmptyResultError(d.Id())
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EFS Mount Targets (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EFS Mount Targets (%s): %s", d.Id(), err)
+	}
+
+	// Mount targets are returned in creation order; sort them for a stable plan.
+	slices.SortFunc(mts, func(a, b *efs.MountTargetDescription) int {
+		return strings.Compare(aws.StringValue(a.AvailabilityZoneName), aws.StringValue(b.AvailabilityZoneName))
+	})
+
+	dnsNames := make(map[string]string)
+	var securityGroups []string
+	var subnetIDs []string
+	var tfList []interface{}
+
+	for i, mt := range mts {
+		id := aws.StringValue(mt.MountTargetId)
+		azName := aws.StringValue(mt.AvailabilityZoneName)
+		dnsName := meta.(*conns.AWSClient).RegionalHostname(ctx, fmt.Sprintf("%s.%s.efs", azName, d.Id()))
+
+		output, err := conn.DescribeMountTargetSecurityGroupsWithContext(ctx, &efs.DescribeMountTargetSecurityGroupsInput{
+			MountTargetId: aws.String(id),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EFS Mount Target (%s) security groups: %s", id, err)
+		}
+
+		// Security groups are managed as a single set across all mount targets.
+		// If any mount target has drifted, surface a diff so that it is corrected.
+		v := aws.StringValueSlice(output.SecurityGroups)
+		slices.Sort(v)
+		if i == 0 {
+			securityGroups = v
+		} else if !slices.Equal(securityGroups, v) {
+			securityGroups = nil
+		}
+
+		dnsNames[azName] = dnsName
+		subnetIDs = append(subnetIDs, aws.StringValue(mt.SubnetId))
+		tfList = append(tfList, map[string]interface{}{
+			"availability_zone_id":       aws.StringValue(mt.AvailabilityZoneId),
+			"availability_zone_name":     azName,
+			names.AttrID:                 id,
+			names.AttrIPAddress:          aws.StringValue(mt.IpAddress),
+			"mount_target_dns_name":      dnsName,
+			names.AttrNetworkInterfaceID: aws.StringValue(mt.NetworkInterfaceId),
+			names.AttrSubnetID:           aws.StringValue(mt.SubnetId),
+		})
+	}
+
+	d.Set(names.AttrDNSName, meta.(*conns.AWSClient).RegionalHostname(ctx, d.Id()+".efs"))
+	d.Set("dns_names", dnsNames)
+	d.Set(names.AttrFileSystemID, d.Id())
+	if err := d.Set("mount_target", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mount_target: %s", err)
+	}
+	d.Set(names.AttrSecurityGroups, securityGroups)
+	d.Set(names.AttrSubnetIDs, subnetIDs)
+
+	return diags
+}
+
+func resourceMountTargetsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChanges(names.AttrSecurityGroups, names.AttrSubnetIDs) {
+		if err := syncMountTargets(ctx, d, meta, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EFS Mount Targets (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMountTargetsRead(ctx, d, meta)...)
+}
+
+func resourceMountTargetsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EFSConn(ctx)
+
+	mts, err := FindMountTargetsByFileSystemID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EFS Mount Targets (%s): %s", d.Id(), err)
+	}
+
+	subnetIDs := flex.ExpandStringValueSet(d.Get(names.AttrSubnetIDs).(*schema.Set))
+	var ids []string
+
+	for _, mt := range mts {
+		if slices.Contains(subnetIDs, aws.StringValue(mt.SubnetId)) {
+			ids = append(ids, aws.StringValue(mt.MountTargetId))
+		}
+	}
+
+	if err := deleteMountTargets(ctx, conn, ids, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EFS Mount Targets (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// syncMountTargets makes the file system's mount targets exactly match the configured subnets.
+// Mount targets in other subnets are deleted before any are created, because a file system
+// can only have one mount target per Availability Zone.
+func syncMountTargets(ctx context.Context, d *schema.ResourceData, meta interface{}, fsID string, timeout time.Duration) error {
+	conn := meta.(*conns.AWSClient).EFSConn(ctx)
+	ec2Conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	subnetIDs := flex.ExpandStringValueSet(d.Get(names.AttrSubnetIDs).(*schema.Set))
+	slices.Sort(subnetIDs)
+	azBySubnetID := make(map[string]string, len(subnetIDs))
+	subnetIDByAZ := make(map[string]string, len(subnetIDs))
+
+	for _, subnetID := range subnetIDs {
+		az, err := getAZFromSubnetID(ctx, ec2Conn, subnetID)
+
+		if err != nil {
+			return fmt.Errorf("reading EC2 Subnet (%s): %w", subnetID, err)
+		}
+
+		if v, ok := subnetIDByAZ[az]; ok {
+			return fmt.Errorf("subnets %s and %s are both in Availability Zone %s; a file system can have only one mount target per Availability Zone", v, subnetID, az)
+		}
+
+		azBySubnetID[subnetID] = az
+		subnetIDByAZ[az] = subnetID
+	}
+
+	existing, err := FindMountTargetsByFileSystemID(ctx, conn, fsID)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return fmt.Errorf("reading EFS Mount Targets: %w", err)
+	}
+
+	var securityGroups []*string
+	if v, ok := d.GetOk(names.AttrSecurityGroups); ok && v.(*schema.Set).Len() > 0 {
+		securityGroups = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	var deleteIDs []string
+	existingSubnetIDs := make(map[string]struct{})
+
+	for _, mt := range existing {
+		id, subnetID := aws.StringValue(mt.MountTargetId), aws.StringValue(mt.SubnetId)
+
+		if _, ok := azBySubnetID[subnetID]; !ok {
+			deleteIDs = append(deleteIDs, id)
+			continue
+		}
+
+		existingSubnetIDs[subnetID] = struct{}{}
+
+		if securityGroups != nil && d.HasChange(names.AttrSecurityGroups) {
+			input := &efs.ModifyMountTargetSecurityGroupsInput{
+				MountTargetId:  aws.String(id),
+				SecurityGroups: securityGroups,
+			}
+
+			if _, err := conn.ModifyMountTargetSecurityGroupsWithContext(ctx, input); err != nil {
+				return fmt.Errorf("updating EFS Mount Target (%s) security groups: %w", id, err)
+			}
+		}
+	}
+
+	if err := deleteMountTargets(ctx, conn, deleteIDs, timeout); err != nil {
+		return err
+	}
+
+	// Issue all of the create requests before waiting, so that mount targets are created concurrently.
+	var createIDs []string
+
+	for _, subnetID := range subnetIDs {
+		if _, ok := existingSubnetIDs[subnetID]; ok {
+			continue
+		}
+
+		input := &efs.CreateMountTargetInput{
+			FileSystemId:   aws.String(fsID),
+			SecurityGroups: securityGroups,
+			SubnetId:       aws.String(subnetID),
+		}
+
+		id, err := createMountTarget(ctx, conn, input, "efs-mt-"+fsID+"-"+azBySubnetID[subnetID])
+
+		if err != nil {
+			return fmt.Errorf("creating EFS Mount Target in subnet (%s): %w", subnetID, err)
+		}
+
+		createIDs = append(createIDs, id)
+	}
+
+	for _, id := range createIDs {
+		if _, err := waitMountTargetCreated(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for EFS Mount Target (%s) create: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func createMountTarget(ctx context.Context, conn *efs.EFS, input *efs.CreateMountTargetInput, mutexKey string) (string, error) {
+	// Serialize with aws_efs_mount_target so that parallel requests in the same AZ fail
+	// rather than returning the same mount target.
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	output, err := conn.CreateMountTargetWithContext(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.MountTargetId), nil
+}
+
+func deleteMountTargets(ctx context.Context, conn *efs.EFS, ids []string, timeout time.Duration) error {
+	for _, id := range ids {
+		log.Printf("[DEBUG] Deleting EFS Mount Target: %s", id)
+		_, err := conn.DeleteMountTargetWithContext(ctx, &efs.DeleteMountTargetInput{
+			MountTargetId: aws.String(id),
+		})
+
+		if tfawserr.ErrCodeEquals(err, efs.ErrCodeMountTargetNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting EFS Mount Target (%s): %w", id, err)
+		}
+	}
+
+	for _, id := range ids {
+		if _, err := waitMountTargetDeleted(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for EFS Mount Target (%s) delete: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func FindMountTargetsByFileSystemID(ctx context.Context, conn *efs.EFS, fsID string) ([]*efs.MountTargetDescription, error) {
+	input := &efs.DescribeMountTargetsInput{
+		FileSystemId: aws.String(fsID),
+	}
+
+	output, err := findMountTargets(ctx, conn, input)
+
+	if tfawserr.ErrCodeEquals(err, efs.ErrCodeFileSystemNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	return output, err
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package efs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfefs "github.com/hashicorp/terraform-provider-aws/internal/service/efs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEFSMountTargets_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_efs_mount_targets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMountTargetsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMountTargetsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMountTargetsCount(ctx, resourceName, 2),
+					acctest.MatchResourceAttrRegionalHostname(resourceName, names.AttrDNSName, "efs", regexache.MustCompile(`fs-[^.]+`)),
+					resource.TestCheckResourceAttr(resourceName, "dns_names.%", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrFileSystemID, "aws_efs_file_system.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "mount_target.#", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "mount_target.0.availability_zone_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "mount_target.0.mount_target_dns_name"),
+					resource.TestMatchResourceAttr(resourceName, "mount_target.0.ip_address", regexache.MustCompile(`\d+\.\d+\.\d+\.\d+`)),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMountTargetsConfig_basic(rName, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMountTargetsCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "dns_names.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "mount_target.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEFSMountTargets_sameAvailabilityZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMountTargetsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMountTargetsConfig_sameAvailabilityZone(rName),
+				ExpectError: regexache.MustCompile(`only one mount target per Availability Zone`),
+			},
+		},
+	})
+}
+
+func testAccCheckMountTargetsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_efs_mount_targets" {
+				continue
+			}
+
+			for k, v := range rs.Primary.Attributes {
+				if !regexache.MustCompile(`^mount_target\.\d+\.id$`).MatchString(k) {
+					continue
+				}
+
+				_, err := tfefs.FindMountTargetByID(ctx, conn, v)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("EFS Mount Target %s still exists", v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMountTargetsCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn(ctx)
+
+		output, err := tfefs.FindMountTargetsByFileSystemID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("EFS File System (%s) has %d mount targets, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccMountTargetsConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccMountTargetConfig_base(rName), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccMountTargetsConfig_basic(rName string, n int) string {
+	return acctest.ConfigCompose(testAccMountTargetsConfig_base(rName), fmt.Sprintf(`
+resource "aws_efs_mount_targets" "test" {
+  file_system_id  = aws_efs_file_system.test.id
+  security_groups = [aws_security_group.test.id]
+  subnet_ids      = slice(aws_subnet.test[*].id, 0, %[1]d)
+}
+`, n))
+}
+
+func testAccMountTargetsConfig_sameAvailabilityZone(rName string) string {
+	return acctest.ConfigCompose(testAccMountTargetConfig_base(rName), fmt.Sprintf(`
+resource "aws_subnet" "other" {
+  vpc_id            = aws_vpc.test.id
+  availability_zone = aws_subnet.test[0].availability_zone
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, 100)
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_efs_mount_targets" "test" {
+  file_system_id = aws_efs_file_system.test.id
+  subnet_ids     = [aws_subnet.test[0].id, aws_subnet.other.id]
+}
+`, rName))
+}
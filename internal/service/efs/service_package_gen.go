@@ -77,6 +77,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_efs_mount_target",
 			Name:     "Mount Target",
 		},
+		{
+			Factory:  ResourceMountTargets,
+			TypeName: "aws_efs_mount_targets",
+			Name:     "Mount Targets",
+		},
 		{
 			Factory:  ResourceReplicationConfiguration,
 			TypeName: "aws_efs_replication_configuration",
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -39,15 +40,18 @@ func newPodIdentityAssociationResource(_ context.Context) (resource.ResourceWith
 }
 
 type podIdentityAssociationResourceModel struct {
-	AssociationARN types.String `tfsdk:"association_arn"`
-	AssociationID  types.String `tfsdk:"association_id"`
-	ClusterName    types.String `tfsdk:"cluster_name"`
-	ID             types.String `tfsdk:"id"`
-	Namespace      types.String `tfsdk:"namespace"`
-	RoleARN        fwtypes.ARN  `tfsdk:"role_arn"`
-	ServiceAccount types.String `tfsdk:"service_account"`
-	Tags           types.Map    `tfsdk:"tags"`
-	TagsAll        types.Map    `tfsdk:"tags_all"`
+	AssociationARN     types.String `tfsdk:"association_arn"`
+	AssociationID      types.String `tfsdk:"association_id"`
+	ClusterName        types.String `tfsdk:"cluster_name"`
+	DisableSessionTags types.Bool   `tfsdk:"disable_session_tags"`
+	ExternalID         types.String `tfsdk:"external_id"`
+	ID                 types.String `tfsdk:"id"`
+	Namespace          types.String `tfsdk:"namespace"`
+	RoleARN            fwtypes.ARN  `tfsdk:"role_arn"`
+	ServiceAccount     types.String `tfsdk:"service_account"`
+	Tags               types.Map    `tfsdk:"tags"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
+	TargetRoleARN      fwtypes.ARN  `tfsdk:"target_role_arn"`
 }
 
 func (model *podIdentityAssociationResourceModel) setID() {
@@ -87,6 +91,16 @@ func (r *podIdentityAssociationResource) Schema(ctx context.Context, req resourc
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"disable_session_tags": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Computed: true,
+			},
 			names.AttrID: framework.IDAttribute(),
 			names.AttrNamespace: schema.StringAttribute{
 				Required: true,
@@ -106,6 +120,10 @@ func (r *podIdentityAssociationResource) Schema(ctx context.Context, req resourc
 			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
 		},
 	}
 }
@@ -144,6 +162,8 @@ func (r *podIdentityAssociationResource) Create(ctx context.Context, req resourc
 	output := outputRaw.(*eks.CreatePodIdentityAssociationOutput)
 	plan.AssociationARN = fwflex.StringToFramework(ctx, output.Association.AssociationArn)
 	plan.AssociationID = fwflex.StringToFramework(ctx, output.Association.AssociationId)
+	plan.DisableSessionTags = fwflex.BoolToFramework(ctx, output.Association.DisableSessionTags)
+	plan.ExternalID = fwflex.StringToFramework(ctx, output.Association.ExternalId)
 	plan.setID()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
@@ -201,7 +221,9 @@ func (r *podIdentityAssociationResource) Update(ctx context.Context, req resourc
 
 	conn := r.Meta().EKSClient(ctx)
 
-	if !new.RoleARN.Equal(old.RoleARN) {
+	if !new.DisableSessionTags.Equal(old.DisableSessionTags) ||
+		!new.RoleARN.Equal(old.RoleARN) ||
+		!new.TargetRoleARN.Equal(old.TargetRoleARN) {
 		input := &eks.UpdatePodIdentityAssociationInput{}
 		resp.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
 		if resp.Diagnostics.HasError() {
@@ -209,8 +231,12 @@ func (r *podIdentityAssociationResource) Update(ctx context.Context, req resourc
 		}
 
 		input.ClientRequestToken = aws.String(sdkid.UniqueId())
+		// An empty string removes the target role.
+		if new.TargetRoleARN.IsNull() && !old.TargetRoleARN.IsNull() {
+			input.TargetRoleArn = aws.String("")
+		}
 
-		_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidParameterException](ctx, propagationTimeout, func() (interface{}, error) {
+		outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidParameterException](ctx, propagationTimeout, func() (interface{}, error) {
 			return conn.UpdatePodIdentityAssociation(ctx, input)
 		}, "Role provided in the request does not exist")
 
@@ -221,6 +247,12 @@ func (r *podIdentityAssociationResource) Update(ctx context.Context, req resourc
 			)
 			return
 		}
+
+		output := outputRaw.(*eks.UpdatePodIdentityAssociationOutput)
+		new.DisableSessionTags = fwflex.BoolToFramework(ctx, output.Association.DisableSessionTags)
+		new.ExternalID = fwflex.StringToFramework(ctx, output.Association.ExternalId)
+	} else {
+		new.ExternalID = old.ExternalID
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
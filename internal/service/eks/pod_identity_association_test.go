@@ -177,6 +177,65 @@ func TestAccEKSPodIdentityAssociation_updateRoleARN(t *testing.T) {
 	})
 }
 
+func TestAccEKSPodIdentityAssociation_targetRoleARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var podidentityassociation types.PodIdentityAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EKSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &podidentityassociation),
+					resource.TestCheckResourceAttr(resourceName, "disable_session_tags", acctest.CtFalse),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrExternalID),
+					resource.TestCheckNoResourceAttr(resourceName, "target_role_arn"),
+				),
+			},
+			{
+				Config: testAccPodIdentityAssociationConfig_targetRoleARN(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &podidentityassociation),
+					resource.TestCheckResourceAttr(resourceName, "disable_session_tags", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrExternalID),
+					resource.TestCheckResourceAttrPair(resourceName, "target_role_arn", "aws_iam_role.target", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckPodIdentityAssociationImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPodIdentityAssociationConfig_targetRoleARN(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &podidentityassociation),
+					resource.TestCheckResourceAttr(resourceName, "disable_session_tags", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "target_role_arn", "aws_iam_role.target", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccPodIdentityAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPodIdentityAssociationExists(ctx, resourceName, &podidentityassociation),
+					resource.TestCheckNoResourceAttr(resourceName, "target_role_arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPodIdentityAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
@@ -427,3 +486,56 @@ resource "aws_eks_pod_identity_association" "test" {
 }
 `, rName))
 }
+
+func testAccPodIdentityAssociationConfig_targetRoleARN(rName string, disableSessionTags bool) string {
+	return acctest.ConfigCompose(
+		testAccPodIdentityAssociationConfig_clusterBase(rName),
+		testAccPodIdentityAssociationConfig_podIdentityRoleBase(rName),
+		fmt.Sprintf(`
+resource "aws_iam_role" "target" {
+  name = "%[1]s-target"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = aws_iam_role.test.arn
+      }
+      Action = [
+        "sts:AssumeRole",
+        "sts:TagSession",
+      ]
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "sts:AssumeRole",
+        "sts:TagSession",
+      ]
+      Resource = aws_iam_role.target.arn
+    }]
+  })
+}
+
+resource "aws_eks_pod_identity_association" "test" {
+  cluster_name         = aws_eks_cluster.test.name
+  namespace            = %[1]q
+  service_account      = "%[1]s-sa"
+  role_arn             = aws_iam_role.test.arn
+  target_role_arn      = aws_iam_role.target.arn
+  disable_session_tags = %[2]t
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, disableSessionTags))
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_eks_pod_identity_associations", name="Pod Identity Associations")
+func dataSourcePodIdentityAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePodIdentityAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrAssociationID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrNamespace: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrNamespace: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_account": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourcePodIdentityAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get(names.AttrClusterName).(string)
+	input := &eks.ListPodIdentityAssociationsInput{
+		ClusterName: aws.String(clusterName),
+	}
+
+	if v, ok := d.GetOk(names.AttrNamespace); ok {
+		input.Namespace = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("service_account"); ok {
+		input.ServiceAccount = aws.String(v.(string))
+	}
+
+	var associations []awstypes.PodIdentityAssociationSummary
+	pages := eks.NewListPodIdentityAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing EKS Pod Identity Associations: %s", err)
+		}
+
+		associations = append(associations, page.Associations...)
+	}
+
+	d.SetId(clusterName)
+	if err := d.Set("associations", flattenPodIdentityAssociationSummaries(associations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting associations: %s", err)
+	}
+	d.Set(names.AttrClusterName, clusterName)
+
+	return diags
+}
+
+func flattenPodIdentityAssociationSummaries(apiObjects []awstypes.PodIdentityAssociationSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"association_arn":       aws.ToString(apiObject.AssociationArn),
+			names.AttrAssociationID: aws.ToString(apiObject.AssociationId),
+			names.AttrNamespace:     aws.ToString(apiObject.Namespace),
+			"owner_arn":             aws.ToString(apiObject.OwnerArn),
+			"service_account":       aws.ToString(apiObject.ServiceAccount),
+		})
+	}
+
+	return tfList
+}
@@ -66,6 +66,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceNodeGroups,
 			TypeName: "aws_eks_node_groups",
 		},
+		{
+			Factory:  dataSourcePodIdentityAssociations,
+			TypeName: "aws_eks_pod_identity_associations",
+			Name:     "Pod Identity Associations",
+		},
 	}
 }
 
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ID mapping workflows require a subscription to a provider service (e.g. LiveRamp) via AWS Data Exchange.
+const envVarProviderServiceARN = "ENTITYRESOLUTION_PROVIDER_SERVICE_ARN"
+
+func TestAccEntityResolutionIDMappingWorkflow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	providerServiceARN := acctest.SkipIfEnvVarNotSet(t, envVarProviderServiceARN)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, providerServiceARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "entityresolution", regexache.MustCompile(`idmappingworkflow/.+`)),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.id_mapping_type", "PROVIDER"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.provider_properties.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.provider_properties.0.provider_service_arn", providerServiceARN),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.input_source_arn", "aws_glue_catalog_table.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "workflow_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionIDMappingWorkflow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	providerServiceARN := acctest.SkipIfEnvVarNotSet(t, envVarProviderServiceARN)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, providerServiceARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceIDMappingWorkflow, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIDMappingWorkflowExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		_, err := tfentityresolution.FindIDMappingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckIDMappingWorkflowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_id_mapping_workflow" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindIDMappingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution ID Mapping Workflow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccIDMappingWorkflowConfig_basic(rName, providerServiceARN string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_id_mapping_workflow" "test" {
+  workflow_name = %[1]q
+  role_arn      = aws_iam_role.test.arn
+
+  id_mapping_techniques {
+    id_mapping_type = "PROVIDER"
+
+    provider_properties {
+      provider_service_arn = %[2]q
+
+      intermediate_source_configuration {
+        intermediate_s3_path = "s3://${aws_s3_bucket.test.bucket}/intermediate/"
+      }
+    }
+  }
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.schema_name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, providerServiceARN))
+}
@@ -0,0 +1,360 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEntityResolutionMatchingWorkflow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "entityresolution", regexache.MustCompile(`matchingworkflow/.+`)),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.input_source_arn", "aws_glue_catalog_table.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.schema_name", "aws_entityresolution_schema_mapping.test", "schema_name"),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.0.output.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.provider_properties.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.resolution_type", "RULE_MATCHING"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.attribute_matching_model", "ONE_TO_ONE"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rules.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "workflow_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceMatchingWorkflow, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rules.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccMatchingWorkflowConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.0.incremental_run_type", "IMMEDIATE"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.attribute_matching_model", "MANY_TO_MANY"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rules.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMatchingWorkflowExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		_, err := tfentityresolution.FindMatchingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckMatchingWorkflowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_matching_workflow" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindMatchingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution Matching Workflow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccWorkflowConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = replace(%[1]q, "-", "_")
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = replace(%[1]q, "-", "_")
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  storage_descriptor {
+    location      = "s3://${aws_s3_bucket.test.bucket}/input/"
+    input_format  = "org.apache.hadoop.mapred.TextInputFormat"
+    output_format = "org.apache.hadoop.hive.ql.io.HiveIgnoreKeyTextOutputFormat"
+
+    ser_de_info {
+      serialization_library = "org.apache.hadoop.hive.serde2.lazy.LazySimpleSerDe"
+    }
+
+    columns {
+      name = "id"
+      type = "string"
+    }
+
+    columns {
+      name = "name"
+      type = "string"
+    }
+
+    columns {
+      name = "email"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "entityresolution.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:PutObject",
+        "glue:GetDatabase",
+        "glue:GetTable",
+        "glue:GetPartition",
+        "glue:GetPartitions",
+        "glue:GetSchema",
+        "glue:GetSchemaVersion",
+        "glue:BatchGetPartition",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_entityresolution_schema_mapping" "test" {
+  schema_name = %[1]q
+
+  mapped_input_fields {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_fields {
+    field_name = "name"
+    match_key  = "name"
+    type       = "NAME"
+  }
+
+  mapped_input_fields {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+}
+`, rName)
+}
+
+func testAccMatchingWorkflowConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  workflow_name = %[1]q
+  role_arn      = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.schema_name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+
+    output {
+      name = "id"
+    }
+
+    output {
+      name = "name"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+
+      rules {
+        rule_name     = "name"
+        matching_keys = ["name"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccMatchingWorkflowConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  workflow_name = %[1]q
+  description   = "updated"
+  role_arn      = aws_iam_role.test.arn
+
+  incremental_run_config {
+    incremental_run_type = "IMMEDIATE"
+  }
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.schema_name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+
+    output {
+      name = "id"
+    }
+
+    output {
+      name = "name"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "MANY_TO_MANY"
+
+      rules {
+        rule_name     = "name"
+        matching_keys = ["name"]
+      }
+
+      rules {
+        rule_name     = "email"
+        matching_keys = ["email"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
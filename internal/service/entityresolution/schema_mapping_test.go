@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEntityResolutionSchemaMapping_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "entityresolution", regexache.MustCompile(`schemamapping/.+`)),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "has_workflows", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.0.field_name", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.0.type", "UNIQUE_ID"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.1.field_name", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.1.match_key", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.1.type", "NAME"),
+					resource.TestCheckResourceAttr(resourceName, "schema_name", rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceSchemaMapping, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccSchemaMappingConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.2.field_name", "email"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.2.match_key", "email"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_fields.2.type", "EMAIL_ADDRESS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchemaMappingConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccSchemaMappingConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSchemaMappingExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		_, err := tfentityresolution.FindSchemaMappingByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckSchemaMappingDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_schema_mapping" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindSchemaMappingByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution Schema Mapping %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSchemaMappingConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  schema_name = %[1]q
+
+  mapped_input_fields {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_fields {
+    field_name = "name"
+    match_key  = "name"
+    type       = "NAME"
+  }
+}
+`, rName)
+}
+
+func testAccSchemaMappingConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  schema_name = %[1]q
+  description = "updated"
+
+  mapped_input_fields {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_fields {
+    field_name = "name"
+    match_key  = "name"
+    type       = "NAME"
+  }
+
+  mapped_input_fields {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+}
+`, rName)
+}
+
+func testAccSchemaMappingConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  schema_name = %[1]q
+
+  mapped_input_fields {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_fields {
+    field_name = "name"
+    match_key  = "name"
+    type       = "NAME"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSchemaMappingConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  schema_name = %[1]q
+
+  mapped_input_fields {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_fields {
+    field_name = "name"
+    match_key  = "name"
+    type       = "NAME"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
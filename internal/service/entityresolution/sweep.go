@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("aws_entityresolution_id_mapping_workflow", &resource.Sweeper{
+		Name: "aws_entityresolution_id_mapping_workflow",
+		F:    sweepIDMappingWorkflows,
+	})
+
+	sweep.AddTestSweepers("aws_entityresolution_matching_workflow", &resource.Sweeper{
+		Name: "aws_entityresolution_matching_workflow",
+		F:    sweepMatchingWorkflows,
+	})
+
+	sweep.AddTestSweepers("aws_entityresolution_schema_mapping", &resource.Sweeper{
+		Name: "aws_entityresolution_schema_mapping",
+		F:    sweepSchemaMappings,
+		Dependencies: []string{
+			"aws_entityresolution_id_mapping_workflow",
+			"aws_entityresolution_matching_workflow",
+		},
+	})
+}
+
+func sweepIDMappingWorkflows(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	input := &entityresolution.ListIdMappingWorkflowsInput{}
+	conn := client.EntityResolutionClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := entityresolution.NewListIdMappingWorkflowsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Entity Resolution ID Mapping Workflow sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Entity Resolution ID Mapping Workflows (%s): %w", region, err)
+		}
+
+		for _, v := range page.WorkflowSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newIDMappingWorkflowResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.WorkflowName)),
+				framework.NewAttribute("workflow_name", aws.ToString(v.WorkflowName)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Entity Resolution ID Mapping Workflows (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepMatchingWorkflows(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	input := &entityresolution.ListMatchingWorkflowsInput{}
+	conn := client.EntityResolutionClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := entityresolution.NewListMatchingWorkflowsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Entity Resolution Matching Workflow sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Entity Resolution Matching Workflows (%s): %w", region, err)
+		}
+
+		for _, v := range page.WorkflowSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newMatchingWorkflowResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.WorkflowName)),
+				framework.NewAttribute("workflow_name", aws.ToString(v.WorkflowName)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Entity Resolution Matching Workflows (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSchemaMappings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	input := &entityresolution.ListSchemaMappingsInput{}
+	conn := client.EntityResolutionClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := entityresolution.NewListSchemaMappingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Entity Resolution Schema Mapping sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Entity Resolution Schema Mappings (%s): %w", region, err)
+		}
+
+		for _, v := range page.SchemaList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newSchemaMappingResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.SchemaName)),
+				framework.NewAttribute("schema_name", aws.ToString(v.SchemaName)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Entity Resolution Schema Mappings (%s): %w", region, err)
+	}
+
+	return nil
+}
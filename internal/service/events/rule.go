@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -141,9 +142,17 @@ func resourceRule() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"validate_event_pattern": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRuleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -251,7 +260,7 @@ func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EventsClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, names.AttrForceDestroy) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, names.AttrForceDestroy, "validate_event_pattern") {
 		_, ruleName, err := ruleParseResourceID(d.Id())
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -391,6 +400,63 @@ func ruleParseResourceID(id string) (string, string, error) {
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EVENTBUSNAME%[2]sRULENAME or RULENAME", id, ruleResourceIDSeparator)
 }
 
+// resourceRuleCustomizeDiff validates event_pattern against the EventBridge TestEventPattern API
+// when validate_event_pattern is enabled.
+func resourceRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("validate_event_pattern").(bool) {
+		return nil
+	}
+
+	// Skip validation while the pattern is unknown (e.g. it depends on another resource).
+	if !d.NewValueKnown("event_pattern") {
+		return nil
+	}
+
+	v, ok := d.GetOk("event_pattern")
+	if !ok {
+		return nil
+	}
+
+	pattern, err := ruleEventPatternJSONDecoder(v.(string))
+	if err != nil {
+		return err
+	}
+
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+
+	// The event only needs to be well formed; whether or not it matches is irrelevant.
+	event, err := json.Marshal(map[string]interface{}{
+		"account":     meta.(*conns.AWSClient).AccountID,
+		"detail":      map[string]interface{}{},
+		"detail-type": "Terraform Event Pattern Validation",
+		"id":          "00000000-0000-0000-0000-000000000000",
+		"region":      meta.(*conns.AWSClient).Region,
+		"resources":   []string{},
+		"source":      "terraform",
+		"time":        time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	input := &eventbridge.TestEventPatternInput{
+		Event:        aws.String(string(event)),
+		EventPattern: aws.String(pattern),
+	}
+
+	_, err = conn.TestEventPattern(ctx, input)
+
+	if errs.IsA[*types.InvalidEventPatternException](err) {
+		return fmt.Errorf("\"event_pattern\" is not a valid EventBridge event pattern: %w", err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("testing EventBridge event pattern: %w", err)
+	}
+
+	return nil
+}
+
 // ruleEventPatternJSONDecoder decodes unicode translation of <,>,&
 func ruleEventPatternJSONDecoder(jsonString interface{}) (string, error) {
 	var j interface{}
@@ -430,6 +430,40 @@ func TestAccEventsRule_pattern(t *testing.T) {
 	})
 }
 
+func TestAccEventsRule_validateEventPattern(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1 eventbridge.DescribeRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleConfig_validateEventPattern(rName, "{\"source\":\"aws.ec2\"}"),
+				ExpectError: regexache.MustCompile(`is not a valid EventBridge event pattern`),
+			},
+			{
+				Config: testAccRuleConfig_validateEventPattern(rName, "{\"source\":[\"aws.ec2\"]}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v1),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "event_pattern", "{\"source\":[\"aws.ec2\"]}"),
+					resource.TestCheckResourceAttr(resourceName, "validate_event_pattern", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "validate_event_pattern"},
+			},
+		},
+	})
+}
+
 func TestAccEventsRule_patternJSONEncoder(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1 eventbridge.DescribeRuleOutput
@@ -1074,6 +1108,18 @@ PATTERN
 `, rName, pattern)
 }
 
+func testAccRuleConfig_validateEventPattern(rName, pattern string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                   = %[1]q
+  validate_event_pattern = true
+  event_pattern          = <<PATTERN
+	%[2]s
+PATTERN
+}
+`, rName, pattern)
+}
+
 func testAccRuleConfig_patternJSONEncoder(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
@@ -202,7 +202,6 @@ func ResourceKxCluster() *schema.Resource {
 									"cache_type": {
 										Type:     schema.TypeString,
 										Required: true,
-										ForceNew: true,
 									},
 									"db_paths": {
 										Type: schema.TypeSet,
@@ -228,7 +227,6 @@ func ResourceKxCluster() *schema.Resource {
 						"dataview_name": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.StringLenBetween(3, 63),
 						},
 					},
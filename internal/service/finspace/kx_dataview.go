@@ -71,6 +71,7 @@ func ResourceKxDataview() *schema.Resource {
 			"changeset_id": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"created_timestamp": {
 				Type:     schema.TypeString,
@@ -270,6 +271,10 @@ func resourceKxDataviewUpdate(ctx context.Context, d *schema.ResourceData, meta
 		ClientToken:   aws.String(id.UniqueId()),
 	}
 
+	if d.HasChange(names.AttrDescription) {
+		in.Description = aws.String(d.Get(names.AttrDescription).(string))
+	}
+
 	if v, ok := d.GetOk("changeset_id"); ok && d.HasChange("changeset_id") && !d.Get("auto_update").(bool) {
 		in.ChangesetId = aws.String(v.(string))
 	}
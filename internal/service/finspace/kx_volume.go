@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/finspace"
 	"github.com/aws/aws-sdk-go-v2/service/finspace/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -113,13 +114,11 @@ func ResourceKxVolume() *schema.Resource {
 			"nas1_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrSize: {
 							Type:         schema.TypeInt,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.IntBetween(1200, 33600),
 						},
 						names.AttrType: {
@@ -154,7 +153,16 @@ func ResourceKxVolume() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[types.KxVolumeType](),
 			},
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// NAS_1 volumes can only be grown in place.
+			customdiff.ForceNewIfChange("nas1_configuration.0.size", func(_ context.Context, old, new, meta interface{}) bool {
+				return new.(int) < old.(int)
+			}),
+			customdiff.ForceNewIfChange("nas1_configuration.#", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(int) != new.(int)
+			}),
+		),
 	}
 }
 
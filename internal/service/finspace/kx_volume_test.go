@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/finspace"
 	"github.com/aws/aws-sdk-go-v2/service/finspace/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -57,6 +58,45 @@ func TestAccFinSpaceKxVolume_basic(t *testing.T) {
 	})
 }
 
+func TestAccFinSpaceKxVolume_nas1ConfigurationSize(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var volume1, volume2 finspace.GetKxVolumeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_finspace_kx_volume.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, finspace.ServiceID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, finspace.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKxVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKxVolumeConfig_nas1ConfigurationSize(rName, 1200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKxVolumeExists(ctx, resourceName, &volume1),
+					resource.TestCheckResourceAttr(resourceName, "nas1_configuration.0.size", "1200"),
+				),
+			},
+			{
+				Config: testAccKxVolumeConfig_nas1ConfigurationSize(rName, 2400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKxVolumeExists(ctx, resourceName, &volume2),
+					testAccCheckKxVolumeNotRecreated(&volume1, &volume2),
+					resource.TestCheckResourceAttr(resourceName, "nas1_configuration.0.size", "2400"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.KxVolumeStatusActive)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFinSpaceKxVolume_disappears(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -193,6 +233,16 @@ func testAccCheckKxVolumeExists(ctx context.Context, name string, volume *finspa
 	}
 }
 
+func testAccCheckKxVolumeNotRecreated(before, after *finspace.GetKxVolumeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToTime(before.CreatedTimestamp), aws.ToTime(after.CreatedTimestamp); !before.Equal(after) {
+			return create.Error(names.FinSpace, create.ErrActionCheckingNotRecreated, tffinspace.ResNameKxVolume, aws.ToString(after.VolumeName), errors.New("recreated"))
+		}
+
+		return nil
+	}
+}
+
 func testAccKxVolumeConfigBase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -321,6 +371,24 @@ resource "aws_finspace_kx_volume" "test" {
 `, rName))
 }
 
+func testAccKxVolumeConfig_nas1ConfigurationSize(rName string, size int) string {
+	return acctest.ConfigCompose(
+		testAccKxVolumeConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_finspace_kx_volume" "test" {
+  name               = %[1]q
+  environment_id     = aws_finspace_kx_environment.test.id
+  availability_zones = [aws_finspace_kx_environment.test.availability_zones[0]]
+  az_mode            = "SINGLE"
+  type               = "NAS_1"
+  nas1_configuration {
+    type = "SSD_250"
+    size = %[2]d
+  }
+}
+`, rName, size))
+}
+
 func testAccKxVolumeConfig_tags1(rName, key1, value1 string) string {
 	return acctest.ConfigCompose(
 		testAccKxVolumeConfigBase(rName),
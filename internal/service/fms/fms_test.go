@@ -24,6 +24,7 @@ func TestAccFMS_serial(t *testing.T) {
 			acctest.CtDisappears:     testAccPolicy_disappears,
 			"includeMap":             testAccPolicy_includeMap,
 			"policyOption":           testAccPolicy_policyOption,
+			"resourceSetIDs":         testAccPolicy_resourceSetIDs,
 			"resourceTags":           testAccPolicy_resourceTags,
 			"securityGroup":          testAccPolicy_securityGroup,
 			"tags":                   testAccPolicy_tags,
@@ -126,6 +126,14 @@ func resourcePolicy() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"resource_set_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			names.AttrResourceTags: tftags.TagsSchema(),
 			names.AttrResourceType: {
 				Type:          schema.TypeString,
@@ -199,8 +207,9 @@ func resourcePolicy() *schema.Resource {
 							},
 						},
 						names.AttrType: {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.SecurityServiceType](),
 						},
 					},
 				},
@@ -270,6 +279,7 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set(names.AttrName, policy.PolicyName)
 	d.Set("policy_update_token", policy.PolicyUpdateToken)
 	d.Set("remediation_enabled", policy.RemediationEnabled)
+	d.Set("resource_set_ids", policy.ResourceSetIds)
 	if err := d.Set(names.AttrResourceTags, flattenResourceTags(policy.ResourceTags)); err != nil {
 		sdkdiag.AppendErrorf(diags, "setting resource_tags: %s", err)
 	}
@@ -374,6 +384,7 @@ func expandPolicy(d *schema.ResourceData) *awstypes.Policy {
 		PolicyDescription:              aws.String(d.Get(names.AttrDescription).(string)),
 		PolicyName:                     aws.String(d.Get(names.AttrName).(string)),
 		RemediationEnabled:             d.Get("remediation_enabled").(bool),
+		ResourceSetIds:                 flex.ExpandStringValueSet(d.Get("resource_set_ids").(*schema.Set)),
 		ResourceType:                   resourceType,
 		ResourceTypeList:               flex.ExpandStringValueSet(d.Get("resource_type_list").(*schema.Set)),
 	}
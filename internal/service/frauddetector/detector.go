@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_frauddetector_detector", name="Detector")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go/service/frauddetector;frauddetector.Detector")
+func resourceDetector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorCreate,
+		ReadWithoutTimeout:   resourceDetectorRead,
+		UpdateWithoutTimeout: resourceDetectorUpdate,
+		DeleteWithoutTimeout: resourceDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9a-z_-]+$`), "must contain only lowercase alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"event_type_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDetectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	detectorID := d.Get("detector_id").(string)
+	input := &frauddetector.PutDetectorInput{
+		DetectorId:    aws.String(detectorID),
+		EventTypeName: aws.String(d.Get("event_type_name").(string)),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.PutDetectorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Fraud Detector Detector (%s): %s", detectorID, err)
+	}
+
+	d.SetId(detectorID)
+
+	return append(diags, resourceDetectorRead(ctx, d, meta)...)
+}
+
+func resourceDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	detector, err := findDetectorByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Detector (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, detector.Arn)
+	d.Set(names.AttrDescription, detector.Description)
+	d.Set("detector_id", detector.DetectorId)
+	d.Set("event_type_name", detector.EventTypeName)
+
+	return diags
+}
+
+func resourceDetectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	if d.HasChange(names.AttrDescription) {
+		input := &frauddetector.PutDetectorInput{
+			Description:   aws.String(d.Get(names.AttrDescription).(string)),
+			DetectorId:    aws.String(d.Id()),
+			EventTypeName: aws.String(d.Get("event_type_name").(string)),
+		}
+
+		_, err := conn.PutDetectorWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Fraud Detector Detector (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDetectorRead(ctx, d, meta)...)
+}
+
+func resourceDetectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Detector: %s", d.Id())
+	_, err := conn.DeleteDetectorWithContext(ctx, &frauddetector.DeleteDetectorInput{
+		DetectorId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Fraud Detector Detector (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDetectorByID(ctx context.Context, conn *frauddetector.FraudDetector, id string) (*frauddetector.Detector, error) {
+	input := &frauddetector.GetDetectorsInput{
+		DetectorId: aws.String(id),
+	}
+
+	return findDetector(ctx, conn, input)
+}
+
+func findDetector(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetDetectorsInput) (*frauddetector.Detector, error) {
+	output, err := findDetectors(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findDetectors(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetDetectorsInput) ([]*frauddetector.Detector, error) {
+	var output []*frauddetector.Detector
+
+	err := conn.GetDetectorsPagesWithContext(ctx, input, func(page *frauddetector.GetDetectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Detectors {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
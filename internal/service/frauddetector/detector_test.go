@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFraudDetectorDetector_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.Detector
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorConfig_basic(rName, "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "frauddetector", fmt.Sprintf("detector/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "detector_id", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "event_type_name", "aws_frauddetector_event_type.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDetectorConfig_basic(rName, "description 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorDetector_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.Detector
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorConfig_basic(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tffrauddetector.ResourceDetector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_frauddetector_detector" {
+				continue
+			}
+
+			_, err := tffrauddetector.FindDetectorByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Fraud Detector Detector %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDetectorExists(ctx context.Context, n string, v *frauddetector.Detector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		output, err := tffrauddetector.FindDetectorByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDetectorConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig_basic(rName), fmt.Sprintf(`
+resource "aws_frauddetector_detector" "test" {
+  detector_id     = %[1]q
+  description     = %[2]q
+  event_type_name = aws_frauddetector_event_type.test.name
+}
+`, rName, description))
+}
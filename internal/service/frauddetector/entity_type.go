@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_frauddetector_entity_type", name="Entity Type")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go/service/frauddetector;frauddetector.EntityType")
+func resourceEntityType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEntityTypeCreate,
+		ReadWithoutTimeout:   resourceEntityTypeRead,
+		UpdateWithoutTimeout: resourceEntityTypeUpdate,
+		DeleteWithoutTimeout: resourceEntityTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9a-z_-]+$`), "must contain only lowercase alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEntityTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &frauddetector.PutEntityTypeInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.PutEntityTypeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Fraud Detector Entity Type (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceEntityTypeRead(ctx, d, meta)...)
+}
+
+func resourceEntityTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	entityType, err := findEntityTypeByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Entity Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Entity Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, entityType.Arn)
+	d.Set(names.AttrDescription, entityType.Description)
+	d.Set(names.AttrName, entityType.Name)
+
+	return diags
+}
+
+func resourceEntityTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	if d.HasChange(names.AttrDescription) {
+		input := &frauddetector.PutEntityTypeInput{
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		_, err := conn.PutEntityTypeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Fraud Detector Entity Type (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEntityTypeRead(ctx, d, meta)...)
+}
+
+func resourceEntityTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Entity Type: %s", d.Id())
+	_, err := conn.DeleteEntityTypeWithContext(ctx, &frauddetector.DeleteEntityTypeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Fraud Detector Entity Type (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findEntityTypeByName(ctx context.Context, conn *frauddetector.FraudDetector, name string) (*frauddetector.EntityType, error) {
+	input := &frauddetector.GetEntityTypesInput{
+		Name: aws.String(name),
+	}
+
+	return findEntityType(ctx, conn, input)
+}
+
+func findEntityType(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetEntityTypesInput) (*frauddetector.EntityType, error) {
+	output, err := findEntityTypes(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findEntityTypes(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetEntityTypesInput) ([]*frauddetector.EntityType, error) {
+	var output []*frauddetector.EntityType
+
+	err := conn.GetEntityTypesPagesWithContext(ctx, input, func(page *frauddetector.GetEntityTypesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EntityTypes {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
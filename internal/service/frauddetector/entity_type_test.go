@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFraudDetectorEntityType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.EntityType
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEntityTypeExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "frauddetector", fmt.Sprintf("entity-type/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorEntityType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.EntityType
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityTypeExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tffrauddetector.ResourceEntityType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEntityTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_frauddetector_entity_type" {
+				continue
+			}
+
+			_, err := tffrauddetector.FindEntityTypeByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Fraud Detector Entity Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEntityTypeExists(ctx context.Context, n string, v *frauddetector.EntityType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		output, err := tffrauddetector.FindEntityTypeByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEntityTypeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+}
+`, rName)
+}
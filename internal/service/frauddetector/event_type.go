@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_frauddetector_event_type", name="Event Type")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go/service/frauddetector;frauddetector.EventType")
+func resourceEventType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEventTypeCreate,
+		ReadWithoutTimeout:   resourceEventTypeRead,
+		UpdateWithoutTimeout: resourceEventTypeUpdate,
+		DeleteWithoutTimeout: resourceEventTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"entity_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"event_ingestion": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.EventIngestion_Values(), false),
+			},
+			"event_variables": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"labels": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9a-z_]+$`), "must contain only lowercase alphanumeric characters and underscores"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEventTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := expandPutEventTypeInput(d)
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.PutEventTypeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Fraud Detector Event Type (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceEventTypeRead(ctx, d, meta)...)
+}
+
+func resourceEventTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	eventType, err := findEventTypeByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Event Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Event Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, eventType.Arn)
+	d.Set(names.AttrDescription, eventType.Description)
+	d.Set("entity_types", aws.StringValueSlice(eventType.EntityTypes))
+	d.Set("event_ingestion", eventType.EventIngestion)
+	d.Set("event_variables", aws.StringValueSlice(eventType.EventVariables))
+	d.Set("labels", aws.StringValueSlice(eventType.Labels))
+	d.Set(names.AttrName, eventType.Name)
+
+	return diags
+}
+
+func resourceEventTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		// PutEventType replaces the whole event type definition.
+		input := expandPutEventTypeInput(d)
+
+		_, err := conn.PutEventTypeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Fraud Detector Event Type (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEventTypeRead(ctx, d, meta)...)
+}
+
+func resourceEventTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Event Type: %s", d.Id())
+	_, err := conn.DeleteEventTypeWithContext(ctx, &frauddetector.DeleteEventTypeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Fraud Detector Event Type (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findEventTypeByName(ctx context.Context, conn *frauddetector.FraudDetector, name string) (*frauddetector.EventType, error) {
+	input := &frauddetector.GetEventTypesInput{
+		Name: aws.String(name),
+	}
+
+	return findEventType(ctx, conn, input)
+}
+
+func findEventType(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetEventTypesInput) (*frauddetector.EventType, error) {
+	output, err := findEventTypes(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findEventTypes(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetEventTypesInput) ([]*frauddetector.EventType, error) {
+	var output []*frauddetector.EventType
+
+	err := conn.GetEventTypesPagesWithContext(ctx, input, func(page *frauddetector.GetEventTypesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EventTypes {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandPutEventTypeInput(d *schema.ResourceData) *frauddetector.PutEventTypeInput {
+	input := &frauddetector.PutEventTypeInput{
+		EntityTypes:    flex.ExpandStringSet(d.Get("entity_types").(*schema.Set)),
+		EventVariables: flex.ExpandStringSet(d.Get("event_variables").(*schema.Set)),
+		Name:           aws.String(d.Get(names.AttrName).(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("event_ingestion"); ok {
+		input.EventIngestion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("labels"); ok && v.(*schema.Set).Len() > 0 {
+		input.Labels = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	return input
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFraudDetectorEventType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.EventType
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTypeExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "frauddetector", fmt.Sprintf("event-type/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "entity_types.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "entity_types.*", "aws_frauddetector_entity_type.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "event_ingestion", frauddetector.EventIngestionEnabled),
+					resource.TestCheckResourceAttr(resourceName, "event_variables.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "event_variables.*", "aws_frauddetector_variable.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorEventType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.EventType
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventTypeExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tffrauddetector.ResourceEventType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorEventType_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.EventType
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "event_variables.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccEventTypeConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "event_ingestion", frauddetector.EventIngestionDisabled),
+					resource.TestCheckResourceAttr(resourceName, "event_variables.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEventTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_frauddetector_event_type" {
+				continue
+			}
+
+			_, err := tffrauddetector.FindEventTypeByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Fraud Detector Event Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEventTypeExists(ctx context.Context, n string, v *frauddetector.EventType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		output, err := tffrauddetector.FindEventTypeByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEventTypeConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+}
+
+resource "aws_frauddetector_variable" "test" {
+  name          = "%[1]s_amount"
+  data_source   = "EVENT"
+  data_type     = "FLOAT"
+  default_value = "0.0"
+  variable_type = "NUMERIC"
+}
+`, rName)
+}
+
+func testAccEventTypeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_variables = [aws_frauddetector_variable.test.name]
+}
+`, rName))
+}
+
+func testAccEventTypeConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_variable" "test2" {
+  name          = "%[1]s_email"
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = "<unknown>"
+  variable_type = "EMAIL_ADDRESS"
+}
+
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  description     = "updated"
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_ingestion = "DISABLED"
+  event_variables = [aws_frauddetector_variable.test.name, aws_frauddetector_variable.test2.name]
+}
+`, rName))
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+// Exports for use in tests only.
+var (
+	ResourceDetector   = resourceDetector
+	ResourceEntityType = resourceEntityType
+	ResourceEventType  = resourceEventType
+	ResourceOutcome    = resourceOutcome
+	ResourceRule       = resourceRule
+	ResourceVariable   = resourceVariable
+
+	FindDetectorByID                  = findDetectorByID
+	FindEntityTypeByName              = findEntityTypeByName
+	FindEventTypeByName               = findEventTypeByName
+	FindLatestRuleVersionByTwoPartKey = findLatestRuleVersionByTwoPartKey
+	FindOutcomeByName                 = findOutcomeByName
+	FindVariableByName                = findVariableByName
+)
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_frauddetector_outcome", name="Outcome")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go/service/frauddetector;frauddetector.Outcome")
+func resourceOutcome() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOutcomeCreate,
+		ReadWithoutTimeout:   resourceOutcomeRead,
+		UpdateWithoutTimeout: resourceOutcomeUpdate,
+		DeleteWithoutTimeout: resourceOutcomeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9a-z_-]+$`), "must contain only lowercase alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOutcomeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &frauddetector.PutOutcomeInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.PutOutcomeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Fraud Detector Outcome (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceOutcomeRead(ctx, d, meta)...)
+}
+
+func resourceOutcomeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	outcome, err := findOutcomeByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Outcome (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Outcome (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, outcome.Arn)
+	d.Set(names.AttrDescription, outcome.Description)
+	d.Set(names.AttrName, outcome.Name)
+
+	return diags
+}
+
+func resourceOutcomeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	if d.HasChange(names.AttrDescription) {
+		input := &frauddetector.PutOutcomeInput{
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		_, err := conn.PutOutcomeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Fraud Detector Outcome (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOutcomeRead(ctx, d, meta)...)
+}
+
+func resourceOutcomeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Outcome: %s", d.Id())
+	_, err := conn.DeleteOutcomeWithContext(ctx, &frauddetector.DeleteOutcomeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Fraud Detector Outcome (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findOutcomeByName(ctx context.Context, conn *frauddetector.FraudDetector, name string) (*frauddetector.Outcome, error) {
+	input := &frauddetector.GetOutcomesInput{
+		Name: aws.String(name),
+	}
+
+	return findOutcome(ctx, conn, input)
+}
+
+func findOutcome(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetOutcomesInput) (*frauddetector.Outcome, error) {
+	output, err := findOutcomes(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findOutcomes(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetOutcomesInput) ([]*frauddetector.Outcome, error) {
+	var output []*frauddetector.Outcome
+
+	err := conn.GetOutcomesPagesWithContext(ctx, input, func(page *frauddetector.GetOutcomesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Outcomes {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_frauddetector_rule", name="Rule")
+// @Testing(existsType="github.com/aws/aws-sdk-go/service/frauddetector;frauddetector.RuleDetail")
+func resourceRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRuleCreate,
+		ReadWithoutTimeout:   resourceRuleRead,
+		UpdateWithoutTimeout: resourceRuleUpdate,
+		DeleteWithoutTimeout: resourceRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrExpression: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      frauddetector.LanguageDetectorpl,
+				ValidateFunc: validation.StringInSlice(frauddetector.Language_Values(), false),
+			},
+			"outcomes": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9a-z_-]+$`), "must contain only lowercase alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"rule_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	ruleResourceIDPartCount = 2
+)
+
+func resourceRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	detectorID, ruleID := d.Get("detector_id").(string), d.Get("rule_id").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{detectorID, ruleID}, ruleResourceIDPartCount, false))
+	input := &frauddetector.CreateRuleInput{
+		DetectorId: aws.String(detectorID),
+		Expression: aws.String(d.Get(names.AttrExpression).(string)),
+		Language:   aws.String(d.Get("language").(string)),
+		Outcomes:   flex.ExpandStringList(d.Get("outcomes").([]interface{})),
+		RuleId:     aws.String(ruleID),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateRuleWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Fraud Detector Rule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceRuleRead(ctx, d, meta)...)
+}
+
+func resourceRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ruleResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	detectorID, ruleID := parts[0], parts[1]
+	rule, err := findLatestRuleVersionByTwoPartKey(ctx, conn, detectorID, ruleID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Rule (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, rule.Arn)
+	d.Set(names.AttrDescription, rule.Description)
+	d.Set("detector_id", rule.DetectorId)
+	d.Set(names.AttrExpression, rule.Expression)
+	d.Set("language", rule.Language)
+	d.Set("outcomes", aws.StringValueSlice(rule.Outcomes))
+	d.Set("rule_id", rule.RuleId)
+	d.Set("rule_version", rule.RuleVersion)
+
+	return diags
+}
+
+func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	rule := &frauddetector.Rule{
+		DetectorId:  aws.String(d.Get("detector_id").(string)),
+		RuleId:      aws.String(d.Get("rule_id").(string)),
+		RuleVersion: aws.String(d.Get("rule_version").(string)),
+	}
+
+	// Changes to the rule logic create a new rule version.
+	if d.HasChanges(names.AttrExpression, "language", "outcomes") {
+		input := &frauddetector.UpdateRuleVersionInput{
+			Expression: aws.String(d.Get(names.AttrExpression).(string)),
+			Language:   aws.String(d.Get("language").(string)),
+			Outcomes:   flex.ExpandStringList(d.Get("outcomes").([]interface{})),
+			Rule:       rule,
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateRuleVersionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Fraud Detector Rule (%s) version: %s", d.Id(), err)
+		}
+	} else if d.HasChange(names.AttrDescription) {
+		input := &frauddetector.UpdateRuleMetadataInput{
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			Rule:        rule,
+		}
+
+		_, err := conn.UpdateRuleMetadataWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Fraud Detector Rule (%s) metadata: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRuleRead(ctx, d, meta)...)
+}
+
+func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ruleResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	detectorID, ruleID := parts[0], parts[1]
+	rules, err := findRules(ctx, conn, &frauddetector.GetRulesInput{
+		DetectorId: aws.String(detectorID),
+		RuleId:     aws.String(ruleID),
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Rule (%s): %s", d.Id(), err)
+	}
+
+	// Each rule version must be deleted individually.
+	for _, v := range rules {
+		version := aws.StringValue(v.RuleVersion)
+
+		log.Printf("[DEBUG] Deleting Fraud Detector Rule: %s (version %s)", d.Id(), version)
+		_, err := conn.DeleteRuleWithContext(ctx, &frauddetector.DeleteRuleInput{
+			Rule: &frauddetector.Rule{
+				DetectorId:  aws.String(detectorID),
+				RuleId:      aws.String(ruleID),
+				RuleVersion: aws.String(version),
+			},
+		})
+
+		if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Fraud Detector Rule (%s) version %s: %s", d.Id(), version, err)
+		}
+	}
+
+	return diags
+}
+
+func findLatestRuleVersionByTwoPartKey(ctx context.Context, conn *frauddetector.FraudDetector, detectorID, ruleID string) (*frauddetector.RuleDetail, error) {
+	input := &frauddetector.GetRulesInput{
+		DetectorId: aws.String(detectorID),
+		RuleId:     aws.String(ruleID),
+	}
+
+	output, err := findRules(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var latest *frauddetector.RuleDetail
+	var latestVersion int
+
+	for _, v := range output {
+		version, err := strconv.Atoi(aws.StringValue(v.RuleVersion))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if latest == nil || version > latestVersion {
+			latest, latestVersion = v, version
+		}
+	}
+
+	return latest, nil
+}
+
+func findRules(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetRulesInput) ([]*frauddetector.RuleDetail, error) {
+	var output []*frauddetector.RuleDetail
+
+	err := conn.GetRulesPagesWithContext(ctx, input, func(page *frauddetector.GetRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RuleDetails {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
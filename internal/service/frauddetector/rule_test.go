@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFraudDetectorRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.RuleDetail
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_basic(rName, "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "frauddetector", fmt.Sprintf("rule/%[1]s/%[1]s/1", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_frauddetector_detector.test", "detector_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrExpression, fmt.Sprintf("$%s_amount > 100", rName)),
+					resource.TestCheckResourceAttr(resourceName, "language", frauddetector.LanguageDetectorpl),
+					resource.TestCheckResourceAttr(resourceName, "outcomes.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "outcomes.0", "aws_frauddetector_outcome.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "rule_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuleConfig_basic(rName, "description 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.RuleDetail
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_basic(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tffrauddetector.ResourceRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorRule_newVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.RuleDetail
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_basic(rName, "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccRuleConfig_expression(rName, fmt.Sprintf("$%s_amount > 500", rName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrExpression, fmt.Sprintf("$%s_amount > 500", rName)),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_frauddetector_rule" {
+				continue
+			}
+
+			_, err := tffrauddetector.FindLatestRuleVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["detector_id"], rs.Primary.Attributes["rule_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Fraud Detector Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRuleExists(ctx context.Context, n string, v *frauddetector.RuleDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		output, err := tffrauddetector.FindLatestRuleVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["detector_id"], rs.Primary.Attributes["rule_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRuleConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig_basic(rName), fmt.Sprintf(`
+resource "aws_frauddetector_detector" "test" {
+  detector_id     = %[1]q
+  event_type_name = aws_frauddetector_event_type.test.name
+}
+
+resource "aws_frauddetector_outcome" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccRuleConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_rule" "test" {
+  detector_id = aws_frauddetector_detector.test.detector_id
+  rule_id     = %[1]q
+  description = %[2]q
+  expression  = format("$%%s > 100", aws_frauddetector_variable.test.name)
+  outcomes    = [aws_frauddetector_outcome.test.name]
+}
+`, rName, description))
+}
+
+func testAccRuleConfig_expression(rName, expression string) string {
+	return acctest.ConfigCompose(testAccRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_rule" "test" {
+  detector_id = aws_frauddetector_detector.test.detector_id
+  rule_id     = %[1]q
+  description = "description 1"
+  expression  = %[2]q
+  outcomes    = [aws_frauddetector_outcome.test.name]
+}
+`, rName, expression))
+}
@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+)
+
+func RegisterSweepers() {
+	sweep.AddTestSweepers("aws_frauddetector_detector", &resource.Sweeper{
+		Name: "aws_frauddetector_detector",
+		F:    sweepDetectors,
+		Dependencies: []string{
+			"aws_frauddetector_rule",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_frauddetector_entity_type", &resource.Sweeper{
+		Name: "aws_frauddetector_entity_type",
+		F:    sweepEntityTypes,
+		Dependencies: []string{
+			"aws_frauddetector_event_type",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_frauddetector_event_type", &resource.Sweeper{
+		Name: "aws_frauddetector_event_type",
+		F:    sweepEventTypes,
+		Dependencies: []string{
+			"aws_frauddetector_detector",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_frauddetector_outcome", &resource.Sweeper{
+		Name: "aws_frauddetector_outcome",
+		F:    sweepOutcomes,
+		Dependencies: []string{
+			"aws_frauddetector_rule",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_frauddetector_rule", &resource.Sweeper{
+		Name: "aws_frauddetector_rule",
+		F:    sweepRules,
+	})
+
+	sweep.AddTestSweepers("aws_frauddetector_variable", &resource.Sweeper{
+		Name: "aws_frauddetector_variable",
+		F:    sweepVariables,
+		Dependencies: []string{
+			"aws_frauddetector_event_type",
+		},
+	})
+}
+
+func sweepDetectors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.FraudDetectorConn(ctx)
+	input := &frauddetector.GetDetectorsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.GetDetectorsPagesWithContext(ctx, input, func(page *frauddetector.GetDetectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Detectors {
+			r := resourceDetector()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DetectorId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Fraud Detector Detector sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Fraud Detector Detectors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Fraud Detector Detectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepEntityTypes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.FraudDetectorConn(ctx)
+	input := &frauddetector.GetEntityTypesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.GetEntityTypesPagesWithContext(ctx, input, func(page *frauddetector.GetEntityTypesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EntityTypes {
+			r := resourceEntityType()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Fraud Detector Entity Type sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Fraud Detector Entity Types (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Fraud Detector Entity Types (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepEventTypes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.FraudDetectorConn(ctx)
+	input := &frauddetector.GetEventTypesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.GetEventTypesPagesWithContext(ctx, input, func(page *frauddetector.GetEventTypesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EventTypes {
+			r := resourceEventType()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Fraud Detector Event Type sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Fraud Detector Event Types (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Fraud Detector Event Types (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepOutcomes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.FraudDetectorConn(ctx)
+	input := &frauddetector.GetOutcomesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.GetOutcomesPagesWithContext(ctx, input, func(page *frauddetector.GetOutcomesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Outcomes {
+			r := resourceOutcome()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Fraud Detector Outcome sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Fraud Detector Outcomes (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Fraud Detector Outcomes (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepRules(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.FraudDetectorConn(ctx)
+	input := &frauddetector.GetDetectorsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	detectors, err := findDetectors(ctx, conn, input)
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Fraud Detector Rule sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Fraud Detector Detectors (%s): %w", region, err)
+	}
+
+	for _, v := range detectors {
+		detectorID := aws.StringValue(v.DetectorId)
+		rules, err := findRules(ctx, conn, &frauddetector.GetRulesInput{
+			DetectorId: aws.String(detectorID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error listing Fraud Detector Rules (%s): %w", region, err)
+		}
+
+		// Each rule is returned once per version.
+		seen := make(map[string]bool)
+
+		for _, v := range rules {
+			ruleID := aws.StringValue(v.RuleId)
+
+			if seen[ruleID] {
+				continue
+			}
+			seen[ruleID] = true
+
+			r := resourceRule()
+			d := r.Data(nil)
+			d.SetId(errs.Must(flex.FlattenResourceId([]string{detectorID, ruleID}, ruleResourceIDPartCount, false)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Fraud Detector Rules (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepVariables(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.FraudDetectorConn(ctx)
+	input := &frauddetector.GetVariablesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.GetVariablesPagesWithContext(ctx, input, func(page *frauddetector.GetVariablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Variables {
+			r := resourceVariable()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Fraud Detector Variable sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Fraud Detector Variables (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Fraud Detector Variables (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_frauddetector_variable", name="Variable")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go/service/frauddetector;frauddetector.Variable")
+func resourceVariable() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVariableCreate,
+		ReadWithoutTimeout:   resourceVariableRead,
+		UpdateWithoutTimeout: resourceVariableUpdate,
+		DeleteWithoutTimeout: resourceVariableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.DataSource_Values(), false),
+			},
+			"data_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.DataType_Values(), false),
+			},
+			names.AttrDefaultValue: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9a-z_]+$`), "must contain only lowercase alphanumeric characters and underscores"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"variable_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &frauddetector.CreateVariableInput{
+		DataSource:   aws.String(d.Get("data_source").(string)),
+		DataType:     aws.String(d.Get("data_type").(string)),
+		DefaultValue: aws.String(d.Get(names.AttrDefaultValue).(string)),
+		Name:         aws.String(name),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("variable_type"); ok {
+		input.VariableType = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateVariableWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Fraud Detector Variable (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceVariableRead(ctx, d, meta)...)
+}
+
+func resourceVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	variable, err := findVariableByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Variable (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Variable (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, variable.Arn)
+	d.Set("data_source", variable.DataSource)
+	d.Set("data_type", variable.DataType)
+	d.Set(names.AttrDefaultValue, variable.DefaultValue)
+	d.Set(names.AttrDescription, variable.Description)
+	d.Set(names.AttrName, variable.Name)
+	d.Set("variable_type", variable.VariableType)
+
+	return diags
+}
+
+func resourceVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &frauddetector.UpdateVariableInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDefaultValue) {
+			input.DefaultValue = aws.String(d.Get(names.AttrDefaultValue).(string))
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("variable_type") {
+			input.VariableType = aws.String(d.Get("variable_type").(string))
+		}
+
+		_, err := conn.UpdateVariableWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Fraud Detector Variable (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceVariableRead(ctx, d, meta)...)
+}
+
+func resourceVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Variable: %s", d.Id())
+	_, err := conn.DeleteVariableWithContext(ctx, &frauddetector.DeleteVariableInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Fraud Detector Variable (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findVariableByName(ctx context.Context, conn *frauddetector.FraudDetector, name string) (*frauddetector.Variable, error) {
+	input := &frauddetector.GetVariablesInput{
+		Name: aws.String(name),
+	}
+
+	return findVariable(ctx, conn, input)
+}
+
+func findVariable(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetVariablesInput) (*frauddetector.Variable, error) {
+	output, err := findVariables(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findVariables(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetVariablesInput) ([]*frauddetector.Variable, error) {
+	var output []*frauddetector.Variable
+
+	err := conn.GetVariablesPagesWithContext(ctx, input, func(page *frauddetector.GetVariablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Variables {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
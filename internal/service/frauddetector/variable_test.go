@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFraudDetectorVariable_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.Variable
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_variable.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVariableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVariableConfig_basic(rName, "0.0", "order amount"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "frauddetector", fmt.Sprintf("variable/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "data_source", frauddetector.DataSourceEvent),
+					resource.TestCheckResourceAttr(resourceName, "data_type", frauddetector.DataTypeFloat),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDefaultValue, "0.0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "order amount"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "variable_type", "NUMERIC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVariableConfig_basic(rName, "1.0", "updated order amount"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDefaultValue, "1.0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated order amount"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorVariable_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.Variable
+	rName := testAccRandomName()
+	resourceName := "aws_frauddetector_variable.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FraudDetectorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVariableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVariableConfig_basic(rName, "0.0", "order amount"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVariableExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tffrauddetector.ResourceVariable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVariableDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_frauddetector_variable" {
+				continue
+			}
+
+			_, err := tffrauddetector.FindVariableByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Fraud Detector Variable %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVariableExists(ctx context.Context, n string, v *frauddetector.Variable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		output, err := tffrauddetector.FindVariableByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVariableConfig_basic(rName, defaultValue, description string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_variable" "test" {
+  name          = %[1]q
+  data_source   = "EVENT"
+  data_type     = "FLOAT"
+  default_value = %[2]q
+  description   = %[3]q
+  variable_type = "NUMERIC"
+}
+`, rName, defaultValue, description)
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	computeResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_gamelift_compute", name="Compute")
+func ResourceCompute() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComputeCreate,
+		ReadWithoutTimeout:   resourceComputeRead,
+		DeleteWithoutTimeout: resourceComputeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"compute_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			names.AttrDNSName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+				ExactlyOneOf: []string{names.AttrDNSName, names.AttrIPAddress},
+			},
+			"fleet_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"game_lift_service_sdk_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrIPAddress: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+				ExactlyOneOf: []string{names.AttrDNSName, names.AttrIPAddress},
+			},
+			names.AttrLocation: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	fleetID, computeName := d.Get("fleet_id").(string), d.Get("compute_name").(string)
+	id, err := flex.FlattenResourceId([]string{fleetID, computeName}, computeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &gamelift.RegisterComputeInput{
+		ComputeName: aws.String(computeName),
+		FleetId:     aws.String(fleetID),
+	}
+
+	if v, ok := d.GetOk("certificate_path"); ok {
+		input.CertificatePath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDNSName); ok {
+		input.DnsName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrIPAddress); ok {
+		input.IpAddress = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrLocation); ok {
+		input.Location = aws.String(v.(string))
+	}
+
+	_, err = conn.RegisterComputeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "registering GameLift Compute (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceComputeRead(ctx, d, meta)...)
+}
+
+func resourceComputeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), computeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	fleetID, computeName := parts[0], parts[1]
+	compute, err := FindComputeByTwoPartKey(ctx, conn, fleetID, computeName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Compute (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Compute (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, compute.ComputeArn)
+	d.Set("compute_name", compute.ComputeName)
+	d.Set(names.AttrDNSName, compute.DnsName)
+	d.Set("fleet_arn", compute.FleetArn)
+	d.Set("fleet_id", compute.FleetId)
+	d.Set("game_lift_service_sdk_endpoint", compute.GameLiftServiceSdkEndpoint)
+	d.Set(names.AttrIPAddress, compute.IpAddress)
+	d.Set(names.AttrLocation, compute.Location)
+	d.Set("operating_system", compute.OperatingSystem)
+	d.Set(names.AttrStatus, compute.ComputeStatus)
+
+	return diags
+}
+
+func resourceComputeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), computeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deregistering GameLift Compute: %s", d.Id())
+	_, err = conn.DeregisterComputeWithContext(ctx, &gamelift.DeregisterComputeInput{
+		ComputeName: aws.String(parts[1]),
+		FleetId:     aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deregistering GameLift Compute (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
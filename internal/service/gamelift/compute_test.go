@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGameLiftCompute_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf gamelift.Compute
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_compute.test"
+	fleetResourceName := "aws_gamelift_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "compute_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_arn", fleetResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_id", fleetResourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "game_lift_service_sdk_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrIPAddress, "10.1.2.3"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrLocation, "aws_gamelift_location.test", names.AttrName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftCompute_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf gamelift.Compute
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_compute.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgamelift.ResourceCompute(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeExists(ctx context.Context, n string, v *gamelift.Compute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn(ctx)
+
+		output, err := tfgamelift.FindComputeByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckComputeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_gamelift_compute" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfgamelift.FindComputeByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("GameLift Compute %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccComputeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_anywhere(rName, "10.0"), fmt.Sprintf(`
+resource "aws_gamelift_compute" "test" {
+  compute_name = %[1]q
+  fleet_id     = aws_gamelift_fleet.test.id
+  ip_address   = "10.1.2.3"
+  location     = aws_gamelift_location.test.name
+}
+`, rName))
+}
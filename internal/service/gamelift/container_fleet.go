@@ -0,0 +1,431 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_gamelift_container_fleet", name="Container Fleet")
+// @Tags(identifierAttribute="arn")
+func ResourceContainerFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContainerFleetCreate,
+		ReadWithoutTimeout:   resourceContainerFleetRead,
+		UpdateWithoutTimeout: resourceContainerFleetUpdate,
+		DeleteWithoutTimeout: resourceContainerFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(fleetCreatedDefaultTimeout),
+			Delete: schema.DefaultTimeout(FleetDeletedDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container_groups_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_port_range": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"from_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"to_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+								},
+							},
+						},
+						"container_group_definition_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"desired_replica_container_groups_per_instance": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 5000),
+						},
+						"max_replica_container_groups_per_instance": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"ec2_inbound_permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"ip_range": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						names.AttrProtocol: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(gamelift.IpProtocol_Values(), false),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			"ec2_instance_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.EC2InstanceType_Values(), false),
+			},
+			"fleet_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      gamelift.FleetTypeOnDemand,
+				ValidateFunc: validation.StringInSlice(gamelift.FleetType_Values(), false),
+			},
+			"instance_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"metric_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"new_game_session_protection_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      gamelift.ProtectionPolicyNoProtection,
+				ValidateFunc: validation.StringInSlice(gamelift.ProtectionPolicy_Values(), false),
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_creation_limit_policy": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"new_game_sessions_per_creator": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"policy_period_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceContainerFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &gamelift.CreateFleetInput{
+		ComputeType:                    aws.String(gamelift.ComputeTypeContainer),
+		ContainerGroupsConfiguration:   expandContainerGroupsConfiguration(d.Get("container_groups_configuration").([]interface{})),
+		EC2InstanceType:                aws.String(d.Get("ec2_instance_type").(string)),
+		FleetType:                      aws.String(d.Get("fleet_type").(string)),
+		Name:                           aws.String(name),
+		NewGameSessionProtectionPolicy: aws.String(d.Get("new_game_session_protection_policy").(string)),
+		Tags:                           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ec2_inbound_permission"); ok {
+		input.EC2InboundPermissions = expandIPPermissions(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("instance_role_arn"); ok {
+		input.InstanceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("metric_groups"); ok {
+		input.MetricGroups = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_creation_limit_policy"); ok {
+		input.ResourceCreationLimitPolicy = expandResourceCreationLimitPolicy(v.([]interface{}))
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateFleetWithContext(ctx, input)
+	}, gamelift.ErrCodeInvalidRequestException, "GameLift is not authorized to perform")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating GameLift Container Fleet (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*gamelift.CreateFleetOutput).FleetAttributes.FleetId))
+
+	if _, err := waitFleetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceContainerFleetRead(ctx, d, meta)...)
+}
+
+func resourceContainerFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	fleet, err := FindFleetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Container Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Container Fleet (%s): %s", d.Id(), err)
+	}
+
+	if v := aws.StringValue(fleet.ComputeType); v != gamelift.ComputeTypeContainer {
+		return sdkdiag.AppendErrorf(diags, "GameLift Fleet (%s) has compute type %s, expected %s", d.Id(), v, gamelift.ComputeTypeContainer)
+	}
+
+	d.Set(names.AttrARN, fleet.FleetArn)
+	if err := d.Set("container_groups_configuration", flattenContainerGroupsAttributes(fleet.ContainerGroupsAttributes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting container_groups_configuration: %s", err)
+	}
+	d.Set(names.AttrDescription, fleet.Description)
+	d.Set("ec2_instance_type", fleet.InstanceType)
+	d.Set("fleet_type", fleet.FleetType)
+	d.Set("instance_role_arn", fleet.InstanceRoleArn)
+	d.Set("metric_groups", aws.StringValueSlice(fleet.MetricGroups))
+	d.Set(names.AttrName, fleet.Name)
+	d.Set("new_game_session_protection_policy", fleet.NewGameSessionProtectionPolicy)
+	d.Set("operating_system", fleet.OperatingSystem)
+	if err := d.Set("resource_creation_limit_policy", flattenResourceCreationLimitPolicy(fleet.ResourceCreationLimitPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_creation_limit_policy: %s", err)
+	}
+	d.Set(names.AttrStatus, fleet.Status)
+
+	portConfig, err := conn.DescribeFleetPortSettingsWithContext(ctx, &gamelift.DescribeFleetPortSettingsInput{
+		FleetId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Container Fleet (%s) port settings: %s", d.Id(), err)
+	}
+
+	if err := d.Set("ec2_inbound_permission", flattenIPPermissions(portConfig.InboundPermissions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ec2_inbound_permission: %s", err)
+	}
+
+	return diags
+}
+
+func resourceContainerFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	if d.HasChanges(names.AttrDescription, "metric_groups", names.AttrName, "new_game_session_protection_policy", "resource_creation_limit_policy") {
+		_, err := conn.UpdateFleetAttributesWithContext(ctx, &gamelift.UpdateFleetAttributesInput{
+			Description:                    aws.String(d.Get(names.AttrDescription).(string)),
+			FleetId:                        aws.String(d.Id()),
+			MetricGroups:                   flex.ExpandStringList(d.Get("metric_groups").([]interface{})),
+			Name:                           aws.String(d.Get(names.AttrName).(string)),
+			NewGameSessionProtectionPolicy: aws.String(d.Get("new_game_session_protection_policy").(string)),
+			ResourceCreationLimitPolicy:    expandResourceCreationLimitPolicy(d.Get("resource_creation_limit_policy").([]interface{})),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GameLift Container Fleet (%s) attributes: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("ec2_inbound_permission") {
+		o, n := d.GetChange("ec2_inbound_permission")
+		authorizations, revocations := DiffPortSettings(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		_, err := conn.UpdateFleetPortSettingsWithContext(ctx, &gamelift.UpdateFleetPortSettingsInput{
+			FleetId:                         aws.String(d.Id()),
+			InboundPermissionAuthorizations: authorizations,
+			InboundPermissionRevocations:    revocations,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GameLift Container Fleet (%s) port settings: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceContainerFleetRead(ctx, d, meta)...)
+}
+
+func resourceContainerFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	log.Printf("[INFO] Deleting GameLift Container Fleet: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteFleetWithContext(ctx, &gamelift.DeleteFleetInput{
+			FleetId: aws.String(d.Id()),
+		})
+	}, gamelift.ErrCodeInvalidRequestException, fmt.Sprintf("Cannot delete fleet %s that is in status of ", d.Id()))
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting GameLift Container Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFleetTerminated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandContainerGroupsConfiguration(tfList []interface{}) *gamelift.ContainerGroupsConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &gamelift.ContainerGroupsConfiguration{
+		ContainerGroupDefinitionNames: flex.ExpandStringSet(tfMap["container_group_definition_names"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["connection_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ConnectionPortRange = &gamelift.ConnectionPortRange{
+			FromPort: aws.Int64(int64(tfMap["from_port"].(int))),
+			ToPort:   aws.Int64(int64(tfMap["to_port"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["desired_replica_container_groups_per_instance"].(int); ok && v != 0 {
+		apiObject.DesiredReplicaContainerGroupsPerInstance = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenContainerGroupsAttributes(apiObject *gamelift.ContainerGroupsAttributes) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	var definitionNames []string
+	for _, v := range apiObject.ContainerGroupDefinitionProperties {
+		if v != nil {
+			definitionNames = append(definitionNames, aws.StringValue(v.ContainerGroupDefinitionName))
+		}
+	}
+
+	tfMap := map[string]interface{}{
+		"container_group_definition_names": definitionNames,
+	}
+
+	if v := apiObject.ConnectionPortRange; v != nil {
+		tfMap["connection_port_range"] = []interface{}{map[string]interface{}{
+			"from_port": aws.Int64Value(v.FromPort),
+			"to_port":   aws.Int64Value(v.ToPort),
+		}}
+	}
+
+	if v := apiObject.ContainerGroupsPerInstance; v != nil {
+		tfMap["desired_replica_container_groups_per_instance"] = aws.Int64Value(v.DesiredReplicaContainerGroupsPerInstance)
+		tfMap["max_replica_container_groups_per_instance"] = aws.Int64Value(v.MaxReplicaContainerGroupsPerInstance)
+	}
+
+	return []interface{}{tfMap}
+}
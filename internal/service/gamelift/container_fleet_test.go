@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGameLiftContainerFleet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+	var conf gamelift.FleetAttributes
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_container_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerFleetConfig_basic(rName, imageURI, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "gamelift", regexache.MustCompile(`fleet/containerfleet-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "container_groups_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "container_groups_configuration.0.connection_port_range.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "container_groups_configuration.0.connection_port_range.0.from_port", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "container_groups_configuration.0.connection_port_range.0.to_port", "10100"),
+					resource.TestCheckResourceAttr(resourceName, "container_groups_configuration.0.container_group_definition_names.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ec2_instance_type", "c5.large"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerFleetConfig_basic(rName, imageURI, rName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerFleet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+	var conf gamelift.FleetAttributes
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_container_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerFleetConfig_basic(rName, imageURI, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgamelift.ResourceContainerFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccContainerFleetConfig_basic(rName, imageURI, fleetName string) string {
+	return acctest.ConfigCompose(testAccContainerGroupDefinitionConfig_basic(rName, imageURI), testAccFleetIAMRole(rName), fmt.Sprintf(`
+resource "aws_gamelift_container_fleet" "test" {
+  ec2_instance_type = "c5.large"
+  instance_role_arn = aws_iam_role.test.arn
+  name              = %[1]q
+
+  container_groups_configuration {
+    container_group_definition_names = [aws_gamelift_container_group_definition.test.name]
+
+    connection_port_range {
+      from_port = 10000
+      to_port   = 10100
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, fleetName))
+}
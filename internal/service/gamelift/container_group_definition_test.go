@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Container group definitions require a game server image in an Amazon ECR repository in the test account.
+const envVarContainerImageURI = "GAMELIFT_CONTAINER_IMAGE_URI"
+
+func TestAccGameLiftContainerGroupDefinition_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+	var conf gamelift.ContainerGroupDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_container_group_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerGroupDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerGroupDefinitionConfig_basic(rName, imageURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "gamelift", regexache.MustCompile(`containergroupdefinition/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "container_definitions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "container_definitions.0.container_name", "game-server"),
+					resource.TestCheckResourceAttr(resourceName, "container_definitions.0.essential", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "container_definitions.0.image_uri", imageURI),
+					resource.TestCheckResourceAttr(resourceName, "container_definitions.0.memory_limits.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "container_definitions.0.memory_limits.0.hard_limit", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "container_definitions.0.port_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "container_definitions.0.port_configuration.0.container_port_ranges.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions.0.resolved_image_digest"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "operating_system", "AMAZON_LINUX_2023"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling_strategy", "REPLICA"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "total_cpu_limit", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "total_memory_limit", "2048"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerGroupDefinition_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+	var conf gamelift.ContainerGroupDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_container_group_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerGroupDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerGroupDefinitionConfig_basic(rName, imageURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgamelift.ResourceContainerGroupDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContainerGroupDefinitionExists(ctx context.Context, n string, v *gamelift.ContainerGroupDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn(ctx)
+
+		output, err := tfgamelift.FindContainerGroupDefinitionByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckContainerGroupDefinitionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_gamelift_container_group_definition" {
+				continue
+			}
+
+			_, err := tfgamelift.FindContainerGroupDefinitionByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("GameLift Container Group Definition %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccContainerGroupDefinitionConfig_basic(rName, imageURI string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_container_group_definition" "test" {
+  name               = %[1]q
+  operating_system   = "AMAZON_LINUX_2023"
+  total_cpu_limit    = 1024
+  total_memory_limit = 2048
+
+  container_definitions {
+    container_name = "game-server"
+    essential      = true
+    image_uri      = %[2]q
+
+    memory_limits {
+      hard_limit = 1024
+    }
+
+    port_configuration {
+      container_port_ranges {
+        from_port = 7777
+        to_port   = 7777
+        protocol  = "UDP"
+      }
+    }
+  }
+}
+`, rName, imageURI)
+}
@@ -38,6 +38,64 @@ func FindBuildByID(ctx context.Context, conn *gamelift.GameLift, id string) (*ga
 	return output.Build, nil
 }
 
+func FindComputeByTwoPartKey(ctx context.Context, conn *gamelift.GameLift, fleetID, computeName string) (*gamelift.Compute, error) {
+	input := &gamelift.DescribeComputeInput{
+		ComputeName: aws.String(computeName),
+		FleetId:     aws.String(fleetID),
+	}
+
+	output, err := conn.DescribeComputeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Compute == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Compute.ComputeStatus); status == gamelift.ComputeStatusTerminating {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Compute, nil
+}
+
+func FindContainerGroupDefinitionByName(ctx context.Context, conn *gamelift.GameLift, name string) (*gamelift.ContainerGroupDefinition, error) {
+	input := &gamelift.DescribeContainerGroupDefinitionInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeContainerGroupDefinitionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContainerGroupDefinition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContainerGroupDefinition, nil
+}
+
 func FindFleetByID(ctx context.Context, conn *gamelift.GameLift, id string) (*gamelift.FleetAttributes, error) {
 	input := &gamelift.DescribeFleetAttributesInput{
 		FleetIds: aws.StringSlice([]string{id}),
@@ -73,6 +131,47 @@ func FindFleetByID(ctx context.Context, conn *gamelift.GameLift, id string) (*ga
 	return fleet, nil
 }
 
+func findFleetLocationsByID(ctx context.Context, conn *gamelift.GameLift, id string) ([]string, error) {
+	input := &gamelift.DescribeFleetLocationAttributesInput{
+		FleetId: aws.String(id),
+	}
+	var locations []string
+
+	err := conn.DescribeFleetLocationAttributesPagesWithContext(ctx, input, func(page *gamelift.DescribeFleetLocationAttributesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LocationAttributes {
+			if v == nil || v.LocationState == nil {
+				continue
+			}
+
+			// Locations being removed from the fleet are no longer part of its configuration.
+			if aws.StringValue(v.LocationState.Status) == gamelift.FleetStatusDeleting {
+				continue
+			}
+
+			locations = append(locations, aws.StringValue(v.LocationState.Location))
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return locations, nil
+}
+
 func FindGameServerGroupByName(ctx context.Context, conn *gamelift.GameLift, name string) (*gamelift.GameServerGroup, error) {
 	input := &gamelift.DescribeGameServerGroupInput{
 		GameServerGroupName: aws.String(name),
@@ -98,6 +197,41 @@ func FindGameServerGroupByName(ctx context.Context, conn *gamelift.GameLift, nam
 	return output.GameServerGroup, nil
 }
 
+func FindLocationByName(ctx context.Context, conn *gamelift.GameLift, name string) (*gamelift.LocationModel, error) {
+	input := &gamelift.ListLocationsInput{
+		Filters: aws.StringSlice([]string{gamelift.LocationFilterCustom}),
+	}
+	var output *gamelift.LocationModel
+
+	err := conn.ListLocationsPagesWithContext(ctx, input, func(page *gamelift.ListLocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Locations {
+			if v != nil && aws.StringValue(v.LocationName) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindScriptByID(ctx context.Context, conn *gamelift.GameLift, id string) (*gamelift.Script, error) {
 	input := &gamelift.DescribeScriptInput{
 		ScriptId: aws.String(id),
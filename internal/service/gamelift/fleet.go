@@ -5,15 +5,18 @@ package gamelift
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -49,6 +52,20 @@ func ResourceFleet() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"anywhere_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cost": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^\d{1,5}(?:\.\d{1,5})?$`), "must be a decimal number with up to 5 digits before and after the decimal point"),
+						},
+					},
+				},
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,10 +75,10 @@ func ResourceFleet() *schema.Resource {
 				Computed: true,
 			},
 			"build_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ExactlyOneOf: []string{"build_id", "script_id"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"script_id"},
 			},
 			"certificate_configuration": {
 				Type:     schema.TypeList,
@@ -80,6 +97,13 @@ func ResourceFleet() *schema.Resource {
 					},
 				},
 			},
+			"compute_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      gamelift.ComputeTypeEc2,
+				ValidateFunc: validation.StringInSlice([]string{gamelift.ComputeTypeEc2, gamelift.ComputeTypeAnywhere}, false),
+			},
 			names.AttrDescription: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -117,7 +141,7 @@ func ResourceFleet() *schema.Resource {
 			},
 			"ec2_instance_type": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(gamelift.EC2InstanceType_Values(), false),
 			},
@@ -134,6 +158,14 @@ func ResourceFleet() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 				Optional:     true,
 			},
+			"locations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
 			"log_paths": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -230,27 +262,73 @@ func ResourceFleet() *schema.Resource {
 				Computed: true,
 			},
 			"script_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ExactlyOneOf: []string{"build_id", "script_id"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"build_id"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceFleetCustomizeDiff,
+		),
 	}
 }
 
+func resourceFleetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	// Unknown values are not null in the raw configuration, so references to other resources pass.
+	config := d.GetRawConfig()
+
+	switch d.Get("compute_type").(string) {
+	case gamelift.ComputeTypeEc2:
+		if config.GetAttr("ec2_instance_type").IsNull() {
+			return errors.New(`"ec2_instance_type" is required when "compute_type" is "EC2"`)
+		}
+		if config.GetAttr("build_id").IsNull() && config.GetAttr("script_id").IsNull() {
+			return errors.New(`one of "build_id" or "script_id" is required when "compute_type" is "EC2"`)
+		}
+		if v := config.GetAttr("anywhere_configuration"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			return errors.New(`"anywhere_configuration" can only be set when "compute_type" is "ANYWHERE"`)
+		}
+	case gamelift.ComputeTypeAnywhere:
+		if !config.GetAttr("ec2_instance_type").IsNull() {
+			return errors.New(`"ec2_instance_type" cannot be set when "compute_type" is "ANYWHERE"`)
+		}
+		if v := config.GetAttr("locations"); v.IsNull() || (v.IsKnown() && v.LengthInt() == 0) {
+			return errors.New(`at least one custom location is required in "locations" when "compute_type" is "ANYWHERE"`)
+		}
+	}
+
+	return nil
+}
+
 func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
 
 	input := &gamelift.CreateFleetInput{
-		EC2InstanceType: aws.String(d.Get("ec2_instance_type").(string)),
-		Name:            aws.String(d.Get(names.AttrName).(string)),
-		Tags:            getTagsIn(ctx),
+		ComputeType: aws.String(d.Get("compute_type").(string)),
+		Name:        aws.String(d.Get(names.AttrName).(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("anywhere_configuration"); ok {
+		input.AnywhereConfiguration = expandAnywhereConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("ec2_instance_type"); ok {
+		input.EC2InstanceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("locations"); ok && v.(*schema.Set).Len() > 0 {
+		input.Locations = expandLocationConfigurations(flex.ExpandStringValueSet(v.(*schema.Set)))
 	}
 
 	if v, ok := d.GetOk("build_id"); ok {
@@ -343,8 +421,12 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	arn := aws.StringValue(fleet.FleetArn)
+	if err := d.Set("anywhere_configuration", flattenAnywhereConfiguration(fleet.AnywhereConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting anywhere_configuration: %s", err)
+	}
 	d.Set("build_arn", fleet.BuildArn)
 	d.Set("build_id", fleet.BuildId)
+	d.Set("compute_type", fleet.ComputeType)
 	d.Set(names.AttrDescription, fleet.Description)
 	d.Set(names.AttrARN, arn)
 	d.Set("log_paths", aws.StringValueSlice(fleet.LogPaths))
@@ -366,6 +448,22 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "setting resource_creation_limit_policy: %s", err)
 	}
 
+	locations, err := findFleetLocationsByID(ctx, conn, d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Fleet (%s) locations: %s", d.Id(), err)
+	}
+
+	if err := d.Set("locations", locations); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting locations: %s", err)
+	}
+
+	// Port settings only apply to fleets of managed EC2 instances.
+	if aws.StringValue(fleet.ComputeType) == gamelift.ComputeTypeAnywhere {
+		d.Set("ec2_inbound_permission", nil)
+
+		return diags
+	}
+
 	portInput := &gamelift.DescribeFleetPortSettingsInput{
 		FleetId: aws.String(d.Id()),
 	}
@@ -388,15 +486,21 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	log.Printf("[INFO] Updating GameLift Fleet: %s", d.Id())
 
-	if d.HasChanges(names.AttrDescription, "metric_groups", names.AttrName, "new_game_session_protection_policy", "resource_creation_limit_policy") {
-		_, err := conn.UpdateFleetAttributesWithContext(ctx, &gamelift.UpdateFleetAttributesInput{
+	if d.HasChanges("anywhere_configuration", names.AttrDescription, "metric_groups", names.AttrName, "new_game_session_protection_policy", "resource_creation_limit_policy") {
+		input := &gamelift.UpdateFleetAttributesInput{
 			Description:                    aws.String(d.Get(names.AttrDescription).(string)),
 			FleetId:                        aws.String(d.Id()),
 			MetricGroups:                   flex.ExpandStringList(d.Get("metric_groups").([]interface{})),
 			Name:                           aws.String(d.Get(names.AttrName).(string)),
 			NewGameSessionProtectionPolicy: aws.String(d.Get("new_game_session_protection_policy").(string)),
 			ResourceCreationLimitPolicy:    expandResourceCreationLimitPolicy(d.Get("resource_creation_limit_policy").([]interface{})),
-		})
+		}
+
+		if d.HasChange("anywhere_configuration") {
+			input.AnywhereConfiguration = expandAnywhereConfiguration(d.Get("anywhere_configuration").([]interface{}))
+		}
+
+		_, err := conn.UpdateFleetAttributesWithContext(ctx, input)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating for GameLift Fleet attributes (%s): %s", d.Id(), err)
 		}
@@ -416,6 +520,31 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	if d.HasChange("locations") {
+		o, n := d.GetChange("locations")
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+
+		if add := flex.ExpandStringValueSet(newSet.Difference(oldSet)); len(add) > 0 {
+			_, err := conn.CreateFleetLocationsWithContext(ctx, &gamelift.CreateFleetLocationsInput{
+				FleetId:   aws.String(d.Id()),
+				Locations: expandLocationConfigurations(add),
+			})
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "adding GameLift Fleet (%s) locations: %s", d.Id(), err)
+			}
+		}
+
+		if del := flex.ExpandStringValueSet(oldSet.Difference(newSet)); len(del) > 0 {
+			_, err := conn.DeleteFleetLocationsWithContext(ctx, &gamelift.DeleteFleetLocationsInput{
+				FleetId:   aws.String(d.Id()),
+				Locations: aws.StringSlice(del),
+			})
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing GameLift Fleet (%s) locations: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("runtime_configuration") {
 		_, err := conn.UpdateRuntimeConfigurationWithContext(ctx, &gamelift.UpdateRuntimeConfigurationInput{
 			FleetId:              aws.String(d.Id()),
@@ -523,6 +652,42 @@ func flattenIPPermission(apiObject *gamelift.IpPermission) map[string]interface{
 	return tfMap
 }
 
+func expandAnywhereConfiguration(tfList []interface{}) *gamelift.AnywhereConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &gamelift.AnywhereConfiguration{
+		Cost: aws.String(tfMap["cost"].(string)),
+	}
+}
+
+func flattenAnywhereConfiguration(apiObject *gamelift.AnywhereConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"cost": aws.StringValue(apiObject.Cost),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandLocationConfigurations(locations []string) []*gamelift.LocationConfiguration {
+	apiObjects := make([]*gamelift.LocationConfiguration, 0, len(locations))
+
+	for _, location := range locations {
+		apiObjects = append(apiObjects, &gamelift.LocationConfiguration{
+			Location: aws.String(location),
+		})
+	}
+
+	return apiObjects
+}
+
 func expandResourceCreationLimitPolicy(cfg []interface{}) *gamelift.ResourceCreationLimitPolicy {
 	if len(cfg) < 1 {
 		return nil
@@ -47,6 +47,27 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceCompute,
+			TypeName: "aws_gamelift_compute",
+			Name:     "Compute",
+		},
+		{
+			Factory:  ResourceContainerFleet,
+			TypeName: "aws_gamelift_container_fleet",
+			Name:     "Container Fleet",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  ResourceContainerGroupDefinition,
+			TypeName: "aws_gamelift_container_group_definition",
+			Name:     "Container Group Definition",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceFleet,
 			TypeName: "aws_gamelift_fleet",
@@ -71,6 +92,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceLocation,
+			TypeName: "aws_gamelift_location",
+			Name:     "Location",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceScript,
 			TypeName: "aws_gamelift_script",
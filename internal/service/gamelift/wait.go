@@ -5,6 +5,7 @@ package gamelift
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -193,3 +195,45 @@ func waitGameServerGroupTerminated(ctx context.Context, conn *gamelift.GameLift,
 
 	return nil
 }
+
+func waitContainerGroupDefinitionReady(ctx context.Context, conn *gamelift.GameLift, name string, timeout time.Duration) (*gamelift.ContainerGroupDefinition, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{gamelift.ContainerGroupDefinitionStatusCopying},
+		Target:  []string{gamelift.ContainerGroupDefinitionStatusReady},
+		Refresh: statusContainerGroupDefinition(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*gamelift.ContainerGroupDefinition); ok {
+		if status := aws.StringValue(output.Status); status == gamelift.ContainerGroupDefinitionStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerGroupDefinitionDeleted(ctx context.Context, conn *gamelift.GameLift, name string, timeout time.Duration) (*gamelift.ContainerGroupDefinition, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			gamelift.ContainerGroupDefinitionStatusCopying,
+			gamelift.ContainerGroupDefinitionStatusFailed,
+			gamelift.ContainerGroupDefinitionStatusReady,
+		},
+		Target:  []string{},
+		Refresh: statusContainerGroupDefinition(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*gamelift.ContainerGroupDefinition); ok {
+		return output, err
+	}
+
+	return nil, err
+}
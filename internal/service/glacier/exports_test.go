@@ -5,9 +5,11 @@ package glacier
 
 // Exports for use in tests only.
 var (
-	ResourceVault     = resourceVault
-	ResourceVaultLock = resourceVaultLock
+	ResourceVault             = resourceVault
+	ResourceVaultLock         = resourceVaultLock
+	ResourceVaultNotification = resourceVaultNotification
 
-	FindVaultByName     = findVaultByName
-	FindVaultLockByName = findVaultLockByName
+	FindVaultByName              = findVaultByName
+	FindVaultLockByName          = findVaultLockByName
+	FindVaultNotificationsByName = findVaultNotificationsByName
 )
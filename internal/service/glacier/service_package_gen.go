@@ -41,6 +41,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceVaultLock,
 			TypeName: "aws_glacier_vault_lock",
 		},
+		{
+			Factory:  resourceVaultNotification,
+			TypeName: "aws_glacier_vault_notification",
+			Name:     "Vault Notification",
+		},
 	}
 }
 
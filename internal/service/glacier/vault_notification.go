@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glacier"
+	"github.com/aws/aws-sdk-go-v2/service/glacier/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_glacier_vault_notification", name="Vault Notification")
+func resourceVaultNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVaultNotificationPut,
+		ReadWithoutTimeout:   resourceVaultNotificationRead,
+		UpdateWithoutTimeout: resourceVaultNotificationPut,
+		DeleteWithoutTimeout: resourceVaultNotificationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"ArchiveRetrievalCompleted",
+						"InventoryRetrievalCompleted",
+					}, false),
+				},
+			},
+			"sns_topic": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceVaultNotificationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	vaultName := d.Get("vault_name").(string)
+	input := &glacier.SetVaultNotificationsInput{
+		VaultName: aws.String(vaultName),
+		VaultNotificationConfig: expandVaultNotificationConfig(map[string]interface{}{
+			"events":    d.Get("events"),
+			"sns_topic": d.Get("sns_topic"),
+		}),
+	}
+
+	_, err := conn.SetVaultNotifications(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting Glacier Vault (%s) notifications: %s", vaultName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(vaultName)
+	}
+
+	return append(diags, resourceVaultNotificationRead(ctx, d, meta)...)
+}
+
+func resourceVaultNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	output, err := findVaultNotificationsByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glacier Vault Notification (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glacier Vault Notification (%s): %s", d.Id(), err)
+	}
+
+	d.Set("events", output.Events)
+	d.Set("sns_topic", output.SNSTopic)
+	d.Set("vault_name", d.Id())
+
+	return diags
+}
+
+func resourceVaultNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Glacier Vault Notification: %s", d.Id())
+	_, err := conn.DeleteVaultNotifications(ctx, &glacier.DeleteVaultNotificationsInput{
+		VaultName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Glacier Vault Notification (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findVaultNotificationsByName(ctx context.Context, conn *glacier.Client, name string) (*types.VaultNotificationConfig, error) {
+	input := &glacier.GetVaultNotificationsInput{
+		VaultName: aws.String(name),
+	}
+
+	output, err := conn.GetVaultNotifications(ctx, input)
+
+	// "An error occurred (ResourceNotFoundException) when calling the GetVaultNotifications operation: No notification configuration is set for vault: ..."
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VaultNotificationConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.VaultNotificationConfig, nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/glacier/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglacier "github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlacierVaultNotification_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.VaultNotificationConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glacier_vault_notification.test"
+	vaultResourceName := "aws_glacier_vault.test"
+	snsResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultNotificationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultNotificationConfig_basic(rName, `["ArchiveRetrievalCompleted"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultNotificationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "events.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "ArchiveRetrievalCompleted"),
+					resource.TestCheckResourceAttrPair(resourceName, "sns_topic", snsResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "vault_name", vaultResourceName, names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVaultNotificationConfig_basic(rName, `["ArchiveRetrievalCompleted", "InventoryRetrievalCompleted"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultNotificationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "events.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "ArchiveRetrievalCompleted"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "InventoryRetrievalCompleted"),
+					resource.TestCheckResourceAttrPair(resourceName, "sns_topic", snsResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlacierVaultNotification_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.VaultNotificationConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glacier_vault_notification.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultNotificationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultNotificationConfig_basic(rName, `["ArchiveRetrievalCompleted"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultNotificationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfglacier.ResourceVaultNotification(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVaultNotificationExists(ctx context.Context, n string, v *types.VaultNotificationConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierClient(ctx)
+
+		output, err := tfglacier.FindVaultNotificationsByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVaultNotificationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_glacier_vault_notification" {
+				continue
+			}
+
+			_, err := tfglacier.FindVaultNotificationsByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Glacier Vault Notification %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVaultNotificationConfig_basic(rName, events string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_glacier_vault" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [notification]
+  }
+}
+
+resource "aws_glacier_vault_notification" "test" {
+  vault_name = aws_glacier_vault.test.name
+  sns_topic  = aws_sns_topic.test.arn
+  events     = %[2]s
+}
+`, rName, events)
+}
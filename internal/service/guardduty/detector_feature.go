@@ -32,6 +32,7 @@ func ResourceDetectorFeature() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"additional_configuration": {
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 				Type:     schema.TypeList,
 				Elem: &schema.Resource{
@@ -75,6 +76,11 @@ func resourceDetectorFeaturePut(ctx context.Context, d *schema.ResourceData, met
 	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
 
 	detectorID, name := d.Get("detector_id").(string), d.Get(names.AttrName).(string)
+
+	// Use a mutex to ensure that multiple features and additional configurations being updated concurrently don't trample on each other.
+	conns.GlobalMutexKV.Lock(detectorID)
+	defer conns.GlobalMutexKV.Unlock(detectorID)
+
 	feature := &guardduty.DetectorFeatureConfiguration{
 		Name:   aws.String(name),
 		Status: aws.String(d.Get(names.AttrStatus).(string)),
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_guardduty_detector_feature_additional_configuration", name="Detector Feature Additional Configuration")
+func ResourceDetectorFeatureAdditionalConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorFeatureAdditionalConfigurationPut,
+		ReadWithoutTimeout:   resourceDetectorFeatureAdditionalConfigurationRead,
+		UpdateWithoutTimeout: resourceDetectorFeatureAdditionalConfigurationPut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"feature_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.DetectorFeature_Values(), false),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.FeatureAdditionalConfiguration_Values(), false),
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDetectorFeatureAdditionalConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	detectorID, featureName, name := d.Get("detector_id").(string), d.Get("feature_name").(string), d.Get(names.AttrName).(string)
+
+	// Multiple additional configurations of the same feature may be updated concurrently.
+	conns.GlobalMutexKV.Lock(detectorID)
+	defer conns.GlobalMutexKV.Unlock(detectorID)
+
+	// The feature's own status must be sent with its additional configuration, so preserve the current value.
+	feature, err := FindDetectorFeatureByTwoPartKey(ctx, conn, detectorID, featureName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector (%s) Feature (%s): %s", detectorID, featureName, err)
+	}
+
+	input := &guardduty.UpdateDetectorInput{
+		DetectorId: aws.String(detectorID),
+		Features: []*guardduty.DetectorFeatureConfiguration{{
+			AdditionalConfiguration: []*guardduty.DetectorAdditionalConfiguration{{
+				Name:   aws.String(name),
+				Status: aws.String(d.Get(names.AttrStatus).(string)),
+			}},
+			Name:   aws.String(featureName),
+			Status: feature.Status,
+		}},
+	}
+
+	_, err = conn.UpdateDetectorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Detector (%s) Feature (%s) Additional Configuration (%s): %s", detectorID, featureName, name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(detectorFeatureAdditionalConfigurationCreateResourceID(detectorID, featureName, name))
+	}
+
+	return append(diags, resourceDetectorFeatureAdditionalConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceDetectorFeatureAdditionalConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	detectorID, featureName, name, err := detectorFeatureAdditionalConfigurationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	additionalConfiguration, err := FindDetectorFeatureAdditionalConfigurationByThreePartKey(ctx, conn, detectorID, featureName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Detector Feature Additional Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector Feature Additional Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("detector_id", detectorID)
+	d.Set("feature_name", featureName)
+	d.Set(names.AttrName, additionalConfiguration.Name)
+	d.Set(names.AttrStatus, additionalConfiguration.Status)
+
+	return diags
+}
+
+const detectorFeatureAdditionalConfigurationResourceIDSeparator = "/"
+
+func detectorFeatureAdditionalConfigurationCreateResourceID(detectorID, featureName, name string) string {
+	parts := []string{detectorID, featureName, name}
+	id := strings.Join(parts, detectorFeatureAdditionalConfigurationResourceIDSeparator)
+
+	return id
+}
+
+func detectorFeatureAdditionalConfigurationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, detectorFeatureAdditionalConfigurationResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DETECTORID%[2]sFEATURENAME%[2]sADDITIONALCONFIGURATIONNAME", id, detectorFeatureAdditionalConfigurationResourceIDSeparator)
+}
+
+func FindDetectorFeatureAdditionalConfigurationByThreePartKey(ctx context.Context, conn *guardduty.GuardDuty, detectorID, featureName, name string) (*guardduty.DetectorAdditionalConfigurationResult, error) {
+	output, err := FindDetectorFeatureByTwoPartKey(ctx, conn, detectorID, featureName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(tfslices.Filter(output.AdditionalConfiguration, func(v *guardduty.DetectorAdditionalConfigurationResult) bool {
+		return aws.StringValue(v.Name) == name
+	}))
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDetectorFeatureAdditionalConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_detector_feature_additional_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDetectorNotExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureAdditionalConfigurationConfig_basic("ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeatureAdditionalConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "detector_id"),
+					resource.TestCheckResourceAttr(resourceName, "feature_name", "RUNTIME_MONITORING"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "ECS_FARGATE_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ENABLED"),
+					resource.TestCheckResourceAttr("aws_guardduty_detector_feature.test", names.AttrStatus, "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDetectorFeatureAdditionalConfigurationConfig_basic("DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeatureAdditionalConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DISABLED"),
+					resource.TestCheckResourceAttr("aws_guardduty_detector_feature.test", names.AttrStatus, "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDetectorFeatureAdditionalConfiguration_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource1Name := "aws_guardduty_detector_feature_additional_configuration.test1"
+	resource2Name := "aws_guardduty_detector_feature_additional_configuration.test2"
+	resource3Name := "aws_guardduty_detector_feature_additional_configuration.test3"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDetectorNotExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureAdditionalConfigurationConfig_multiple("ENABLED", "DISABLED", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeatureAdditionalConfigurationExists(ctx, resource1Name),
+					testAccCheckDetectorFeatureAdditionalConfigurationExists(ctx, resource2Name),
+					testAccCheckDetectorFeatureAdditionalConfigurationExists(ctx, resource3Name),
+					resource.TestCheckResourceAttr(resource1Name, names.AttrName, "EKS_ADDON_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resource1Name, names.AttrStatus, "ENABLED"),
+					resource.TestCheckResourceAttr(resource2Name, names.AttrName, "ECS_FARGATE_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resource2Name, names.AttrStatus, "DISABLED"),
+					resource.TestCheckResourceAttr(resource3Name, names.AttrName, "EC2_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resource3Name, names.AttrStatus, "ENABLED"),
+				),
+			},
+			{
+				Config: testAccDetectorFeatureAdditionalConfigurationConfig_multiple("DISABLED", "ENABLED", "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorFeatureAdditionalConfigurationExists(ctx, resource1Name),
+					testAccCheckDetectorFeatureAdditionalConfigurationExists(ctx, resource2Name),
+					testAccCheckDetectorFeatureAdditionalConfigurationExists(ctx, resource3Name),
+					resource.TestCheckResourceAttr(resource1Name, names.AttrStatus, "DISABLED"),
+					resource.TestCheckResourceAttr(resource2Name, names.AttrStatus, "ENABLED"),
+					resource.TestCheckResourceAttr(resource3Name, names.AttrStatus, "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorFeatureAdditionalConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn(ctx)
+
+		_, err := tfguardduty.FindDetectorFeatureAdditionalConfigurationByThreePartKey(ctx, conn, rs.Primary.Attributes["detector_id"], rs.Primary.Attributes["feature_name"], rs.Primary.Attributes[names.AttrName])
+
+		return err
+	}
+}
+
+const testAccDetectorFeatureAdditionalConfigurationConfig_base = `
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_detector_feature" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "RUNTIME_MONITORING"
+  status      = "ENABLED"
+}
+`
+
+func testAccDetectorFeatureAdditionalConfigurationConfig_basic(status string) string {
+	return acctest.ConfigCompose(testAccDetectorFeatureAdditionalConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_guardduty_detector_feature_additional_configuration" "test" {
+  detector_id  = aws_guardduty_detector_feature.test.detector_id
+  feature_name = aws_guardduty_detector_feature.test.name
+  name         = "ECS_FARGATE_AGENT_MANAGEMENT"
+  status       = %[1]q
+}
+`, status))
+}
+
+func testAccDetectorFeatureAdditionalConfigurationConfig_multiple(status1, status2, status3 string) string {
+	return acctest.ConfigCompose(testAccDetectorFeatureAdditionalConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_guardduty_detector_feature_additional_configuration" "test1" {
+  detector_id  = aws_guardduty_detector_feature.test.detector_id
+  feature_name = aws_guardduty_detector_feature.test.name
+  name         = "EKS_ADDON_MANAGEMENT"
+  status       = %[1]q
+}
+
+resource "aws_guardduty_detector_feature_additional_configuration" "test2" {
+  detector_id  = aws_guardduty_detector_feature.test.detector_id
+  feature_name = aws_guardduty_detector_feature.test.name
+  name         = "ECS_FARGATE_AGENT_MANAGEMENT"
+  status       = %[2]q
+}
+
+resource "aws_guardduty_detector_feature_additional_configuration" "test3" {
+  detector_id  = aws_guardduty_detector_feature.test.detector_id
+  feature_name = aws_guardduty_detector_feature.test.name
+  name         = "EC2_AGENT_MANAGEMENT"
+  status       = %[3]q
+}
+`, status1, status2, status3))
+}
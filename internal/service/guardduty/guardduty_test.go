@@ -33,6 +33,10 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"additional_configuration": testAccDetectorFeature_additionalConfiguration,
 			"multiple":                 testAccDetectorFeature_multiple,
 		},
+		"DetectorFeatureAdditionalConfiguration": {
+			acctest.CtBasic: testAccDetectorFeatureAdditionalConfiguration_basic,
+			"multiple":      testAccDetectorFeatureAdditionalConfiguration_multiple,
+		},
 		"Filter": {
 			acctest.CtBasic:      testAccFilter_basic,
 			"update":             testAccFilter_update,
@@ -64,6 +68,9 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"additional_configuration": testAccOrganizationConfigurationFeature_additionalConfiguration,
 			"multiple":                 testAccOrganizationConfigurationFeature_multiple,
 		},
+		"OrganizationConfigurationFeatureAdditionalConfiguration": {
+			acctest.CtBasic: testAccOrganizationConfigurationFeatureAdditionalConfiguration_basic,
+		},
 		"ThreatIntelSet": {
 			acctest.CtBasic: testAccThreatIntelSet_basic,
 			"tags":          testAccThreatIntelSet_tags,
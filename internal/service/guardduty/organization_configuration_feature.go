@@ -32,6 +32,7 @@ func ResourceOrganizationConfigurationFeature() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"additional_configuration": {
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 				Type:     schema.TypeList,
 				Elem: &schema.Resource{
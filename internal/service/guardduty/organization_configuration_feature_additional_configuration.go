@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_guardduty_organization_configuration_feature_additional_configuration", name="Organization Configuration Feature Additional Configuration")
+func ResourceOrganizationConfigurationFeatureAdditionalConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationConfigurationFeatureAdditionalConfigurationPut,
+		ReadWithoutTimeout:   resourceOrganizationConfigurationFeatureAdditionalConfigurationRead,
+		UpdateWithoutTimeout: resourceOrganizationConfigurationFeatureAdditionalConfigurationPut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_enable": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureStatus_Values(), false),
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"feature_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.OrgFeature_Values(), false),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureAdditionalConfiguration_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceOrganizationConfigurationFeatureAdditionalConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	detectorID, featureName, name := d.Get("detector_id").(string), d.Get("feature_name").(string), d.Get(names.AttrName).(string)
+
+	// Share the organization configuration feature mutex so that concurrent updates don't trample on each other.
+	conns.GlobalMutexKV.Lock(detectorID)
+	defer conns.GlobalMutexKV.Unlock(detectorID)
+
+	output, err := FindOrganizationConfigurationByID(ctx, conn, detectorID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Organization Configuration (%s): %s", detectorID, err)
+	}
+
+	// The feature's own auto-enable setting must be sent with its additional configuration, so preserve the current value.
+	feature, err := tfresource.AssertSinglePtrResult(tfslices.Filter(output.Features, func(v *guardduty.OrganizationFeatureConfigurationResult) bool {
+		return aws.StringValue(v.Name) == featureName
+	}))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Organization Configuration (%s) Feature (%s): %s", detectorID, featureName, err)
+	}
+
+	input := &guardduty.UpdateOrganizationConfigurationInput{
+		AutoEnableOrganizationMembers: output.AutoEnableOrganizationMembers,
+		DetectorId:                    aws.String(detectorID),
+		Features: []*guardduty.OrganizationFeatureConfiguration{{
+			AdditionalConfiguration: []*guardduty.OrganizationAdditionalConfiguration{{
+				AutoEnable: aws.String(d.Get("auto_enable").(string)),
+				Name:       aws.String(name),
+			}},
+			AutoEnable: feature.AutoEnable,
+			Name:       aws.String(featureName),
+		}},
+	}
+
+	_, err = conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Organization Configuration (%s) Feature (%s) Additional Configuration (%s): %s", detectorID, featureName, name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(organizationConfigurationFeatureAdditionalConfigurationCreateResourceID(detectorID, featureName, name))
+	}
+
+	return append(diags, resourceOrganizationConfigurationFeatureAdditionalConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationConfigurationFeatureAdditionalConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn(ctx)
+
+	detectorID, featureName, name, err := organizationConfigurationFeatureAdditionalConfigurationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	additionalConfiguration, err := FindOrganizationConfigurationFeatureAdditionalConfigurationByThreePartKey(ctx, conn, detectorID, featureName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Organization Configuration Feature Additional Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Organization Configuration Feature Additional Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("auto_enable", additionalConfiguration.AutoEnable)
+	d.Set("detector_id", detectorID)
+	d.Set("feature_name", featureName)
+	d.Set(names.AttrName, additionalConfiguration.Name)
+
+	return diags
+}
+
+const organizationConfigurationFeatureAdditionalConfigurationResourceIDSeparator = "/"
+
+func organizationConfigurationFeatureAdditionalConfigurationCreateResourceID(detectorID, featureName, name string) string {
+	parts := []string{detectorID, featureName, name}
+	id := strings.Join(parts, organizationConfigurationFeatureAdditionalConfigurationResourceIDSeparator)
+
+	return id
+}
+
+func organizationConfigurationFeatureAdditionalConfigurationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, organizationConfigurationFeatureAdditionalConfigurationResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DETECTORID%[2]sFEATURENAME%[2]sADDITIONALCONFIGURATIONNAME", id, organizationConfigurationFeatureAdditionalConfigurationResourceIDSeparator)
+}
+
+func FindOrganizationConfigurationFeatureAdditionalConfigurationByThreePartKey(ctx context.Context, conn *guardduty.GuardDuty, detectorID, featureName, name string) (*guardduty.OrganizationAdditionalConfigurationResult, error) {
+	output, err := FindOrganizationConfigurationFeatureByTwoPartKey(ctx, conn, detectorID, featureName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(tfslices.Filter(output.AdditionalConfiguration, func(v *guardduty.OrganizationAdditionalConfigurationResult) bool {
+		return aws.StringValue(v.Name) == name
+	}))
+}
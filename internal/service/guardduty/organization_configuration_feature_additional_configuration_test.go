@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccOrganizationConfigurationFeatureAdditionalConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_organization_configuration_feature_additional_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+			testAccPreCheckDetectorNotExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationFeatureAdditionalConfigurationConfig_basic("NEW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccOrganizationConfigurationFeatureAdditionalConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "NEW"),
+					resource.TestCheckResourceAttrSet(resourceName, "detector_id"),
+					resource.TestCheckResourceAttr(resourceName, "feature_name", "RUNTIME_MONITORING"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "ECS_FARGATE_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr("aws_guardduty_organization_configuration_feature.test", "auto_enable", "ALL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationConfigurationFeatureAdditionalConfigurationConfig_basic("NONE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccOrganizationConfigurationFeatureAdditionalConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "NONE"),
+					resource.TestCheckResourceAttr("aws_guardduty_organization_configuration_feature.test", "auto_enable", "ALL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfigurationFeatureAdditionalConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn(ctx)
+
+		_, err := tfguardduty.FindOrganizationConfigurationFeatureAdditionalConfigurationByThreePartKey(ctx, conn, rs.Primary.Attributes["detector_id"], rs.Primary.Attributes["feature_name"], rs.Primary.Attributes[names.AttrName])
+
+		return err
+	}
+}
+
+func testAccOrganizationConfigurationFeatureAdditionalConfigurationConfig_basic(autoEnable string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfigurationFeatureConfig_base, fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration_feature" "test" {
+  depends_on = [aws_guardduty_organization_configuration.test]
+
+  detector_id = aws_guardduty_detector.test.id
+  name        = "RUNTIME_MONITORING"
+  auto_enable = "ALL"
+}
+
+resource "aws_guardduty_organization_configuration_feature_additional_configuration" "test" {
+  detector_id  = aws_guardduty_organization_configuration_feature.test.detector_id
+  feature_name = aws_guardduty_organization_configuration_feature.test.name
+  name         = "ECS_FARGATE_AGENT_MANAGEMENT"
+  auto_enable  = %[1]q
+}
+`, autoEnable))
+}
@@ -64,6 +64,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_guardduty_detector_feature",
 			Name:     "Detector Feature",
 		},
+		{
+			Factory:  ResourceDetectorFeatureAdditionalConfiguration,
+			TypeName: "aws_guardduty_detector_feature_additional_configuration",
+			Name:     "Detector Feature Additional Configuration",
+		},
 		{
 			Factory:  ResourceFilter,
 			TypeName: "aws_guardduty_filter",
@@ -102,6 +107,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_guardduty_organization_configuration_feature",
 			Name:     "Organization Configuration Feature",
 		},
+		{
+			Factory:  ResourceOrganizationConfigurationFeatureAdditionalConfiguration,
+			TypeName: "aws_guardduty_organization_configuration_feature_additional_configuration",
+			Name:     "Organization Configuration Feature Additional Configuration",
+		},
 		{
 			Factory:  ResourcePublishingDestination,
 			TypeName: "aws_guardduty_publishing_destination",
@@ -55,7 +55,8 @@ func resourceOpenIDConnectProvider() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"thumbprint_list": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(40, 40),
@@ -79,10 +80,14 @@ func resourceOpenIDConnectProviderCreate(ctx context.Context, d *schema.Resource
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
 	input := &iam.CreateOpenIDConnectProviderInput{
-		ClientIDList:   flex.ExpandStringValueSet(d.Get("client_id_list").(*schema.Set)),
-		Tags:           getTagsIn(ctx),
-		ThumbprintList: flex.ExpandStringValueList(d.Get("thumbprint_list").([]interface{})),
-		Url:            aws.String(d.Get(names.AttrURL).(string)),
+		ClientIDList: flex.ExpandStringValueSet(d.Get("client_id_list").(*schema.Set)),
+		Tags:         getTagsIn(ctx),
+		Url:          aws.String(d.Get(names.AttrURL).(string)),
+	}
+
+	// If no thumbprints are configured, IAM retrieves the top intermediate CA thumbprint of the IdP's server certificate.
+	if v, ok := d.GetOk("thumbprint_list"); ok && len(v.([]interface{})) > 0 {
+		input.ThumbprintList = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
 	output, err := conn.CreateOpenIDConnectProvider(ctx, input)
@@ -148,10 +153,10 @@ func resourceOpenIDConnectProviderUpdate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	if d.HasChange("thumbprint_list") {
+	if v, ok := d.GetOk("thumbprint_list"); ok && len(v.([]interface{})) > 0 && d.HasChange("thumbprint_list") {
 		input := &iam.UpdateOpenIDConnectProviderThumbprintInput{
 			OpenIDConnectProviderArn: aws.String(d.Id()),
-			ThumbprintList:           flex.ExpandStringValueList(d.Get("thumbprint_list").([]interface{})),
+			ThumbprintList:           flex.ExpandStringValueList(v.([]interface{})),
 		}
 
 		_, err := conn.UpdateOpenIDConnectProviderThumbprint(ctx, input)
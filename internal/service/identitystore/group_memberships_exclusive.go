@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameGroupMembershipsExclusive = "GroupMembershipsExclusive"
+)
+
+// @SDKResource("aws_identitystore_group_memberships_exclusive")
+func ResourceGroupMembershipsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupMembershipsExclusiveCreate,
+		ReadWithoutTimeout:   resourceGroupMembershipsExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupMembershipsExclusiveUpdate,
+		DeleteWithoutTimeout: schema.NoopContext, // Removing the resource leaves the group's members in place.
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+
+			"member_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 47),
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipsExclusiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreId := d.Get("identity_store_id").(string)
+	groupId := d.Get("group_id").(string)
+	id := groupMembershipsExclusiveCreateResourceID(identityStoreId, groupId)
+
+	if err := syncGroupMemberships(ctx, conn, identityStoreId, groupId, flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set))); err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionCreating, ResNameGroupMembershipsExclusive, id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceGroupMembershipsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceGroupMembershipsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreId, groupId, err := groupMembershipsExclusiveParseResourceID(d.Id())
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameGroupMembershipsExclusive, d.Id(), err)
+	}
+
+	memberships, err := FindGroupMembershipsByGroupID(ctx, conn, identityStoreId, groupId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IdentityStore GroupMembershipsExclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameGroupMembershipsExclusive, d.Id(), err)
+	}
+
+	memberIds := make([]string, 0, len(memberships))
+	for memberId := range memberships {
+		memberIds = append(memberIds, memberId)
+	}
+
+	d.Set("group_id", groupId)
+	d.Set("identity_store_id", identityStoreId)
+	d.Set("member_ids", memberIds)
+
+	return diags
+}
+
+func resourceGroupMembershipsExclusiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	if d.HasChange("member_ids") {
+		identityStoreId := d.Get("identity_store_id").(string)
+		groupId := d.Get("group_id").(string)
+
+		if err := syncGroupMemberships(ctx, conn, identityStoreId, groupId, flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set))); err != nil {
+			return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionUpdating, ResNameGroupMembershipsExclusive, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceGroupMembershipsExclusiveRead(ctx, d, meta)...)
+}
+
+// syncGroupMemberships makes the group's members exactly memberIds.
+// The current memberships are listed once and only the differences are applied.
+func syncGroupMemberships(ctx context.Context, conn *identitystore.Client, identityStoreId, groupId string, memberIds []string) error {
+	memberships, err := FindGroupMembershipsByGroupID(ctx, conn, identityStoreId, groupId)
+
+	if err != nil {
+		return err
+	}
+
+	want := make(map[string]struct{}, len(memberIds))
+	for _, memberId := range memberIds {
+		want[memberId] = struct{}{}
+
+		if _, ok := memberships[memberId]; ok {
+			continue
+		}
+
+		input := &identitystore.CreateGroupMembershipInput{
+			GroupId:         aws.String(groupId),
+			IdentityStoreId: aws.String(identityStoreId),
+			MemberId:        &types.MemberIdMemberUserId{Value: memberId},
+		}
+
+		_, err := conn.CreateGroupMembership(ctx, input)
+		if err != nil {
+			// Added concurrently outside of Terraform.
+			var ce *types.ConflictException
+			if errors.As(err, &ce) {
+				continue
+			}
+
+			return fmt.Errorf("adding member (%s): %w", memberId, err)
+		}
+	}
+
+	for memberId, membershipId := range memberships {
+		if _, ok := want[memberId]; ok {
+			continue
+		}
+
+		input := &identitystore.DeleteGroupMembershipInput{
+			IdentityStoreId: aws.String(identityStoreId),
+			MembershipId:    aws.String(membershipId),
+		}
+
+		_, err := conn.DeleteGroupMembership(ctx, input)
+		if err != nil {
+			var nfe *types.ResourceNotFoundException
+			if errors.As(err, &nfe) {
+				continue
+			}
+
+			return fmt.Errorf("removing member (%s): %w", memberId, err)
+		}
+	}
+
+	return nil
+}
+
+const groupMembershipsExclusiveResourceIDSeparator = "/"
+
+func groupMembershipsExclusiveCreateResourceID(identityStoreId, groupId string) string {
+	return strings.Join([]string{identityStoreId, groupId}, groupMembershipsExclusiveResourceIDSeparator)
+}
+
+func groupMembershipsExclusiveParseResourceID(id string) (identityStoreId, groupId string, err error) {
+	parts := strings.Split(id, groupMembershipsExclusiveResourceIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		err = errors.New("expected a resource id in the form: identity-store-id/group-id")
+		return
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// FindGroupMembershipsByGroupID returns the group's user memberships keyed by member (user) ID.
+func FindGroupMembershipsByGroupID(ctx context.Context, conn *identitystore.Client, identityStoreId, groupId string) (map[string]string, error) {
+	in := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupId),
+		IdentityStoreId: aws.String(identityStoreId),
+	}
+
+	out := make(map[string]string)
+
+	pages := identitystore.NewListGroupMembershipsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			var e *types.ResourceNotFoundException
+			if errors.As(err, &e) {
+				return nil, &retry.NotFoundError{
+					LastError:   err,
+					LastRequest: in,
+				}
+			}
+
+			return nil, err
+		}
+
+		for _, v := range page.GroupMemberships {
+			memberId, ok := v.MemberId.(*types.MemberIdMemberUserId)
+			if !ok {
+				continue
+			}
+
+			out[memberId.Value] = aws.ToString(v.MembershipId)
+		}
+	}
+
+	return out, nil
+}
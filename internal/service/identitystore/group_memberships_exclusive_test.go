@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIdentityStoreGroupMembershipsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_identitystore_group_memberships_exclusive.test"
+	groupResourceName := "aws_identitystore_group.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExclusiveExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", groupResourceName, "group_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_store_id"),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.0", "user_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.1", "user_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreGroupMembershipsExclusive_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_identitystore_group_memberships_exclusive.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExclusiveExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExclusiveExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "3"),
+				),
+			},
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_basic(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExclusiveExists(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// A membership added outside of Terraform is removed on the next apply.
+func TestAccIdentityStoreGroupMembershipsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_identitystore_group_memberships_exclusive.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_outOfBandAddition(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExclusiveExists(ctx, resourceName, 2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExclusiveExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreGroupMembershipsExclusive_removeFromState(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_identitystore_group_memberships_exclusive.test"
+	groupResourceName := "aws_identitystore_group.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExclusiveExists(ctx, resourceName, 2),
+				),
+			},
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					// Destroying the resource leaves the group's members in place.
+					testAccCheckGroupMembershipsExclusiveExists(ctx, groupResourceName, 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMembershipsExclusiveExists(ctx context.Context, name string, expectedCount int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMembershipsExclusive, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMembershipsExclusive, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient(ctx)
+
+		memberships, err := tfidentitystore.FindGroupMembershipsByGroupID(ctx, conn, rs.Primary.Attributes["identity_store_id"], rs.Primary.Attributes["group_id"])
+
+		if err != nil {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMembershipsExclusive, rs.Primary.ID, err)
+		}
+
+		if got := len(memberships); got != expectedCount {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMembershipsExclusive, rs.Primary.ID, fmt.Errorf("expected %d memberships, got %d", expectedCount, got))
+		}
+
+		return nil
+	}
+}
+
+func testAccGroupMembershipsExclusiveConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  count = 3
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = "%[1]s-${count.index}"
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+  description       = "Acceptance Test"
+}
+`, rName)
+}
+
+func testAccGroupMembershipsExclusiveConfig_basic(rName string, memberCount int) string {
+	return acctest.ConfigCompose(testAccGroupMembershipsExclusiveConfig_base(rName), fmt.Sprintf(`
+resource "aws_identitystore_group_memberships_exclusive" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  group_id          = aws_identitystore_group.test.group_id
+  member_ids        = slice(aws_identitystore_user.test[*].user_id, 0, %[1]d)
+}
+`, memberCount))
+}
+
+func testAccGroupMembershipsExclusiveConfig_outOfBandAddition(rName string) string {
+	return acctest.ConfigCompose(testAccGroupMembershipsExclusiveConfig_basic(rName, 1), `
+resource "aws_identitystore_group_membership" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  group_id          = aws_identitystore_group.test.group_id
+  member_id         = aws_identitystore_user.test[1].user_id
+
+  depends_on = [aws_identitystore_group_memberships_exclusive.test]
+}
+`)
+}
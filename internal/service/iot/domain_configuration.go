@@ -5,12 +5,15 @@ package iot
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,13 +40,23 @@ func ResourceDomainConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
-
 		Schema: map[string]*schema.Schema{
+			"application_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(iot.ApplicationProtocol_Values(), false),
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"authentication_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(iot.AuthenticationType_Values(), false),
+			},
 			"authorizer_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -76,14 +89,28 @@ func ResourceDomainConfiguration() *schema.Resource {
 				ForceNew: true,
 			},
 			"server_certificate_arns": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{names.AttrDomainName},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"server_certificate_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_ocsp_check": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"service_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -118,12 +145,55 @@ func ResourceDomainConfiguration() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
+				RequiredWith: []string{names.AttrDomainName, "server_certificate_arns"},
 				ValidateFunc: verify.ValidARN,
 			},
 		},
+
+		CustomizeDiff: customdiff.All(
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				protocol, authenticationType := diff.Get("application_protocol").(string), diff.Get("authentication_type").(string)
+
+				if protocol == "" || authenticationType == "" || protocol == iot.ApplicationProtocolDefault || authenticationType == iot.AuthenticationTypeDefault {
+					return nil
+				}
+
+				if v, ok := domainConfigurationAuthenticationTypes[protocol]; ok && !slices.Contains(v, authenticationType) {
+					return fmt.Errorf(`"authentication_type" %q is not supported when "application_protocol" is %q`, authenticationType, protocol)
+				}
+
+				if authenticationType == iot.AuthenticationTypeCustomAuth || authenticationType == iot.AuthenticationTypeCustomAuthX509 {
+					if v := diff.Get("authorizer_config").([]interface{}); len(v) == 0 || v[0] == nil {
+						return fmt.Errorf(`"authorizer_config" is required when "authentication_type" is %q`, authenticationType)
+					}
+				}
+
+				return nil
+			},
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// domainConfigurationAuthenticationTypes maps each application protocol to the authentication types it supports.
+var domainConfigurationAuthenticationTypes = map[string][]string{
+	iot.ApplicationProtocolHttps: {
+		iot.AuthenticationTypeAwsSigv4,
+		iot.AuthenticationTypeAwsX509,
+		iot.AuthenticationTypeCustomAuth,
+		iot.AuthenticationTypeCustomAuthX509,
+	},
+	iot.ApplicationProtocolMqttWss: {
+		iot.AuthenticationTypeAwsSigv4,
+		iot.AuthenticationTypeCustomAuth,
+	},
+	iot.ApplicationProtocolSecureMqtt: {
+		iot.AuthenticationTypeAwsX509,
+		iot.AuthenticationTypeCustomAuth,
+		iot.AuthenticationTypeCustomAuthX509,
+	},
+}
+
 func resourceDomainConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IoTConn(ctx)
@@ -134,6 +204,14 @@ func resourceDomainConfigurationCreate(ctx context.Context, d *schema.ResourceDa
 		Tags:                    getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("application_protocol"); ok {
+		input.ApplicationProtocol = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("authentication_type"); ok {
+		input.AuthenticationType = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("authorizer_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.AuthorizerConfig = expandAuthorizerConfig(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -146,6 +224,10 @@ func resourceDomainConfigurationCreate(ctx context.Context, d *schema.ResourceDa
 		input.ServerCertificateArns = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("server_certificate_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ServerCertificateConfig = expandServerCertificateConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("service_type"); ok {
 		input.ServiceType = aws.String(v.(string))
 	}
@@ -185,7 +267,9 @@ func resourceDomainConfigurationRead(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendErrorf(diags, "reading IoT Domain Configuration (%s): %s", d.Id(), err)
 	}
 
+	d.Set("application_protocol", output.ApplicationProtocol)
 	d.Set(names.AttrARN, output.DomainConfigurationArn)
+	d.Set("authentication_type", output.AuthenticationType)
 	if output.AuthorizerConfig != nil {
 		if err := d.Set("authorizer_config", []interface{}{flattenAuthorizerConfig(output.AuthorizerConfig)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting authorizer_config: %s", err)
@@ -199,6 +283,13 @@ func resourceDomainConfigurationRead(ctx context.Context, d *schema.ResourceData
 	d.Set("server_certificate_arns", tfslices.ApplyToAll(output.ServerCertificates, func(v *iot.ServerCertificateSummary) string {
 		return aws.StringValue(v.ServerCertificateArn)
 	}))
+	if output.ServerCertificateConfig != nil {
+		if err := d.Set("server_certificate_config", []interface{}{flattenServerCertificateConfig(output.ServerCertificateConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting server_certificate_config: %s", err)
+		}
+	} else {
+		d.Set("server_certificate_config", nil)
+	}
 	d.Set("service_type", output.ServiceType)
 	d.Set(names.AttrStatus, output.DomainConfigurationStatus)
 	if output.TlsConfig != nil {
@@ -222,6 +313,14 @@ func resourceDomainConfigurationUpdate(ctx context.Context, d *schema.ResourceDa
 			DomainConfigurationName: aws.String(d.Id()),
 		}
 
+		if d.HasChange("application_protocol") {
+			input.ApplicationProtocol = aws.String(d.Get("application_protocol").(string))
+		}
+
+		if d.HasChange("authentication_type") {
+			input.AuthenticationType = aws.String(d.Get("authentication_type").(string))
+		}
+
 		if d.HasChange("authorizer_config") {
 			if v, ok := d.GetOk("authorizer_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.AuthorizerConfig = expandAuthorizerConfig(v.([]interface{})[0].(map[string]interface{}))
@@ -230,6 +329,16 @@ func resourceDomainConfigurationUpdate(ctx context.Context, d *schema.ResourceDa
 			}
 		}
 
+		if d.HasChange("server_certificate_config") {
+			if v, ok := d.GetOk("server_certificate_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ServerCertificateConfig = expandServerCertificateConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.ServerCertificateConfig = &iot.ServerCertificateConfig{
+					EnableOCSPCheck: aws.Bool(false),
+				}
+			}
+		}
+
 		if d.HasChange(names.AttrStatus) {
 			input.DomainConfigurationStatus = aws.String(d.Get(names.AttrStatus).(string))
 		}
@@ -329,6 +438,20 @@ func expandAuthorizerConfig(tfMap map[string]interface{}) *iot.AuthorizerConfig
 	return apiObject
 }
 
+func expandServerCertificateConfig(tfMap map[string]interface{}) *iot.ServerCertificateConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.ServerCertificateConfig{}
+
+	if v, ok := tfMap["enable_ocsp_check"].(bool); ok {
+		apiObject.EnableOCSPCheck = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
 func expandTlsConfig(tfMap map[string]interface{}) *iot.TlsConfig { // nosemgrep:ci.caps5-in-func-name
 	if tfMap == nil {
 		return nil
@@ -361,6 +484,20 @@ func flattenAuthorizerConfig(apiObject *iot.AuthorizerConfig) map[string]interfa
 	return tfMap
 }
 
+func flattenServerCertificateConfig(apiObject *iot.ServerCertificateConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EnableOCSPCheck; v != nil {
+		tfMap["enable_ocsp_check"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
 func flattenTlsConfig(apiObject *iot.TlsConfig) map[string]interface{} { // nosemgrep:ci.caps5-in-func-name
 	if apiObject == nil {
 		return nil
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -173,6 +174,53 @@ func TestAccIoTDomainConfiguration_update(t *testing.T) {
 	})
 }
 
+func TestAccIoTDomainConfiguration_authenticationType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	resourceName := "aws_iot_domain_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainConfigurationConfig_authenticationType(rName, rootDomain, domain, "MQTT_WSS", "AWS_X509", false),
+				ExpectError: regexache.MustCompile(`"authentication_type" "AWS_X509" is not supported when "application_protocol" is "MQTT_WSS"`),
+			},
+			{
+				Config: testAccDomainConfigurationConfig_authenticationType(rName, rootDomain, domain, "SECURE_MQTT", "CUSTOM_AUTH_X509", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "application_protocol", "SECURE_MQTT"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "CUSTOM_AUTH_X509"),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.0.enable_ocsp_check", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainConfigurationConfig_authenticationType(rName, rootDomain, domain, "HTTPS", "AWS_SIGV4", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "application_protocol", "HTTPS"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "AWS_SIGV4"),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.0.enable_ocsp_check", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIoTDomainConfiguration_awsManaged(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -344,6 +392,30 @@ resource "aws_iot_domain_configuration" "test" {
 `, rName, domain, securityPolicy, allowAuthorizerOverride))
 }
 
+func testAccDomainConfigurationConfig_authenticationType(rName, rootDomain, domain, applicationProtocol, authenticationType string, enableOCSPCheck bool) string {
+	return acctest.ConfigCompose(testAccAuthorizerConfig_basic(rName), testAccDomainConfigurationConfig_base(rootDomain, domain), fmt.Sprintf(`
+resource "aws_iot_domain_configuration" "test" {
+  depends_on = [aws_acm_certificate_validation.test]
+
+  application_protocol = %[3]q
+  authentication_type  = %[4]q
+
+  authorizer_config {
+    allow_authorizer_override = true
+    default_authorizer_name   = aws_iot_authorizer.test.name
+  }
+
+  name                    = %[1]q
+  domain_name             = %[2]q
+  server_certificate_arns = [aws_acm_certificate.test.arn]
+
+  server_certificate_config {
+    enable_ocsp_check = %[5]t
+  }
+}
+`, rName, domain, applicationProtocol, authenticationType, enableOCSPCheck))
+}
+
 func testAccDomainConfigurationConfig_awsManaged(rName string) string { // nosemgrep:ci.aws-in-func-name
 	return fmt.Sprintf(`
 resource "aws_iot_domain_configuration" "test" {
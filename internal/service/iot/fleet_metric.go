@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iot_fleet_metric", name="Fleet Metric")
+// @Tags(identifierAttribute="arn")
+func ResourceFleetMetric() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFleetMetricCreate,
+		ReadWithoutTimeout:   resourceFleetMetricRead,
+		UpdateWithoutTimeout: resourceFleetMetricUpdate,
+		DeleteWithoutTimeout: resourceFleetMetricDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation_field": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"aggregation_type": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iot.AggregationTypeName_Values(), false),
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"index_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"period": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.IntBetween(60, 86400),
+					validation.IntDivisibleBy(60),
+				),
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"query_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrUnit: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(iot.FleetMetricUnit_Values(), false),
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFleetMetricCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	name := d.Get("metric_name").(string)
+	input := &iot.CreateFleetMetricInput{
+		AggregationField: aws.String(d.Get("aggregation_field").(string)),
+		MetricName:       aws.String(name),
+		Period:           aws.Int64(int64(d.Get("period").(int))),
+		QueryString:      aws.String(d.Get("query_string").(string)),
+		Tags:             getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("aggregation_type"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AggregationType = expandAggregationType(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("index_name"); ok {
+		input.IndexName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("query_version"); ok {
+		input.QueryVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrUnit); ok {
+		input.Unit = aws.String(v.(string))
+	}
+
+	// The fleet index may still be building after indexing has been enabled.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateFleetMetricWithContext(ctx, input)
+	}, iot.ErrCodeIndexNotReadyException)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Fleet Metric (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*iot.CreateFleetMetricOutput).MetricName))
+
+	return append(diags, resourceFleetMetricRead(ctx, d, meta)...)
+}
+
+func resourceFleetMetricRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	output, err := FindFleetMetricByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Fleet Metric (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Fleet Metric (%s): %s", d.Id(), err)
+	}
+
+	d.Set("aggregation_field", output.AggregationField)
+	if output.AggregationType != nil {
+		if err := d.Set("aggregation_type", []interface{}{flattenAggregationType(output.AggregationType)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting aggregation_type: %s", err)
+		}
+	} else {
+		d.Set("aggregation_type", nil)
+	}
+	d.Set(names.AttrARN, output.MetricArn)
+	if output.CreationDate != nil {
+		d.Set(names.AttrCreationDate, aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationDate, nil)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("index_name", output.IndexName)
+	if output.LastModifiedDate != nil {
+		d.Set("last_modified_date", aws.TimeValue(output.LastModifiedDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_date", nil)
+	}
+	d.Set("metric_name", output.MetricName)
+	d.Set("period", output.Period)
+	d.Set("query_string", output.QueryString)
+	d.Set("query_version", output.QueryVersion)
+	d.Set(names.AttrUnit, output.Unit)
+	d.Set(names.AttrVersion, output.Version)
+
+	return diags
+}
+
+func resourceFleetMetricUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iot.UpdateFleetMetricInput{
+			AggregationField: aws.String(d.Get("aggregation_field").(string)),
+			Description:      aws.String(d.Get(names.AttrDescription).(string)),
+			ExpectedVersion:  aws.Int64(int64(d.Get(names.AttrVersion).(int))),
+			IndexName:        aws.String(d.Get("index_name").(string)),
+			MetricName:       aws.String(d.Id()),
+			Period:           aws.Int64(int64(d.Get("period").(int))),
+			QueryString:      aws.String(d.Get("query_string").(string)),
+		}
+
+		if v, ok := d.GetOk("aggregation_type"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AggregationType = expandAggregationType(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("query_version"); ok {
+			input.QueryVersion = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(names.AttrUnit); ok {
+			input.Unit = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateFleetMetricWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Fleet Metric (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFleetMetricRead(ctx, d, meta)...)
+}
+
+func resourceFleetMetricDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Fleet Metric: %s", d.Id())
+	_, err := conn.DeleteFleetMetricWithContext(ctx, &iot.DeleteFleetMetricInput{
+		MetricName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Fleet Metric (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindFleetMetricByName(ctx context.Context, conn *iot.IoT, name string) (*iot.DescribeFleetMetricOutput, error) {
+	input := &iot.DescribeFleetMetricInput{
+		MetricName: aws.String(name),
+	}
+
+	output, err := conn.DescribeFleetMetricWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandAggregationType(tfMap map[string]interface{}) *iot.AggregationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.AggregationType{}
+
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrValues].([]interface{}); ok && len(v) > 0 {
+		apiObject.Values = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func flattenAggregationType(apiObject *iot.AggregationType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap[names.AttrName] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Values; v != nil {
+		tfMap[names.AttrValues] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFleetMetric_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.DescribeFleetMetricOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_fleet_metric.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetMetricDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetMetricConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFleetMetricExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_field", "attributes.temperature"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.name", "Statistics"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.values.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.values.0", "average"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iot", fmt.Sprintf("fleetmetric/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "index_name", "AWS_Things"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_date"),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "thingName:*"),
+					resource.TestCheckResourceAttrSet(resourceName, "query_version"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrUnit, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFleetMetric_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.DescribeFleetMetricOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_fleet_metric.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetMetricDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetMetricConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetMetricExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiot.ResourceFleetMetric(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccFleetMetric_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.DescribeFleetMetricOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_fleet_metric.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetMetricDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetMetricConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetMetricExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetMetricConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetMetricExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccFleetMetricConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetMetricExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccFleetMetric_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.DescribeFleetMetricOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_fleet_metric.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetMetricDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetMetricConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetMetricExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccFleetMetricConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFleetMetricExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.name", "Percentiles"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.values.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "period", "300"),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "thingName:tf-acc-*"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrUnit, "None"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFleetMetricExists(ctx context.Context, n string, v *iot.DescribeFleetMetricOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		output, err := tfiot.FindFleetMetricByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFleetMetricDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iot_fleet_metric" {
+				continue
+			}
+
+			_, err := tfiot.FindFleetMetricByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Fleet Metric %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccFleetMetricConfig_base = `
+resource "aws_iot_indexing_configuration" "test" {
+  thing_indexing_configuration {
+    thing_indexing_mode = "REGISTRY"
+
+    custom_field {
+      name = "attributes.temperature"
+      type = "Number"
+    }
+  }
+}
+`
+
+func testAccFleetMetricConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFleetMetricConfig_base, fmt.Sprintf(`
+resource "aws_iot_fleet_metric" "test" {
+  metric_name       = %[1]q
+  aggregation_field = "attributes.temperature"
+  period            = 60
+  query_string      = "thingName:*"
+
+  aggregation_type {
+    name   = "Statistics"
+    values = ["average"]
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName))
+}
+
+func testAccFleetMetricConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccFleetMetricConfig_base, fmt.Sprintf(`
+resource "aws_iot_fleet_metric" "test" {
+  metric_name       = %[1]q
+  aggregation_field = "attributes.temperature"
+  description       = "updated"
+  period            = 300
+  query_string      = "thingName:tf-acc-*"
+  unit              = "None"
+
+  aggregation_type {
+    name   = "Percentiles"
+    values = ["50", "90"]
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName))
+}
+
+func testAccFleetMetricConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccFleetMetricConfig_base, fmt.Sprintf(`
+resource "aws_iot_fleet_metric" "test" {
+  metric_name       = %[1]q
+  aggregation_field = "attributes.temperature"
+  period            = 60
+  query_string      = "thingName:*"
+
+  aggregation_type {
+    name   = "Statistics"
+    values = ["average"]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccFleetMetricConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccFleetMetricConfig_base, fmt.Sprintf(`
+resource "aws_iot_fleet_metric" "test" {
+  metric_name       = %[1]q
+  aggregation_field = "attributes.temperature"
+  period            = 60
+  query_string      = "thingName:*"
+
+  aggregation_type {
+    name   = "Statistics"
+    values = ["average"]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
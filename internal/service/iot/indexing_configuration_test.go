@@ -14,12 +14,21 @@ import (
 func TestAccIoTIndexingConfiguration_serial(t *testing.T) {
 	t.Parallel()
 
-	testCases := map[string]func(t *testing.T){
-		acctest.CtBasic: testAccIndexingConfiguration_basic,
-		"allAttributes": testAccIndexingConfiguration_allAttributes,
+	// Fleet metrics depend on the account-wide fleet indexing configuration.
+	testCases := map[string]map[string]func(t *testing.T){
+		"IndexingConfiguration": {
+			acctest.CtBasic: testAccIndexingConfiguration_basic,
+			"allAttributes": testAccIndexingConfiguration_allAttributes,
+		},
+		"FleetMetric": {
+			acctest.CtBasic:      testAccFleetMetric_basic,
+			acctest.CtDisappears: testAccFleetMetric_disappears,
+			"tags":               testAccFleetMetric_tags,
+			"update":             testAccFleetMetric_update,
+		},
 	}
 
-	acctest.RunSerialTests1Level(t, testCases, 0)
+	acctest.RunSerialTests2Levels(t, testCases, 0)
 }
 
 func testAccIndexingConfiguration_basic(t *testing.T) {
@@ -79,6 +79,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iot_event_configurations",
 			Name:     "Event Configurations",
 		},
+		{
+			Factory:  ResourceFleetMetric,
+			TypeName: "aws_iot_fleet_metric",
+			Name:     "Fleet Metric",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceIndexingConfiguration,
 			TypeName: "aws_iot_indexing_configuration",
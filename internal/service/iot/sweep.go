@@ -19,7 +19,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
 		Name: "aws_iot_certificate",
 		F:    sweepCertificates,
 		Dependencies: []string{
@@ -28,12 +28,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
 		Name: "aws_iot_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -41,56 +41,61 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
 		Name: "aws_iot_role_alias",
 		F:    sweepRoleAliases,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
 		Name: "aws_iot_thing_principal_attachment",
 		F:    sweepThingPrincipalAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
 		Name:         "aws_iot_thing",
 		F:            sweepThings,
 		Dependencies: []string{"aws_iot_thing_principal_attachment"},
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepThingGroups,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
 		Name:         "aws_iot_thing_type",
 		F:            sweepThingTypes,
 		Dependencies: []string{"aws_iot_thing"},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
 		Name:         "aws_iot_topic_rule",
 		F:            sweepTopicRules,
 		Dependencies: []string{"aws_iot_topic_rule_destination"},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
 		Name: "aws_iot_topic_rule_destination",
 		F:    sweepTopicRuleDestinations,
 	})
 
-	resource.AddTestSweepers("aws_iot_authorizer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_authorizer", &resource.Sweeper{
 		Name:         "aws_iot_authorizer",
 		F:            sweepAuthorizers,
 		Dependencies: []string{"aws_iot_domain_configuration"},
 	})
 
-	resource.AddTestSweepers("aws_iot_domain_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_domain_configuration", &resource.Sweeper{
 		Name: "aws_iot_domain_configuration",
 		F:    sweepDomainConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_iot_ca_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_fleet_metric", &resource.Sweeper{
+		Name: "aws_iot_fleet_metric",
+		F:    sweepFleetMetrics,
+	})
+
+	sweep.AddTestSweepers("aws_iot_ca_certificate", &resource.Sweeper{
 		Name: "aws_iot_ca_certificate",
 		F:    sweepCACertificates,
 		Dependencies: []string{
@@ -748,3 +753,47 @@ func sweepCACertificates(region string) error {
 
 	return nil
 }
+
+func sweepFleetMetrics(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.IoTConn(ctx)
+	input := &iot.ListFleetMetricsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListFleetMetricsPagesWithContext(ctx, input, func(page *iot.ListFleetMetricsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FleetMetrics {
+			r := ResourceFleetMetric()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.MetricName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Fleet Metric sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Fleet Metrics (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Fleet Metrics (%s): %w", region, err)
+	}
+
+	return nil
+}
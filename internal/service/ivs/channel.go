@@ -6,6 +6,7 @@ package ivs
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ivs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -57,6 +59,11 @@ func ResourceChannel() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"insecure_ingest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"latency_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -69,10 +76,21 @@ func ResourceChannel() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]{0,128}$`), "must contain only alphanumeric characters, hyphen, or underscore and at most 128 characters"),
 			},
+			"playback_restriction_policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"playback_url": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"preset": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ivs.TranscodePreset_Values(), false),
+			},
 			"recording_configuration_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -89,7 +107,10 @@ func ResourceChannel() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceChannelCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -97,6 +118,19 @@ const (
 	ResNameChannel = "Channel"
 )
 
+func resourceChannelCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A transcode preset only applies to advanced channel types.
+	if v := diff.GetRawConfig().GetAttr("preset"); v.IsKnown() && !v.IsNull() && diff.NewValueKnown(names.AttrType) {
+		switch channelType := diff.Get(names.AttrType).(string); channelType {
+		case ivs.ChannelTypeAdvancedSd, ivs.ChannelTypeAdvancedHd:
+		default:
+			return fmt.Errorf("preset can only be set when type is %q or %q, got %q", ivs.ChannelTypeAdvancedSd, ivs.ChannelTypeAdvancedHd, channelType)
+		}
+	}
+
+	return nil
+}
+
 func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -110,6 +144,10 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta int
 		in.Authorized = aws.Bool(v.(bool))
 	}
 
+	if v, ok := d.GetOk("insecure_ingest"); ok {
+		in.InsecureIngest = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("latency_mode"); ok {
 		in.LatencyMode = aws.String(v.(string))
 	}
@@ -118,6 +156,14 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta int
 		in.Name = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("playback_restriction_policy_arn"); ok {
+		in.PlaybackRestrictionPolicyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preset"); ok {
+		in.Preset = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("recording_configuration_arn"); ok {
 		in.RecordingConfigurationArn = aws.String(v.(string))
 	}
@@ -164,9 +210,12 @@ func resourceChannelRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set(names.AttrARN, out.Arn)
 	d.Set("authorized", out.Authorized)
 	d.Set("ingest_endpoint", out.IngestEndpoint)
+	d.Set("insecure_ingest", out.InsecureIngest)
 	d.Set("latency_mode", out.LatencyMode)
 	d.Set(names.AttrName, out.Name)
+	d.Set("playback_restriction_policy_arn", out.PlaybackRestrictionPolicyArn)
 	d.Set("playback_url", out.PlaybackUrl)
+	d.Set("preset", out.Preset)
 	d.Set("recording_configuration_arn", out.RecordingConfigurationArn)
 	d.Set(names.AttrType, out.Type)
 
@@ -190,6 +239,11 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		update = true
 	}
 
+	if d.HasChanges("insecure_ingest") {
+		in.InsecureIngest = aws.Bool(d.Get("insecure_ingest").(bool))
+		update = true
+	}
+
 	if d.HasChanges("latency_mode") {
 		in.LatencyMode = aws.String(d.Get("latency_mode").(string))
 		update = true
@@ -200,6 +254,16 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		update = true
 	}
 
+	if d.HasChanges("playback_restriction_policy_arn") {
+		in.PlaybackRestrictionPolicyArn = aws.String(d.Get("playback_restriction_policy_arn").(string))
+		update = true
+	}
+
+	if d.HasChanges("preset") {
+		in.Preset = aws.String(d.Get("preset").(string))
+		update = true
+	}
+
 	if d.HasChanges("recording_configuration_arn") {
 		in.RecordingConfigurationArn = aws.String(d.Get("recording_configuration_arn").(string))
 		update = true
@@ -215,6 +215,110 @@ func TestAccIVSChannel_recordingConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccIVSChannel_playbackRestrictionPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ivs.Channel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_channel.test"
+	policyResourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccChannelPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_playbackRestrictionPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "playback_restriction_policy_arn", policyResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfig_noPlaybackRestrictionPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v2),
+					testAccCheckChannelNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "playback_restriction_policy_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSChannel_advanced(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ivs.Channel
+	resourceName := "aws_ivs_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccChannelPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_advanced(ivs.ChannelTypeAdvancedSd, ivs.TranscodePresetConstrainedBandwidthDelivery, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "insecure_ingest", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "preset", ivs.TranscodePresetConstrainedBandwidthDelivery),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, ivs.ChannelTypeAdvancedSd),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfig_advanced(ivs.ChannelTypeAdvancedHd, ivs.TranscodePresetHigherBandwidthDelivery, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v2),
+					testAccCheckChannelNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "insecure_ingest", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "preset", ivs.TranscodePresetHigherBandwidthDelivery),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, ivs.ChannelTypeAdvancedHd),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSChannel_presetRequiresAdvancedType(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccChannelConfig_advanced(ivs.ChannelTypeStandard, ivs.TranscodePresetHigherBandwidthDelivery, false),
+				ExpectError: regexache.MustCompile(`preset can only be set when type is "ADVANCED_SD" or "ADVANCED_HD"`),
+			},
+		},
+	})
+}
+
 func testAccCheckChannelDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn(ctx)
@@ -333,6 +437,43 @@ resource "aws_ivs_channel" "test" {
 `, bucketName)
 }
 
+func testAccChannelConfig_playbackRestrictionPolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  name              = %[1]q
+  allowed_countries = ["US"]
+}
+
+resource "aws_ivs_channel" "test" {
+  name                            = %[1]q
+  playback_restriction_policy_arn = aws_ivs_playback_restriction_policy.test.arn
+}
+`, rName)
+}
+
+func testAccChannelConfig_noPlaybackRestrictionPolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  name              = %[1]q
+  allowed_countries = ["US"]
+}
+
+resource "aws_ivs_channel" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccChannelConfig_advanced(channelType, preset string, insecureIngest bool) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_channel" "test" {
+  type            = %[1]q
+  preset          = %[2]q
+  insecure_ingest = %[3]t
+}
+`, channelType, preset, insecureIngest)
+}
+
 func testAccChannelConfig_tags1(tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ivs_channel" "test" {
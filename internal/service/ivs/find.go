@@ -36,6 +36,29 @@ func FindPlaybackKeyPairByID(ctx context.Context, conn *ivs.IVS, id string) (*iv
 	return out.KeyPair, nil
 }
 
+func FindPlaybackRestrictionPolicyByID(ctx context.Context, conn *ivs.IVS, id string) (*ivs.PlaybackRestrictionPolicy, error) {
+	in := &ivs.GetPlaybackRestrictionPolicyInput{
+		Arn: aws.String(id),
+	}
+	out, err := conn.GetPlaybackRestrictionPolicyWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, ivs.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.PlaybackRestrictionPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.PlaybackRestrictionPolicy, nil
+}
+
 func FindRecordingConfigurationByID(ctx context.Context, conn *ivs.IVS, id string) (*ivs.RecordingConfiguration, error) {
 	in := &ivs.GetRecordingConfigurationInput{
 		Arn: aws.String(id),
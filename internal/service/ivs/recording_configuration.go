@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -85,6 +86,32 @@ func ResourceRecordingConfiguration() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 300),
 			},
+			"rendition_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rendition_selection": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ivs.RenditionConfigurationRenditionSelection_Values(), false),
+						},
+						"renditions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(ivs.RenditionConfigurationRendition_Values(), false),
+							},
+						},
+					},
+				},
+			},
 			names.AttrState: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -105,6 +132,21 @@ func ResourceRecordingConfiguration() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: validation.StringInSlice(ivs.RecordingMode_Values(), false),
 						},
+						"resolution": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ivs.ThumbnailConfigurationResolution_Values(), false),
+						},
+						"storage": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(ivs.ThumbnailConfigurationStorage_Values(), false),
+							},
+						},
 						"target_interval_seconds": {
 							Type:         schema.TypeInt,
 							Optional:     true,
@@ -142,6 +184,14 @@ func resourceRecordingConfigurationCreate(ctx context.Context, d *schema.Resourc
 		in.RecordingReconnectWindowSeconds = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("rendition_configuration"); ok {
+		in.RenditionConfiguration = expandRenditionConfiguration(v.([]interface{}))
+
+		if aws.StringValue(in.RenditionConfiguration.RenditionSelection) != ivs.RenditionConfigurationRenditionSelectionCustom && len(in.RenditionConfiguration.Renditions) > 0 {
+			return sdkdiag.AppendErrorf(diags, "rendition configuration renditions can only be set if rendition_selection is \"CUSTOM\"")
+		}
+	}
+
 	if v, ok := d.GetOk("thumbnail_configuration"); ok {
 		in.ThumbnailConfiguration = expandThumbnailConfiguration(v.([]interface{}))
 
@@ -193,6 +243,11 @@ func resourceRecordingConfigurationRead(ctx context.Context, d *schema.ResourceD
 
 	d.Set(names.AttrName, out.Name)
 	d.Set("recording_reconnect_window_seconds", out.RecordingReconnectWindowSeconds)
+
+	if err := d.Set("rendition_configuration", flattenRenditionConfiguration(out.RenditionConfiguration)); err != nil {
+		return create.AppendDiagError(diags, names.IVS, create.ErrActionSetting, ResNameRecordingConfiguration, d.Id(), err)
+	}
+
 	d.Set(names.AttrState, out.State)
 
 	if err := d.Set("thumbnail_configuration", flattenThumbnailConfiguration(out.ThumbnailConfiguration)); err != nil {
@@ -267,6 +322,14 @@ func flattenThumbnailConfiguration(apiObject *ivs.ThumbnailConfiguration) []inte
 		m["recording_mode"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.Resolution; v != nil {
+		m["resolution"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Storage; v != nil {
+		m["storage"] = flex.FlattenStringSet(v)
+	}
+
 	if v := apiObject.TargetIntervalSeconds; v != nil {
 		m["target_interval_seconds"] = aws.Int64Value(v)
 	}
@@ -274,6 +337,24 @@ func flattenThumbnailConfiguration(apiObject *ivs.ThumbnailConfiguration) []inte
 	return []interface{}{m}
 }
 
+func flattenRenditionConfiguration(apiObject *ivs.RenditionConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.RenditionSelection; v != nil {
+		m["rendition_selection"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Renditions; v != nil {
+		m["renditions"] = flex.FlattenStringSet(v)
+	}
+
+	return []interface{}{m}
+}
+
 func expandDestinationConfiguration(vSettings []interface{}) *ivs.DestinationConfiguration {
 	if len(vSettings) == 0 || vSettings[0] == nil {
 		return nil
@@ -314,9 +395,35 @@ func expandThumbnailConfiguration(vSettings []interface{}) *ivs.ThumbnailConfigu
 		a.RecordingMode = aws.String(v)
 	}
 
+	if v, ok := tfMap["resolution"].(string); ok && v != "" {
+		a.Resolution = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage"].(*schema.Set); ok && v.Len() > 0 {
+		a.Storage = flex.ExpandStringSet(v)
+	}
+
 	if v, ok := tfMap["target_interval_seconds"].(int); ok {
 		a.TargetIntervalSeconds = aws.Int64(int64(v))
 	}
 
 	return a
 }
+
+func expandRenditionConfiguration(vSettings []interface{}) *ivs.RenditionConfiguration {
+	if len(vSettings) == 0 || vSettings[0] == nil {
+		return nil
+	}
+	a := &ivs.RenditionConfiguration{}
+	tfMap := vSettings[0].(map[string]interface{})
+
+	if v, ok := tfMap["rendition_selection"].(string); ok && v != "" {
+		a.RenditionSelection = aws.String(v)
+	}
+
+	if v, ok := tfMap["renditions"].(*schema.Set); ok && v.Len() > 0 {
+		a.Renditions = flex.ExpandStringSet(v)
+	}
+
+	return a
+}
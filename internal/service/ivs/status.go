@@ -63,8 +63,11 @@ func statusChannel(ctx context.Context, conn *ivs.IVS, arn string, updateDetails
 			return out, statusNormal, nil
 		} else {
 			if (updateDetails.Authorized != nil && aws.BoolValue(updateDetails.Authorized) == aws.BoolValue(out.Authorized)) ||
+				(updateDetails.InsecureIngest != nil && aws.BoolValue(updateDetails.InsecureIngest) == aws.BoolValue(out.InsecureIngest)) ||
 				(updateDetails.LatencyMode != nil && aws.StringValue(updateDetails.LatencyMode) == aws.StringValue(out.LatencyMode)) ||
 				(updateDetails.Name != nil && aws.StringValue(updateDetails.Name) == aws.StringValue(out.Name)) ||
+				(updateDetails.PlaybackRestrictionPolicyArn != nil && aws.StringValue(updateDetails.PlaybackRestrictionPolicyArn) == aws.StringValue(out.PlaybackRestrictionPolicyArn)) ||
+				(updateDetails.Preset != nil && aws.StringValue(updateDetails.Preset) == aws.StringValue(out.Preset)) ||
 				(updateDetails.RecordingConfigurationArn != nil && aws.StringValue(updateDetails.RecordingConfigurationArn) == aws.StringValue(out.RecordingConfigurationArn)) ||
 				(updateDetails.Type != nil && aws.StringValue(updateDetails.Type) == aws.StringValue(out.Type)) {
 				return out, statusUpdated, nil
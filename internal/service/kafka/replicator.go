@@ -159,6 +159,24 @@ func resourceReplicator() *schema.Resource {
 										Optional: true,
 										Default:  true,
 									},
+									"starting_position": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrType: {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.ReplicationStartingPositionType](),
+												},
+											},
+										},
+									},
 									"topics_to_exclude": {
 										Type:     schema.TypeSet,
 										Optional: true,
@@ -567,6 +585,22 @@ func flattenTopicReplication(apiObject *types.TopicReplication) map[string]inter
 		tfMap["detect_and_copy_new_topics"] = apiObject.DetectAndCopyNewTopics
 	}
 
+	if v := apiObject.StartingPosition; v != nil {
+		tfMap["starting_position"] = []interface{}{flattenReplicationStartingPosition(v)}
+	}
+
+	return tfMap
+}
+
+func flattenReplicationStartingPosition(apiObject *types.ReplicationStartingPosition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrType: apiObject.Type,
+	}
+
 	return tfMap
 }
 
@@ -769,6 +803,20 @@ func expandTopicReplication(tfMap map[string]interface{}) *types.TopicReplicatio
 		apiObject.DetectAndCopyNewTopics = aws.Bool(v)
 	}
 
+	if v, ok := tfMap["starting_position"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StartingPosition = expandReplicationStartingPosition(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandReplicationStartingPosition(tfMap map[string]interface{}) *types.ReplicationStartingPosition {
+	apiObject := &types.ReplicationStartingPosition{}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = types.ReplicationStartingPositionType(v)
+	}
+
 	return apiObject
 }
 
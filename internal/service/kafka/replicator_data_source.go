@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kafka
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_msk_replicator", name="Replicator")
+// @Tags
+func dataSourceReplicator() *schema.Resource {
+	resourceSchema := resourceReplicator().Schema
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReplicatorRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{names.AttrARN, "replicator_name"},
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kafka_cluster":         sdkv2.DataSourcePropertyFromResourceProperty(resourceSchema["kafka_cluster"]),
+			"replication_info_list": sdkv2.DataSourcePropertyFromResourceProperty(resourceSchema["replication_info_list"]),
+			"replicator_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"service_execution_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceReplicatorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KafkaClient(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+
+	if arn == "" {
+		name := d.Get("replicator_name").(string)
+		input := &kafka.ListReplicatorsInput{
+			ReplicatorNameFilter: aws.String(name),
+		}
+		replicator, err := findReplicator(ctx, conn, input, func(v *types.ReplicatorSummary) bool {
+			return aws.ToString(v.ReplicatorName) == name
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading MSK Replicator (%s): %s", name, err)
+		}
+
+		arn = aws.ToString(replicator.ReplicatorArn)
+	}
+
+	output, err := findReplicatorByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MSK Replicator (%s): %s", arn, err)
+	}
+
+	sourceAlias := aws.ToString(output.ReplicationInfoList[0].SourceKafkaClusterAlias)
+	targetAlias := aws.ToString(output.ReplicationInfoList[0].TargetKafkaClusterAlias)
+	var sourceARN, targetARN *string
+
+	for _, cluster := range output.KafkaClusters {
+		if clusterAlias := aws.ToString(cluster.KafkaClusterAlias); clusterAlias == sourceAlias {
+			sourceARN = cluster.AmazonMskCluster.MskClusterArn
+		} else if clusterAlias == targetAlias {
+			targetARN = cluster.AmazonMskCluster.MskClusterArn
+		}
+	}
+
+	d.SetId(aws.ToString(output.ReplicatorArn))
+	d.Set(names.AttrARN, output.ReplicatorArn)
+	d.Set("current_version", output.CurrentVersion)
+	d.Set(names.AttrDescription, output.ReplicatorDescription)
+	if err := d.Set("kafka_cluster", flattenKafkaClusterDescriptions(output.KafkaClusters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting kafka_cluster: %s", err)
+	}
+	if err := d.Set("replication_info_list", flattenReplicationInfoDescriptions(output.ReplicationInfoList, sourceARN, targetARN)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting replication_info_list: %s", err)
+	}
+	d.Set("replicator_name", output.ReplicatorName)
+	d.Set("service_execution_role_arn", output.ServiceExecutionRoleArn)
+	d.Set(names.AttrState, output.ReplicatorState)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func findReplicator(ctx context.Context, conn *kafka.Client, input *kafka.ListReplicatorsInput, filter tfslices.Predicate[*types.ReplicatorSummary]) (*types.ReplicatorSummary, error) {
+	output, err := findReplicators(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findReplicators(ctx context.Context, conn *kafka.Client, input *kafka.ListReplicatorsInput, filter tfslices.Predicate[*types.ReplicatorSummary]) ([]types.ReplicatorSummary, error) {
+	var output []types.ReplicatorSummary
+
+	pages := kafka.NewListReplicatorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Replicators {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
@@ -6,6 +6,7 @@ package kafkaconnect
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -134,10 +135,11 @@ func ResourceConnector() *schema.Resource {
 				},
 			},
 			"connector_configuration": {
-				Type:     schema.TypeMap,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeMap,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentConnectorConfigurationValue,
 			},
 			names.AttrDescription: {
 				Type:         schema.TypeString,
@@ -513,23 +515,25 @@ func resourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	conn := meta.(*conns.AWSClient).KafkaConnectConn(ctx)
 
-	input := &kafkaconnect.UpdateConnectorInput{
-		Capacity:       expandCapacityUpdate(d.Get("capacity").([]interface{})[0].(map[string]interface{})),
-		ConnectorArn:   aws.String(d.Id()),
-		CurrentVersion: aws.String(d.Get(names.AttrVersion).(string)),
-	}
+	if d.HasChange("capacity") {
+		input := &kafkaconnect.UpdateConnectorInput{
+			Capacity:       expandCapacityUpdate(d.Get("capacity").([]interface{})[0].(map[string]interface{})),
+			ConnectorArn:   aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get(names.AttrVersion).(string)),
+		}
 
-	log.Printf("[DEBUG] Updating MSK Connect Connector: %s", input)
-	_, err := conn.UpdateConnectorWithContext(ctx, input)
+		log.Printf("[DEBUG] Updating MSK Connect Connector: %s", input)
+		_, err := conn.UpdateConnectorWithContext(ctx, input)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating MSK Connect Connector (%s): %s", d.Id(), err)
-	}
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MSK Connect Connector (%s): %s", d.Id(), err)
+		}
 
-	_, err = waitConnectorUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		_, err = waitConnectorUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for MSK Connect Connector (%s) update: %s", d.Id(), err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MSK Connect Connector (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceConnectorRead(ctx, d, meta)...)
@@ -562,6 +566,35 @@ func resourceConnectorDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// suppressEquivalentConnectorConfigurationValue suppresses differences in connector configuration values
+// that Kafka Connect treats as equivalent: surrounding whitespace, the case of boolean values and
+// whitespace around the elements of comma-separated lists (e.g. "topics").
+func suppressEquivalentConnectorConfigurationValue(k, old, new string, d *schema.ResourceData) bool {
+	old, new = strings.TrimSpace(old), strings.TrimSpace(new)
+
+	if old == new {
+		return true
+	}
+
+	if strings.EqualFold(old, new) && (strings.EqualFold(old, "true") || strings.EqualFold(old, "false")) {
+		return true
+	}
+
+	oldParts, newParts := strings.Split(old, ","), strings.Split(new, ",")
+
+	if len(oldParts) == 1 || len(oldParts) != len(newParts) {
+		return false
+	}
+
+	for i := range oldParts {
+		if strings.TrimSpace(oldParts[i]) != strings.TrimSpace(newParts[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func expandCapacity(tfMap map[string]interface{}) *kafkaconnect.Capacity {
 	if tfMap == nil {
 		return nil
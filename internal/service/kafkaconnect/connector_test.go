@@ -77,6 +77,10 @@ func TestAccKafkaConnectConnector_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config:   testAccConnectorConfig_topics(rName, " t1 "),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -415,6 +419,10 @@ resource "aws_msk_cluster" "test" {
 }
 
 func testAccConnectorConfig_basic(rName string) string {
+	return testAccConnectorConfig_topics(rName, "t1")
+}
+
+func testAccConnectorConfig_topics(rName, topics string) string {
 	return acctest.ConfigCompose(
 		testAccCustomPluginConfig_basic(rName),
 		testAccConnectorBaseConfig(rName),
@@ -434,7 +442,7 @@ resource "aws_mskconnect_connector" "test" {
   connector_configuration = {
     "connector.class" = "com.github.jcustenborder.kafka.connect.simulator.SimulatorSinkConnector"
     "tasks.max"       = "1"
-    "topics"          = "t1"
+    "topics"          = %[2]q
   }
 
   kafka_cluster {
@@ -467,7 +475,7 @@ resource "aws_mskconnect_connector" "test" {
 
   depends_on = [aws_iam_role_policy.test, aws_vpc_endpoint.test]
 }
-`, rName))
+`, rName, topics))
 }
 
 func testAccConnectorConfig_allAttributes(rName string) string {
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/kendra/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kendra_featured_results_set", name="Featured Results Set")
+// @Tags(identifierAttribute="arn")
+func ResourceFeaturedResultsSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFeaturedResultsSetCreate,
+		ReadWithoutTimeout:   resourceFeaturedResultsSetRead,
+		UpdateWithoutTimeout: resourceFeaturedResultsSetUpdate,
+		DeleteWithoutTimeout: resourceFeaturedResultsSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"featured_document_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 4,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				},
+			},
+			"featured_results_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"query_texts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 49,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1000),
+				},
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.FeaturedResultsSetStatus](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFeaturedResultsSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	indexId := d.Get("index_id").(string)
+	input := &kendra.CreateFeaturedResultsSetInput{
+		ClientToken:            aws.String(id.UniqueId()),
+		FeaturedResultsSetName: aws.String(name),
+		IndexId:                aws.String(indexId),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("featured_document_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.FeaturedDocuments = expandFeaturedDocuments(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("query_texts"); ok && v.(*schema.Set).Len() > 0 {
+		input.QueryTexts = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Status = types.FeaturedResultsSetStatus(v.(string))
+	}
+
+	output, err := conn.CreateFeaturedResultsSet(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Kendra Featured Results Set (%s): %s", name, err)
+	}
+
+	if output == nil || output.FeaturedResultsSet == nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Kendra Featured Results Set (%s): empty output", name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", aws.ToString(output.FeaturedResultsSet.FeaturedResultsSetId), indexId))
+
+	return append(diags, resourceFeaturedResultsSetRead(ctx, d, meta)...)
+}
+
+func resourceFeaturedResultsSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	out, err := FindFeaturedResultsSetByID(ctx, conn, id, indexId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Featured Results Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Service:   "kendra",
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/featured-results-set/%s", indexId, id),
+	}.String()
+
+	var featuredDocumentIDs []string
+	for _, v := range out.FeaturedDocumentsWithMetadata {
+		featuredDocumentIDs = append(featuredDocumentIDs, aws.ToString(v.Id))
+	}
+	for _, v := range out.FeaturedDocumentsMissing {
+		featuredDocumentIDs = append(featuredDocumentIDs, aws.ToString(v.Id))
+	}
+
+	d.Set(names.AttrARN, arn)
+	if out.CreationTimestamp != nil {
+		d.Set(names.AttrCreatedAt, time.UnixMilli(aws.ToInt64(out.CreationTimestamp)).Format(time.RFC3339))
+	}
+	d.Set(names.AttrDescription, out.Description)
+	d.Set("featured_document_ids", featuredDocumentIDs)
+	d.Set("featured_results_set_id", out.FeaturedResultsSetId)
+	d.Set("index_id", indexId)
+	d.Set(names.AttrName, out.FeaturedResultsSetName)
+	d.Set("query_texts", out.QueryTexts)
+	d.Set(names.AttrStatus, out.Status)
+	if out.LastUpdatedTimestamp != nil {
+		d.Set("updated_at", time.UnixMilli(aws.ToInt64(out.LastUpdatedTimestamp)).Format(time.RFC3339))
+	}
+
+	return diags
+}
+
+func resourceFeaturedResultsSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &kendra.UpdateFeaturedResultsSetInput{
+			FeaturedResultsSetId:   aws.String(id),
+			FeaturedResultsSetName: aws.String(d.Get(names.AttrName).(string)),
+			IndexId:                aws.String(indexId),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("featured_document_ids") {
+			input.FeaturedDocuments = expandFeaturedDocuments(d.Get("featured_document_ids").(*schema.Set).List())
+		}
+
+		if d.HasChange("query_texts") {
+			input.QueryTexts = flex.ExpandStringValueSet(d.Get("query_texts").(*schema.Set))
+			if input.QueryTexts == nil {
+				input.QueryTexts = []string{}
+			}
+		}
+
+		if d.HasChange(names.AttrStatus) {
+			input.Status = types.FeaturedResultsSetStatus(d.Get(names.AttrStatus).(string))
+		}
+
+		_, err = conn.UpdateFeaturedResultsSet(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Kendra Featured Results Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFeaturedResultsSetRead(ctx, d, meta)...)
+}
+
+func resourceFeaturedResultsSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	log.Printf("[INFO] Deleting Kendra Featured Results Set %s", d.Id())
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := conn.BatchDeleteFeaturedResultsSet(ctx, &kendra.BatchDeleteFeaturedResultsSetInput{
+		FeaturedResultsSetIds: []string{id},
+		IndexId:               aws.String(indexId),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return diags
+	}
+
+	if err == nil && output != nil && len(output.Errors) > 0 {
+		err = errors.New(aws.ToString(output.Errors[0].ErrorMessage))
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandFeaturedDocuments(tfList []interface{}) []types.FeaturedDocument {
+	apiObjects := []types.FeaturedDocument{}
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, types.FeaturedDocument{
+			Id: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -116,6 +117,16 @@ func resourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	err = FindAssociation(ctx, conn, resourceARN, licenseConfigurationARN)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading License Manager Association (%s): %s", d.Id(), err)
+	}
+
 	input := &licensemanager.UpdateLicenseSpecificationsForResourceInput{
 		RemoveLicenseSpecifications: []*licensemanager.LicenseSpecification{{
 			LicenseConfigurationArn: aws.String(licenseConfigurationARN),
@@ -123,8 +134,13 @@ func resourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta
 		ResourceArn: aws.String(resourceARN),
 	}
 
+	log.Printf("[DEBUG] Deleting License Manager Association: %s", d.Id())
 	_, err = conn.UpdateLicenseSpecificationsForResourceWithContext(ctx, input)
 
+	if tfawserr.ErrCodeEquals(err, licensemanager.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting License Manager Association (%s): %s", d.Id(), err)
 	}
@@ -152,6 +168,13 @@ func FindAssociation(ctx context.Context, conn *licensemanager.LicenseManager, r
 		return !lastPage
 	})
 
+	if tfawserr.ErrCodeEquals(err, licensemanager.ErrCodeInvalidParameterValueException, licensemanager.ErrCodeResourceNotFoundException) {
+		return &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
 	if err != nil {
 		return err
 	}
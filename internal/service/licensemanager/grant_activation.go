@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResGrantActivation = "Grant Activation"
+)
+
+// @SDKResource("aws_licensemanager_grant_activation", name="Grant Activation")
+func ResourceGrantActivation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGrantActivationCreate,
+		ReadWithoutTimeout:   resourceGrantActivationRead,
+		UpdateWithoutTimeout: resourceGrantActivationUpdate,
+		DeleteWithoutTimeout: resourceGrantActivationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activation_override_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(licensemanager.ActivationOverrideBehavior_Values(), false),
+				Description:  "Override behavior applied when activating the grant. Valid values are `ALL_GRANTS_PERMITTED_BY_ISSUER` and `DISTRIBUTED_GRANTS_ONLY`.",
+			},
+			"allowed_operations": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Allowed operations for the grant.",
+			},
+			"grant_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				Description:  "Amazon Resource Name (ARN) of the received grant.",
+			},
+			"home_region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Home Region of the grant.",
+			},
+			"license_arn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "License ARN.",
+			},
+			names.AttrName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the grant.",
+			},
+			"parent_arn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Parent ARN.",
+			},
+			names.AttrPrincipal: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The grantee principal ARN.",
+			},
+			names.AttrStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Grant status.",
+			},
+			names.AttrVersion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Grant version.",
+			},
+		},
+	}
+}
+
+func resourceGrantActivationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	grantARN := d.Get("grant_arn").(string)
+	grant, err := findReceivedGrantByARN(ctx, conn, grantARN)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionCreating, ResGrantActivation, grantARN, err)
+	}
+
+	// A received grant must be accepted before it can be activated.
+	if aws.StringValue(grant.GrantStatus) == licensemanager.GrantStatusPendingAccept {
+		_, err := conn.AcceptGrantWithContext(ctx, &licensemanager.AcceptGrantInput{
+			GrantArn: aws.String(grantARN),
+		})
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionCreating, ResGrantActivation, grantARN, err)
+		}
+
+		grant, err = waitReceivedGrantStatus(ctx, conn, grantARN, []string{licensemanager.GrantStatusPendingAccept}, []string{licensemanager.GrantStatusActive, licensemanager.GrantStatusDisabled}, d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionWaitingForCreation, ResGrantActivation, grantARN, err)
+		}
+	}
+
+	if aws.StringValue(grant.GrantStatus) != licensemanager.GrantStatusActive || d.Get("activation_override_behavior").(string) != "" {
+		if err := activateGrant(ctx, conn, grantARN, aws.StringValue(grant.Version), d.Get("activation_override_behavior").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionCreating, ResGrantActivation, grantARN, err)
+		}
+	}
+
+	d.SetId(grantARN)
+
+	return append(diags, resourceGrantActivationRead(ctx, d, meta)...)
+}
+
+func resourceGrantActivationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	out, err := FindGrantActivationByGrantARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.LicenseManager, create.ErrActionReading, ResGrantActivation, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionReading, ResGrantActivation, d.Id(), err)
+	}
+
+	if out.Options != nil {
+		d.Set("activation_override_behavior", out.Options.ActivationOverrideBehavior)
+	}
+	d.Set("allowed_operations", out.GrantedOperations)
+	d.Set("grant_arn", out.GrantArn)
+	d.Set("home_region", out.HomeRegion)
+	d.Set("license_arn", out.LicenseArn)
+	d.Set(names.AttrName, out.GrantName)
+	d.Set("parent_arn", out.ParentArn)
+	d.Set(names.AttrPrincipal, out.GranteePrincipalArn)
+	d.Set(names.AttrStatus, out.GrantStatus)
+	d.Set(names.AttrVersion, out.Version)
+
+	return diags
+}
+
+func resourceGrantActivationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	if d.HasChange("activation_override_behavior") {
+		if err := activateGrant(ctx, conn, d.Id(), d.Get(names.AttrVersion).(string), d.Get("activation_override_behavior").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionUpdating, ResGrantActivation, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceGrantActivationRead(ctx, d, meta)...)
+}
+
+func resourceGrantActivationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	out, err := FindGrantActivationByGrantARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionReading, ResGrantActivation, d.Id(), err)
+	}
+
+	// Deactivating a grant leaves it accepted so that it can be activated again.
+	in := &licensemanager.CreateGrantVersionInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		GrantArn:      aws.String(d.Id()),
+		SourceVersion: out.Version,
+		Status:        aws.String(licensemanager.GrantStatusDisabled),
+	}
+
+	_, err = conn.CreateGrantVersionWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, licensemanager.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionDeleting, ResGrantActivation, d.Id(), err)
+	}
+
+	if _, err := waitReceivedGrantStatus(ctx, conn, d.Id(), []string{licensemanager.GrantStatusActive, licensemanager.GrantStatusPendingWorkflow}, []string{licensemanager.GrantStatusDisabled}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionWaitingForDeletion, ResGrantActivation, d.Id(), err)
+	}
+
+	return diags
+}
+
+func activateGrant(ctx context.Context, conn *licensemanager.LicenseManager, grantARN, sourceVersion, overrideBehavior string, timeout time.Duration) error {
+	in := &licensemanager.CreateGrantVersionInput{
+		ClientToken: aws.String(id.UniqueId()),
+		GrantArn:    aws.String(grantARN),
+		Status:      aws.String(licensemanager.GrantStatusActive),
+	}
+
+	if sourceVersion != "" {
+		in.SourceVersion = aws.String(sourceVersion)
+	}
+
+	if overrideBehavior != "" {
+		in.Options = &licensemanager.Options{
+			ActivationOverrideBehavior: aws.String(overrideBehavior),
+		}
+	}
+
+	if _, err := conn.CreateGrantVersionWithContext(ctx, in); err != nil {
+		return err
+	}
+
+	_, err := waitReceivedGrantStatus(ctx, conn, grantARN, []string{licensemanager.GrantStatusDisabled, licensemanager.GrantStatusPendingWorkflow, licensemanager.GrantStatusActive}, []string{licensemanager.GrantStatusActive}, timeout)
+
+	return err
+}
+
+// FindGrantActivationByGrantARN returns the received grant only while it is active.
+func FindGrantActivationByGrantARN(ctx context.Context, conn *licensemanager.LicenseManager, arn string) (*licensemanager.Grant, error) {
+	grant, err := findReceivedGrantByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(grant.GrantStatus); status != licensemanager.GrantStatusActive {
+		return nil, &retry.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return grant, nil
+}
+
+func findReceivedGrantByARN(ctx context.Context, conn *licensemanager.LicenseManager, arn string) (*licensemanager.Grant, error) {
+	in := &licensemanager.ListReceivedGrantsInput{
+		GrantArns: aws.StringSlice([]string{arn}),
+	}
+
+	out, err := conn.ListReceivedGrantsWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, licensemanager.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out != nil {
+		for _, grant := range out.Grants {
+			if arn != aws.StringValue(grant.GrantArn) {
+				continue
+			}
+
+			switch aws.StringValue(grant.GrantStatus) {
+			case licensemanager.GrantStatusDeleted, licensemanager.GrantStatusRejected:
+				return nil, &retry.NotFoundError{
+					Message:     aws.StringValue(grant.GrantStatus),
+					LastRequest: in,
+				}
+			}
+
+			return grant, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
+func statusReceivedGrant(ctx context.Context, conn *licensemanager.LicenseManager, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReceivedGrantByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.GrantStatus), nil
+	}
+}
+
+func waitReceivedGrantStatus(ctx context.Context, conn *licensemanager.LicenseManager, arn string, pending, target []string, timeout time.Duration) (*licensemanager.Grant, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: statusReceivedGrant(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*licensemanager.Grant); ok {
+		if output.StatusReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
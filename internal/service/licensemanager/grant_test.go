@@ -45,6 +45,10 @@ func TestAccLicenseManagerGrant_serial(t *testing.T) {
 			acctest.CtBasic:      testAccGrantAccepter_basic,
 			acctest.CtDisappears: testAccGrantAccepter_disappears,
 		},
+		"grant_activation": {
+			acctest.CtBasic:      testAccGrantActivation_basic,
+			acctest.CtDisappears: testAccGrantActivation_disappears,
+		},
 		"grant_data_source": {
 			acctest.CtBasic: testAccGrantsDataSource_basic,
 			"empty":         testAccGrantsDataSource_noMatch,
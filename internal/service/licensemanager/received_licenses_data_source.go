@@ -28,6 +28,10 @@ func DataSourceReceivedLicenses() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			names.AttrFilter: DataSourceFiltersSchema(),
+			"product_sku": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -42,6 +46,13 @@ func dataSourceReceivedLicensesRead(ctx context.Context, d *schema.ResourceData,
 		d.Get(names.AttrFilter).(*schema.Set),
 	)
 
+	if v, ok := d.GetOk("product_sku"); ok {
+		in.Filters = append(in.Filters, &licensemanager.Filter{
+			Name:   aws.String("ProductSKU"),
+			Values: aws.StringSlice([]string{v.(string)}),
+		})
+	}
+
 	if len(in.Filters) == 0 {
 		in.Filters = nil
 	}
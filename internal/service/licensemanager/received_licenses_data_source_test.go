@@ -33,6 +33,27 @@ func TestAccLicenseManagerReceivedLicensesDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccLicenseManagerReceivedLicensesDataSource_productSKU(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_licensemanager_received_licenses.test"
+	licenseARN := envvar.SkipIfEmpty(t, licenseARNKey, envVarLicenseARNKeyError)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceivedLicensesDataSourceConfig_productSKU(licenseARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "arns.*", licenseARN),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLicenseManagerReceivedLicensesDataSource_empty(t *testing.T) {
 	ctx := acctest.Context(t)
 	datasourceName := "data.aws_licensemanager_received_licenses.test"
@@ -69,6 +90,18 @@ data "aws_licensemanager_received_license" "test" {
 `, licenseARN)
 }
 
+func testAccReceivedLicensesDataSourceConfig_productSKU(licenseARN string) string {
+	return fmt.Sprintf(`
+data "aws_licensemanager_received_licenses" "test" {
+  product_sku = data.aws_licensemanager_received_license.test.product_sku
+}
+
+data "aws_licensemanager_received_license" "test" {
+  license_arn = %[1]q
+}
+`, licenseARN)
+}
+
 func testAccReceivedLicensesDataSourceConfig_empty() string {
 	return `
 data "aws_licensemanager_received_licenses" "test" {
@@ -56,6 +56,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGrantAccepter,
 			TypeName: "aws_licensemanager_grant_accepter",
 		},
+		{
+			Factory:  ResourceGrantActivation,
+			TypeName: "aws_licensemanager_grant_activation",
+			Name:     "Grant Activation",
+		},
 		{
 			Factory:  ResourceLicenseConfiguration,
 			TypeName: "aws_licensemanager_license_configuration",
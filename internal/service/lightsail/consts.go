@@ -21,3 +21,15 @@ const (
 	ResLoadBalancerStickinessPolicy       = "Load Balancer StickinessPolicy"
 	ResLoadBalancerHTTPSRedirectionPolicy = "Load Balancer HTTPS Redirection Policy"
 )
+
+const (
+	databaseParameterApplyMethodImmediate     = "immediate"
+	databaseParameterApplyMethodPendingReboot = "pending-reboot"
+)
+
+func databaseParameterApplyMethod_Values() []string {
+	return []string{
+		databaseParameterApplyMethodImmediate,
+		databaseParameterApplyMethodPendingReboot,
+	}
+}
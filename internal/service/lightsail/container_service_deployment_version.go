@@ -42,10 +42,12 @@ func ResourceContainerServiceDeploymentVersion() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"container": {
-				Type:     schema.TypeSet,
-				Required: true,
-				ForceNew: true,
-				MaxItems: 53,
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				MaxItems:     53,
+				ExactlyOneOf: []string{"container", "source_version"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"container_name": {
@@ -89,10 +91,11 @@ func ResourceContainerServiceDeploymentVersion() *schema.Resource {
 				Computed: true,
 			},
 			"public_endpoint": {
-				Type:     schema.TypeList,
-				Optional: true,
-				ForceNew: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"source_version"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"container_name": {
@@ -161,6 +164,12 @@ func ResourceContainerServiceDeploymentVersion() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"source_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			names.AttrState: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -191,6 +200,19 @@ func resourceContainerServiceDeploymentVersionCreate(ctx context.Context, d *sch
 		input.PublicEndpoint = expandContainerServiceDeploymentPublicEndpoint(v.([]interface{}))
 	}
 
+	// Roll back by redeploying the configuration of an earlier deployment version.
+	if v, ok := d.GetOk("source_version"); ok {
+		sourceVersion := v.(int)
+		deployment, err := FindContainerServiceDeploymentByVersion(ctx, conn, serviceName, sourceVersion)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lightsail Container Service (%s) Deployment Version (%d): %s", serviceName, sourceVersion, err)
+		}
+
+		input.Containers = deployment.Containers
+		input.PublicEndpoint = containerServiceEndpointToEndpointRequest(deployment.PublicEndpoint)
+	}
+
 	output, err := conn.CreateContainerServiceDeployment(ctx, &input)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Lightsail Container Service (%s) Deployment Version: %s", serviceName, err)
@@ -242,8 +264,11 @@ func resourceContainerServiceDeploymentVersionRead(ctx context.Context, d *schem
 		return sdkdiag.AppendErrorf(diags, "setting container for Lightsail Container Service (%s) Deployment Version (%d): %s", serviceName, version, err)
 	}
 
-	if err := d.Set("public_endpoint", flattenContainerServiceDeploymentPublicEndpoint(deployment.PublicEndpoint)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting public_endpoint for Lightsail Container Service (%s) Deployment Version (%d): %s", serviceName, version, err)
+	// A deployment rolled back from a source version takes its public endpoint from that version.
+	if _, ok := d.GetOk("source_version"); !ok {
+		if err := d.Set("public_endpoint", flattenContainerServiceDeploymentPublicEndpoint(deployment.PublicEndpoint)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting public_endpoint for Lightsail Container Service (%s) Deployment Version (%d): %s", serviceName, version, err)
+		}
 	}
 
 	return diags
@@ -373,6 +398,18 @@ func expandContainerServiceDeploymentPublicEndpointHealthCheck(tfList []interfac
 	return healthCheck
 }
 
+func containerServiceEndpointToEndpointRequest(apiObject *types.ContainerServiceEndpoint) *types.EndpointRequest {
+	if apiObject == nil {
+		return nil
+	}
+
+	return &types.EndpointRequest{
+		ContainerName: apiObject.ContainerName,
+		ContainerPort: apiObject.ContainerPort,
+		HealthCheck:   apiObject.HealthCheck,
+	}
+}
+
 func flattenContainerServiceDeploymentContainers(containers map[string]types.Container) []interface{} {
 	if len(containers) == 0 {
 		return nil
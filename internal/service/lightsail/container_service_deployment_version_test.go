@@ -483,6 +483,44 @@ func TestAccLightsailContainerServiceDeploymentVersion_Container_enableService(t
 	})
 }
 
+func TestAccLightsailContainerServiceDeploymentVersion_sourceVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	containerName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lightsail_container_service_deployment_version.rollback"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(lightsail.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceDeploymentVersionConfig_sourceVersion(rName, containerName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerServiceDeploymentVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.ContainerServiceDeploymentStateActive)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "source_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "container.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "container.0.container_name", containerName),
+					resource.TestCheckResourceAttr(resourceName, "container.0.image", helloWorldImage),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"public_endpoint", "source_version"},
+			},
+		},
+	})
+}
+
 func testAccCheckContainerServiceDeploymentVersionExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -725,3 +763,36 @@ resource "aws_lightsail_container_service_deployment_version" "test" {
 }
 `, rName, isDisabled, containerName)
 }
+
+func testAccContainerServiceDeploymentVersionConfig_sourceVersion(rName, containerName string) string {
+	return acctest.ConfigCompose(
+		testAccContainerServiceDeploymentVersionBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_lightsail_container_service_deployment_version" "test" {
+  container {
+    container_name = %[1]q
+    image          = %[2]q
+  }
+
+  service_name = aws_lightsail_container_service.test.name
+}
+
+resource "aws_lightsail_container_service_deployment_version" "update" {
+  container {
+    container_name = %[1]q
+    image          = %[3]q
+  }
+
+  service_name = aws_lightsail_container_service.test.name
+
+  depends_on = [aws_lightsail_container_service_deployment_version.test]
+}
+
+resource "aws_lightsail_container_service_deployment_version" "rollback" {
+  service_name   = aws_lightsail_container_service.test.name
+  source_version = aws_lightsail_container_service_deployment_version.test.version
+
+  depends_on = [aws_lightsail_container_service_deployment_version.update]
+}
+`, containerName, helloWorldImage, redisImage))
+}
@@ -141,6 +141,28 @@ func ResourceDatabase() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "Subsequent characters can be letters, underscores, or digits (0- 9)"),
 				),
 			},
+			names.AttrParameter: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      databaseParameterApplyMethodImmediate,
+							ValidateFunc: validation.StringInSlice(databaseParameterApplyMethod_Values(), false),
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"preferred_backup_window": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -271,6 +293,14 @@ func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "waiting for Lightsail Relational Database (%s) to become available: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk(names.AttrParameter); ok && v.(*schema.Set).Len() > 0 {
+		diags = append(diags, updateDatabaseParameters(ctx, conn, d.Id(), expandRelationalDatabaseParameters(v.(*schema.Set).List()))...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	return append(diags, resourceDatabaseRead(ctx, d, meta)...)
 }
 
@@ -310,6 +340,19 @@ func resourceDatabaseRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("master_endpoint_address", rd.MasterEndpoint.Address)
 	d.Set("master_endpoint_port", rd.MasterEndpoint.Port)
 	d.Set("master_username", rd.MasterUsername)
+
+	// Only parameters managed by Terraform are read, as the API returns every parameter of the engine.
+	if v, ok := d.GetOk(names.AttrParameter); ok && v.(*schema.Set).Len() > 0 {
+		parameters, err := findRelationalDatabaseParametersByName(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lightsail Relational Database (%s) parameters: %s", d.Id(), err)
+		}
+
+		if err := d.Set(names.AttrParameter, flattenRelationalDatabaseParameters(v.(*schema.Set).List(), parameters)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
+		}
+	}
 	d.Set("preferred_backup_window", rd.PreferredBackupWindow)
 	d.Set(names.AttrPreferredMaintenanceWindow, rd.PreferredMaintenanceWindow)
 	d.Set(names.AttrPubliclyAccessible, rd.PubliclyAccessible)
@@ -328,7 +371,7 @@ func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
 
-	if d.HasChangesExcept(names.AttrApplyImmediately, "final_snapshot_name", "skip_final_snapshot", names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrApplyImmediately, "final_snapshot_name", names.AttrParameter, "skip_final_snapshot", names.AttrTags, names.AttrTagsAll) {
 		input := &lightsail.UpdateRelationalDatabaseInput{
 			ApplyImmediately:       aws.Bool(d.Get(names.AttrApplyImmediately).(bool)),
 			RelationalDatabaseName: aws.String(d.Id()),
@@ -392,6 +435,20 @@ func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange(names.AttrParameter) {
+		o, n := d.GetChange(names.AttrParameter)
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// There is no API to reset a parameter to its default value, so removed parameters keep their current value.
+		if add := ns.Difference(os); add.Len() > 0 {
+			diags = append(diags, updateDatabaseParameters(ctx, conn, d.Id(), expandRelationalDatabaseParameters(add.List()))...)
+
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
 	return append(diags, resourceDatabaseRead(ctx, d, meta)...)
 }
 
@@ -475,3 +532,107 @@ func FindDatabaseById(ctx context.Context, conn *lightsail.Client, id string) (*
 
 	return out.RelationalDatabase, nil
 }
+
+func updateDatabaseParameters(ctx context.Context, conn *lightsail.Client, name string, parameters []types.RelationalDatabaseParameter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := &lightsail.UpdateRelationalDatabaseParametersInput{
+		Parameters:             parameters,
+		RelationalDatabaseName: aws.String(name),
+	}
+
+	output, err := conn.UpdateRelationalDatabaseParameters(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lightsail Relational Database (%s) parameters: %s", name, err)
+	}
+
+	diagError := expandOperations(ctx, conn, output.Operations, types.OperationTypeUpdateRelationalDatabaseParameters, ResNameDatabase, name)
+
+	if diagError != nil {
+		return diagError
+	}
+
+	if _, err := waitDatabaseModified(ctx, conn, aws.String(name)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lightsail Relational Database (%s) to become available: %s", name, err)
+	}
+
+	return diags
+}
+
+func findRelationalDatabaseParametersByName(ctx context.Context, conn *lightsail.Client, name string) (map[string]types.RelationalDatabaseParameter, error) {
+	input := &lightsail.GetRelationalDatabaseParametersInput{
+		RelationalDatabaseName: aws.String(name),
+	}
+	output := make(map[string]types.RelationalDatabaseParameter)
+
+	err := getRelationalDatabaseParametersPages(ctx, conn, input, func(page *lightsail.GetRelationalDatabaseParametersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			output[aws.ToString(v.ParameterName)] = v
+		}
+
+		return !lastPage
+	})
+
+	if IsANotFoundError(err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandRelationalDatabaseParameters(tfList []interface{}) []types.RelationalDatabaseParameter {
+	var apiObjects []types.RelationalDatabaseParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.RelationalDatabaseParameter{
+			ApplyMethod:    aws.String(tfMap["apply_method"].(string)),
+			ParameterName:  aws.String(tfMap[names.AttrName].(string)),
+			ParameterValue: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenRelationalDatabaseParameters returns the current values of the configured parameters.
+// The apply method is not returned by the API, so the configured value is kept.
+func flattenRelationalDatabaseParameters(tfList []interface{}, apiObjects map[string]types.RelationalDatabaseParameter) []interface{} {
+	var result []interface{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject, ok := apiObjects[tfMap[names.AttrName].(string)]
+		if !ok {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"apply_method":  tfMap["apply_method"],
+			names.AttrName:  aws.ToString(apiObject.ParameterName),
+			names.AttrValue: aws.ToString(apiObject.ParameterValue),
+		})
+	}
+
+	return result
+}
@@ -491,6 +491,50 @@ func testAccDatabase_publiclyAccessible(t *testing.T, semaphore tfsync.Semaphore
 	})
 }
 
+func testAccDatabase_parameter(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckLightsailSynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(lightsail.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatabaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfig_parameter(rName, "60"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"apply_method":  "immediate",
+						names.AttrName:  "wait_timeout",
+						names.AttrValue: "60",
+					}),
+				),
+			},
+			{
+				Config: testAccDatabaseConfig_parameter(rName, "120"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"apply_method":  "immediate",
+						names.AttrName:  "wait_timeout",
+						names.AttrValue: "120",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccDatabase_backupRetentionEnabled(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1028,6 +1072,29 @@ resource "aws_lightsail_database" "test" {
 `, rName, publiclyAccessible))
 }
 
+func testAccDatabaseConfig_parameter(rName, waitTimeout string) string {
+	return acctest.ConfigCompose(
+		testAccDatabaseConfig_base(),
+		fmt.Sprintf(`
+resource "aws_lightsail_database" "test" {
+  relational_database_name = %[1]q
+  availability_zone        = data.aws_availability_zones.available.names[0]
+  master_database_name     = "testdatabasename"
+  master_password          = "testdatabasepassword"
+  master_username          = "test"
+  blueprint_id             = "mysql_8_0"
+  bundle_id                = "micro_2_0"
+  apply_immediately        = true
+  skip_final_snapshot      = true
+
+  parameter {
+    name  = "wait_timeout"
+    value = %[2]q
+  }
+}
+`, rName, waitTimeout))
+}
+
 func testAccDatabaseConfig_backupRetentionEnabled(rName string, backupRetentionEnabled bool) string {
 	return acctest.ConfigCompose(
 		testAccDatabaseConfig_base(),
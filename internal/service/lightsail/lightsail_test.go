@@ -31,6 +31,7 @@ func TestAccLightsail_serial(t *testing.T) {
 			"masterDatabaseName":         testAccDatabase_masterDatabaseName,
 			"masterUsername":             testAccDatabase_masterUsername,
 			"masterPassword":             testAccDatabase_masterPassword,
+			names.AttrParameter:          testAccDatabase_parameter,
 			"preferredBackupWindow":      testAccDatabase_preferredBackupWindow,
 			"preferredMaintenanceWindow": testAccDatabase_preferredMaintenanceWindow,
 			"publiclyAccessible":         testAccDatabase_publiclyAccessible,
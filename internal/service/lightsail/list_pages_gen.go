@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=GetRelationalDatabases,GetLoadBalancers,GetDisks,GetDistributions,GetDomains -InputPaginator=PageToken -OutputPaginator=NextPageToken -AWSSDKVersion=2"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=GetRelationalDatabases,GetRelationalDatabaseParameters,GetLoadBalancers,GetDisks,GetDistributions,GetDomains -InputPaginator=PageToken -OutputPaginator=NextPageToken -AWSSDKVersion=2"; DO NOT EDIT.
 
 package lightsail
 
@@ -73,6 +73,22 @@ func getLoadBalancersPages(ctx context.Context, conn *lightsail.Client, input *l
 	}
 	return nil
 }
+func getRelationalDatabaseParametersPages(ctx context.Context, conn *lightsail.Client, input *lightsail.GetRelationalDatabaseParametersInput, fn func(*lightsail.GetRelationalDatabaseParametersOutput, bool) bool) error {
+	for {
+		output, err := conn.GetRelationalDatabaseParameters(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextPageToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.PageToken = output.NextPageToken
+	}
+	return nil
+}
 func getRelationalDatabasesPages(ctx context.Context, conn *lightsail.Client, input *lightsail.GetRelationalDatabasesInput, fn func(*lightsail.GetRelationalDatabasesOutput, bool) bool) error {
 	for {
 		output, err := conn.GetRelationalDatabases(ctx, input)
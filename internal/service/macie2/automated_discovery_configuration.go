@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_macie2_automated_discovery_configuration", name="Automated Discovery Configuration")
+func ResourceAutomatedDiscoveryConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAutomatedDiscoveryConfigurationPut,
+		ReadWithoutTimeout:   resourceAutomatedDiscoveryConfigurationRead,
+		UpdateWithoutTimeout: resourceAutomatedDiscoveryConfigurationPut,
+		DeleteWithoutTimeout: resourceAutomatedDiscoveryConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"classification_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"excluded_bucket_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"first_enabled_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sensitivity_inspection_template": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"excluded_managed_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"included_allow_list_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"included_custom_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"included_managed_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(macie2.AutomatedDiscoveryStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAutomatedDiscoveryConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	if d.HasChange(names.AttrStatus) {
+		input := &macie2.UpdateAutomatedDiscoveryConfigurationInput{
+			Status: aws.String(d.Get(names.AttrStatus).(string)),
+		}
+
+		_, err := conn.UpdateAutomatedDiscoveryConfigurationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie automated discovery configuration: %s", err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	output, err := findAutomatedDiscoveryConfiguration(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie automated discovery configuration: %s", err)
+	}
+
+	if d.HasChange("excluded_bucket_names") {
+		if output.ClassificationScopeId == nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie classification scope: no classification scope found")
+		}
+
+		input := &macie2.UpdateClassificationScopeInput{
+			Id: output.ClassificationScopeId,
+			S3: &macie2.S3ClassificationScopeUpdate{
+				Excludes: &macie2.S3ClassificationScopeExclusionUpdate{
+					BucketNames: flex.ExpandStringSet(d.Get("excluded_bucket_names").(*schema.Set)),
+					Operation:   aws.String(macie2.ClassificationScopeUpdateOperationReplace),
+				},
+			},
+		}
+
+		_, err := conn.UpdateClassificationScopeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie classification scope (%s): %s", aws.StringValue(output.ClassificationScopeId), err)
+		}
+	}
+
+	if d.HasChange("sensitivity_inspection_template") {
+		if v, ok := d.GetOk("sensitivity_inspection_template"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if output.SensitivityInspectionTemplateId == nil {
+				return sdkdiag.AppendErrorf(diags, "updating Macie sensitivity inspection template: no sensitivity inspection template found")
+			}
+
+			input := expandUpdateSensitivityInspectionTemplateInput(v.([]interface{})[0].(map[string]interface{}))
+			input.Id = output.SensitivityInspectionTemplateId
+
+			_, err := conn.UpdateSensitivityInspectionTemplateWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Macie sensitivity inspection template (%s): %s", aws.StringValue(output.SensitivityInspectionTemplateId), err)
+			}
+		}
+	}
+
+	return append(diags, resourceAutomatedDiscoveryConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceAutomatedDiscoveryConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	output, err := findAutomatedDiscoveryConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie automated discovery configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie automated discovery configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("classification_scope_id", output.ClassificationScopeId)
+	if v := output.FirstEnabledAt; v != nil {
+		d.Set("first_enabled_at", aws.TimeValue(v).Format(time.RFC3339))
+	}
+	if v := output.LastUpdatedAt; v != nil {
+		d.Set("last_updated_at", aws.TimeValue(v).Format(time.RFC3339))
+	}
+	d.Set(names.AttrStatus, output.Status)
+
+	if v := output.ClassificationScopeId; v != nil {
+		scope, err := conn.GetClassificationScopeWithContext(ctx, &macie2.GetClassificationScopeInput{
+			Id: v,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Macie classification scope (%s): %s", aws.StringValue(v), err)
+		}
+
+		var bucketNames []*string
+		if scope.S3 != nil && scope.S3.Excludes != nil {
+			bucketNames = scope.S3.Excludes.BucketNames
+		}
+		d.Set("excluded_bucket_names", aws.StringValueSlice(bucketNames))
+	}
+
+	if v := output.SensitivityInspectionTemplateId; v != nil {
+		template, err := conn.GetSensitivityInspectionTemplateWithContext(ctx, &macie2.GetSensitivityInspectionTemplateInput{
+			Id: v,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Macie sensitivity inspection template (%s): %s", aws.StringValue(v), err)
+		}
+
+		if err := d.Set("sensitivity_inspection_template", []interface{}{flattenSensitivityInspectionTemplate(template)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting sensitivity_inspection_template: %s", err)
+		}
+	}
+
+	return diags
+}
+
+func resourceAutomatedDiscoveryConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	log.Printf("[DEBUG] Disabling Macie automated discovery: %s", d.Id())
+	_, err := conn.UpdateAutomatedDiscoveryConfigurationWithContext(ctx, &macie2.UpdateAutomatedDiscoveryConfigurationInput{
+		Status: aws.String(macie2.AutomatedDiscoveryStatusDisabled),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling Macie automated discovery (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAutomatedDiscoveryConfiguration(ctx context.Context, conn *macie2.Macie2) (*macie2.GetAutomatedDiscoveryConfigurationOutput, error) {
+	input := &macie2.GetAutomatedDiscoveryConfigurationInput{}
+
+	output, err := conn.GetAutomatedDiscoveryConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandUpdateSensitivityInspectionTemplateInput(tfMap map[string]interface{}) *macie2.UpdateSensitivityInspectionTemplateInput {
+	apiObject := &macie2.UpdateSensitivityInspectionTemplateInput{
+		Excludes: &macie2.SensitivityInspectionTemplateExcludes{},
+		Includes: &macie2.SensitivityInspectionTemplateIncludes{},
+	}
+
+	if v, ok := tfMap[names.AttrDescription].(string); ok {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["excluded_managed_data_identifier_ids"].(*schema.Set); ok {
+		apiObject.Excludes.ManagedDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["included_allow_list_ids"].(*schema.Set); ok {
+		apiObject.Includes.AllowListIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["included_custom_data_identifier_ids"].(*schema.Set); ok {
+		apiObject.Includes.CustomDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["included_managed_data_identifier_ids"].(*schema.Set); ok {
+		apiObject.Includes.ManagedDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenSensitivityInspectionTemplate(apiObject *macie2.GetSensitivityInspectionTemplateOutput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrDescription: aws.StringValue(apiObject.Description),
+		names.AttrID:          aws.StringValue(apiObject.SensitivityInspectionTemplateId),
+	}
+
+	if v := apiObject.Excludes; v != nil {
+		tfMap["excluded_managed_data_identifier_ids"] = aws.StringValueSlice(v.ManagedDataIdentifierIds)
+	}
+
+	if v := apiObject.Includes; v != nil {
+		tfMap["included_allow_list_ids"] = aws.StringValueSlice(v.AllowListIds)
+		tfMap["included_custom_data_identifier_ids"] = aws.StringValueSlice(v.CustomDataIdentifierIds)
+		tfMap["included_managed_data_identifier_ids"] = aws.StringValueSlice(v.ManagedDataIdentifierIds)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAutomatedDiscoveryConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_macie2_automated_discovery_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomatedDiscoveryConfigurationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, macie2.AutomatedDiscoveryStatusEnabled),
+					resource.TestCheckResourceAttrSet(resourceName, "classification_scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "excluded_bucket_names.#", acctest.Ct0),
+					acctest.CheckResourceAttrRFC3339(resourceName, "first_enabled_at"),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_inspection_template.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "sensitivity_inspection_template.0.id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomatedDiscoveryConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_automated_discovery_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomatedDiscoveryConfigurationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_full(rName, macie2.AutomatedDiscoveryStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, macie2.AutomatedDiscoveryStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "excluded_bucket_names.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "excluded_bucket_names.*", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_inspection_template.0.description", rName),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_inspection_template.0.excluded_managed_data_identifier_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "sensitivity_inspection_template.0.excluded_managed_data_identifier_ids.*", "AWS_CREDENTIALS"),
+				),
+			},
+			{
+				Config: testAccAutomatedDiscoveryConfigurationConfig_full(rName, macie2.AutomatedDiscoveryStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomatedDiscoveryConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, macie2.AutomatedDiscoveryStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomatedDiscoveryConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_automated_discovery_configuration" {
+				continue
+			}
+
+			output, err := conn.GetAutomatedDiscoveryConfigurationWithContext(ctx, &macie2.GetAutomatedDiscoveryConfigurationInput{})
+
+			if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+				tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if aws.StringValue(output.Status) != macie2.AutomatedDiscoveryStatusDisabled {
+				return fmt.Errorf("macie automated discovery %q still enabled", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutomatedDiscoveryConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		output, err := conn.GetAutomatedDiscoveryConfigurationWithContext(ctx, &macie2.GetAutomatedDiscoveryConfigurationInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.StringValue(output.Status), rs.Primary.Attributes[names.AttrStatus]; got != want {
+			return fmt.Errorf("macie automated discovery %q status is %s, expected %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccAutomatedDiscoveryConfigurationConfig_basic() string {
+	return `
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_automated_discovery_configuration" "test" {
+  status = "ENABLED"
+
+  depends_on = [aws_macie2_account.test]
+}
+`
+}
+
+func testAccAutomatedDiscoveryConfigurationConfig_full(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_automated_discovery_configuration" "test" {
+  status                = %[2]q
+  excluded_bucket_names = [aws_s3_bucket.test.bucket]
+
+  sensitivity_inspection_template {
+    description                          = %[1]q
+    excluded_managed_data_identifier_ids = ["AWS_CREDENTIALS"]
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, status)
+}
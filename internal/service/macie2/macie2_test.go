@@ -20,6 +20,10 @@ func TestAccMacie2_serial(t *testing.T) {
 			"finding_and_status":           testAccAccount_WithFindingAndStatus,
 			acctest.CtDisappears:           testAccAccount_disappears,
 		},
+		"AutomatedDiscoveryConfiguration": {
+			acctest.CtBasic: testAccAutomatedDiscoveryConfiguration_basic,
+			"update":        testAccAutomatedDiscoveryConfiguration_update,
+		},
 		"ClassificationExportConfiguration": {
 			acctest.CtBasic: testAccClassificationExportConfiguration_basic,
 		},
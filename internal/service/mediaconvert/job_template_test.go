@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConvertJobTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName, "ZEROBASED", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mediaconvert", regexache.MustCompile(`jobTemplates/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "queue"),
+					resource.TestCheckResourceAttrSet(resourceName, "settings_json"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobTemplateConfig_basic(rName, "EMBEDDED", 10),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, acctest.Ct10),
+					resource.TestMatchResourceAttr(resourceName, "settings_json", regexache.MustCompile(`"EMBEDDED"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName, "ZEROBASED", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconvert.ResourceJobTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobTemplateConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccJobTemplateConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_convert_job_template" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+			_, err := tfmediaconvert.FindJobTemplateByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Media Convert Job Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobTemplateExists(ctx context.Context, n string, v *types.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+		output, err := tfmediaconvert.FindJobTemplateByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobTemplateConfig_settings(timecodeSource string) string {
+	return fmt.Sprintf(`
+locals {
+  settings_json = jsonencode({
+    timecodeConfig = {
+      source = %[1]q
+    }
+    inputs = [{
+      audioSelectors = {
+        "Audio Selector 1" = {
+          defaultSelection = "DEFAULT"
+        }
+      }
+      videoSelector  = {}
+      timecodeSource = %[1]q
+    }]
+    outputGroups = [{
+      name = "File Group"
+      outputGroupSettings = {
+        type              = "FILE_GROUP_SETTINGS"
+        fileGroupSettings = {}
+      }
+      outputs = [{
+        containerSettings = {
+          container   = "MP4"
+          mp4Settings = {}
+        }
+        videoDescription = {
+          codecSettings = {
+            codec = "H_264"
+            h264Settings = {
+              rateControlMode   = "QVBR"
+              maxBitrate        = 5000000
+              sceneChangeDetect = "TRANSITION_DETECTION"
+            }
+          }
+        }
+        audioDescriptions = [{
+          codecSettings = {
+            codec = "AAC"
+            aacSettings = {
+              bitrate    = 96000
+              codingMode = "CODING_MODE_2_0"
+              sampleRate = 48000
+            }
+          }
+        }]
+      }]
+    }]
+  })
+}
+`, timecodeSource)
+}
+
+func testAccJobTemplateConfig_basic(rName, timecodeSource string, priority int) string {
+	return acctest.ConfigCompose(testAccJobTemplateConfig_settings(timecodeSource), fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name          = %[1]q
+  priority      = %[2]d
+  settings_json = local.settings_json
+}
+`, rName, priority))
+}
+
+func testAccJobTemplateConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccJobTemplateConfig_settings("ZEROBASED"), fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name          = %[1]q
+  settings_json = local.settings_json
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccJobTemplateConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccJobTemplateConfig_settings("ZEROBASED"), fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name          = %[1]q
+  settings_json = local.settings_json
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
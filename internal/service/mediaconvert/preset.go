@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_media_convert_preset", name="Preset")
+// @Tags(identifierAttribute="arn")
+func resourcePreset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePresetCreate,
+		ReadWithoutTimeout:   resourcePresetRead,
+		UpdateWithoutTimeout: resourcePresetUpdate,
+		DeleteWithoutTimeout: resourcePresetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"settings_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentPresetSettings,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePresetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	settings, err := expandSettingsJSON[types.PresetSettings](d.Get("settings_json").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Preset (%s): %s", name, err)
+	}
+
+	input := &mediaconvert.CreatePresetInput{
+		Name:     aws.String(name),
+		Settings: settings,
+		Tags:     getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePreset(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Preset (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Preset.Name))
+
+	return append(diags, resourcePresetRead(ctx, d, meta)...)
+}
+
+func resourcePresetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	preset, err := findPresetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Convert Preset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Preset (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, preset.Arn)
+	d.Set("category", preset.Category)
+	d.Set(names.AttrDescription, preset.Description)
+	d.Set(names.AttrName, preset.Name)
+	settingsJSON, err := flattenSettingsJSON(preset.Settings)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Preset (%s): %s", d.Id(), err)
+	}
+	d.Set("settings_json", settingsJSON)
+
+	return diags
+}
+
+func resourcePresetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		settings, err := expandSettingsJSON[types.PresetSettings](d.Get("settings_json").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Media Convert Preset (%s): %s", d.Id(), err)
+		}
+
+		input := &mediaconvert.UpdatePresetInput{
+			Name:     aws.String(d.Id()),
+			Settings: settings,
+		}
+
+		if v, ok := d.GetOk("category"); ok {
+			input.Category = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdatePreset(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Media Convert Preset (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePresetRead(ctx, d, meta)...)
+}
+
+func resourcePresetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Media Convert Preset: %s", d.Id())
+	_, err := conn.DeletePreset(ctx, &mediaconvert.DeletePresetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Media Convert Preset (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPresetByName(ctx context.Context, conn *mediaconvert.Client, name string) (*types.Preset, error) {
+	input := &mediaconvert.GetPresetInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetPreset(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Preset == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Preset, nil
+}
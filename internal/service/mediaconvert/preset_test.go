@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConvertPreset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preset types.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_basic(rName, "description 1", 5000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mediaconvert", regexache.MustCompile(`presets/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestMatchResourceAttr(resourceName, "settings_json", regexache.MustCompile(`5000000`)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPresetConfig_basic(rName, "description 2", 8000000),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+					resource.TestMatchResourceAttr(resourceName, "settings_json", regexache.MustCompile(`8000000`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertPreset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preset types.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_basic(rName, "description 1", 5000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconvert.ResourcePreset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPresetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_convert_preset" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+			_, err := tfmediaconvert.FindPresetByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Media Convert Preset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPresetExists(ctx context.Context, n string, v *types.Preset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+		output, err := tfmediaconvert.FindPresetByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPresetConfig_basic(rName, description string, maxBitrate int) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  settings_json = jsonencode({
+    containerSettings = {
+      container = "MP4"
+    }
+    videoDescription = {
+      codecSettings = {
+        codec = "H_264"
+        h264Settings = {
+          rateControlMode   = "QVBR"
+          maxBitrate        = %[3]d
+          sceneChangeDetect = "TRANSITION_DETECTION"
+        }
+      }
+    }
+    audioDescriptions = [{
+      codecSettings = {
+        codec = "AAC"
+        aacSettings = {
+          bitrate    = 96000
+          codingMode = "CODING_MODE_2_0"
+          sampleRate = 48000
+        }
+      }
+    }]
+  })
+}
+`, rName, description, maxBitrate)
+}
@@ -5,12 +5,15 @@ package mediaconvert
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
 	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -69,6 +72,14 @@ func resourceQueue() *schema.Resource {
 							Required:         true,
 							ValidateDiagFunc: enum.Validate[types.Commitment](),
 						},
+						"expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"purchased_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"renewal_type": {
 							Type:             schema.TypeString,
 							Required:         true,
@@ -91,7 +102,10 @@ func resourceQueue() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffQueueReservationPlan,
+		),
 	}
 }
 
@@ -172,8 +186,12 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.Description = aws.String(v.(string))
 		}
 
-		if v, ok := d.Get("reservation_plan_settings").([]interface{}); ok && len(v) > 0 && v[0] != nil {
-			input.ReservationPlanSettings = expandReservationPlanSettings(v[0].(map[string]interface{}))
+		// Only send the reservation plan when it changes, as each update with reservation plan settings
+		// is a purchase commitment.
+		if d.HasChange("reservation_plan_settings") {
+			if v, ok := d.Get("reservation_plan_settings").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				input.ReservationPlanSettings = expandReservationPlanSettings(v[0].(map[string]interface{}))
+			}
 		}
 
 		_, err := conn.UpdateQueue(ctx, input)
@@ -190,6 +208,30 @@ func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
 
+	reserved := types.PricingPlan(d.Get("pricing_plan").(string)) == types.PricingPlanReserved
+
+	// Reserved queues can't be deleted until their commitment ends.
+	// Stop the commitment from automatically renewing so that the queue can be deleted once it expires.
+	if v, ok := d.Get("reservation_plan_settings").([]interface{}); reserved && ok && len(v) > 0 && v[0] != nil {
+		if tfMap := v[0].(map[string]interface{}); types.RenewalType(tfMap["renewal_type"].(string)) == types.RenewalTypeAutoRenew {
+			apiObject := expandReservationPlanSettings(tfMap)
+			apiObject.RenewalType = types.RenewalTypeExpire
+
+			_, err := conn.UpdateQueue(ctx, &mediaconvert.UpdateQueueInput{
+				Name:                    aws.String(d.Id()),
+				ReservationPlanSettings: apiObject,
+			})
+
+			if errs.IsA[*types.NotFoundException](err) {
+				return diags
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Media Convert Queue (%s) reservation plan renewal type: %s", d.Id(), err)
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting Media Convert Queue: %s", d.Id())
 	_, err := conn.DeleteQueue(ctx, &mediaconvert.DeleteQueueInput{
 		Name: aws.String(d.Id()),
@@ -199,6 +241,10 @@ func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return diags
 	}
 
+	if reserved && (errs.IsA[*types.ConflictException](err) || errs.IsA[*types.BadRequestException](err)) {
+		return sdkdiag.AppendWarningf(diags, "Media Convert Queue (%s) is a reserved queue and can't be deleted until its commitment ends. Its reservation plan has been set to expire and it has been removed from Terraform state: %s", d.Id(), err)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Media Convert Queue (%s): %s", d.Id(), err)
 	}
@@ -264,5 +310,38 @@ func flattenReservationPlan(apiObject *types.ReservationPlan) map[string]interfa
 		"reserved_slots": aws.ToInt32(apiObject.ReservedSlots),
 	}
 
+	if v := apiObject.ExpiresAt; v != nil {
+		tfMap["expires_at"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.PurchasedAt; v != nil {
+		tfMap["purchased_at"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
 	return tfMap
 }
+
+func customizeDiffQueueReservationPlan(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if types.PricingPlan(d.Get("pricing_plan").(string)) != types.PricingPlanReserved {
+		return nil
+	}
+
+	if d.Id() == "" {
+		if v, ok := d.Get("reservation_plan_settings").([]interface{}); !ok || len(v) == 0 || v[0] == nil {
+			return fmt.Errorf(`"reservation_plan_settings" is required when "pricing_plan" is %q`, types.PricingPlanReserved)
+		}
+
+		return nil
+	}
+
+	// An existing commitment can only be renewed (or not) and have reserved slots added.
+	if o, n := d.GetChange("reservation_plan_settings.0.commitment"); o.(string) != "" && o.(string) != n.(string) {
+		return fmt.Errorf(`"reservation_plan_settings.0.commitment" can't be changed for an existing reserved queue`)
+	}
+
+	if o, n := d.GetChange("reservation_plan_settings.0.reserved_slots"); n.(int) < o.(int) {
+		return fmt.Errorf(`"reservation_plan_settings.0.reserved_slots" can't be decreased from %d to %d for an existing reserved queue`, o.(int), n.(int))
+	}
+
+	return nil
+}
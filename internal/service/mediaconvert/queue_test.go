@@ -167,6 +167,25 @@ func TestAccMediaConvertQueue_reservationPlanSettings(t *testing.T) {
 	})
 }
 
+func TestAccMediaConvertQueue_reservedRequiresReservationPlanSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueueConfig_pricingPlan(rName, string(types.PricingPlanReserved)),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"reservation_plan_settings" is required when "pricing_plan" is "RESERVED"`),
+			},
+		},
+	})
+}
+
 func TestAccMediaConvertQueue_withStatus(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queue types.Queue
@@ -289,6 +308,15 @@ resource "aws_media_convert_queue" "test" {
 `, rName)
 }
 
+func testAccQueueConfig_pricingPlan(rName, pricingPlan string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name         = %[1]q
+  pricing_plan = %[2]q
+}
+`, rName, pricingPlan)
+}
+
 func testAccQueueConfig_status(rName, status string) string {
 	return fmt.Sprintf(`
 resource "aws_media_convert_queue" "test" {
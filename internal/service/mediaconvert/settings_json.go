@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// expandSettingsJSON decodes a MediaConvert settings JSON document into the corresponding API object.
+// Field names are matched case-insensitively, so documents exported from the MediaConvert console or
+// API (camelCase) and documents using the AWS SDK field names (PascalCase) are both accepted.
+func expandSettingsJSON[T any](s string) (*T, error) {
+	var apiObject T
+
+	if err := json.Unmarshal([]byte(s), &apiObject); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	return &apiObject, nil
+}
+
+// flattenSettingsJSON encodes a MediaConvert settings API object as a JSON document with empty values removed.
+func flattenSettingsJSON(apiObject any) (string, error) {
+	v, err := normalizeSettingsObject(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	if v == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// normalizeSettingsObject converts an API object to its generic JSON representation with empty values removed.
+func normalizeSettingsObject(apiObject any) (any, error) {
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var v any
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	return removeEmptySettingsValues(v), nil
+}
+
+// removeEmptySettingsValues recursively removes `null`, `""`, empty array and empty object values.
+func removeEmptySettingsValues(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if e := removeEmptySettingsValues(e); e == nil {
+				delete(v, k)
+			} else {
+				v[k] = e
+			}
+		}
+		if len(v) == 0 {
+			return nil
+		}
+		return v
+	case []any:
+		s := make([]any, 0, len(v))
+		for _, e := range v {
+			// Keep array element positions stable.
+			if e := removeEmptySettingsValues(e); e == nil {
+				s = append(s, map[string]any{})
+			} else {
+				s = append(s, e)
+			}
+		}
+		if len(s) == 0 {
+			return nil
+		}
+		return s
+	case string:
+		if v == "" {
+			return nil
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// settingsJSONEquivalent returns whether the proposed settings JSON document is semantically equivalent
+// to the settings JSON document in state.
+// Both documents are decoded into the API object so that differences in field name casing and formatting
+// are ignored. Values that are present in state but absent from the proposed document are service defaults
+// and are also ignored.
+func settingsJSONEquivalent[T any](state, proposed string) bool {
+	if state == proposed {
+		return true
+	}
+
+	stateObject, err := expandSettingsJSON[T](state)
+
+	if err != nil {
+		return false
+	}
+
+	proposedObject, err := expandSettingsJSON[T](proposed)
+
+	if err != nil {
+		return false
+	}
+
+	stateValue, err := normalizeSettingsObject(stateObject)
+
+	if err != nil {
+		log.Printf("[WARN] normalizing MediaConvert settings JSON: %s", err)
+		return false
+	}
+
+	proposedValue, err := normalizeSettingsObject(proposedObject)
+
+	if err != nil {
+		log.Printf("[WARN] normalizing MediaConvert settings JSON: %s", err)
+		return false
+	}
+
+	return settingsValueContains(stateValue, proposedValue)
+}
+
+// settingsValueContains returns whether every value in proposed is present in, and equal to, the value in state.
+func settingsValueContains(state, proposed any) bool {
+	if proposed == nil {
+		return true
+	}
+
+	switch proposed := proposed.(type) {
+	case map[string]any:
+		state, ok := state.(map[string]any)
+
+		if !ok {
+			return false
+		}
+
+		for k, v := range proposed {
+			if !settingsValueContains(state[k], v) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		state, ok := state.([]any)
+
+		if !ok || len(state) != len(proposed) {
+			return false
+		}
+
+		for i, v := range proposed {
+			if !settingsValueContains(state[i], v) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(state, proposed)
+	}
+}
+
+func suppressEquivalentJobTemplateSettings(k, old, new string, d *schema.ResourceData) bool {
+	return settingsJSONEquivalent[types.JobTemplateSettings](old, new)
+}
+
+func suppressEquivalentPresetSettings(k, old, new string, d *schema.ResourceData) bool {
+	return settingsJSONEquivalent[types.PresetSettings](old, new)
+}
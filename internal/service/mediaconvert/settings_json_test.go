@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"testing"
+
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+)
+
+func TestJobTemplateSettingsJSONEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		state    string
+		proposed string
+		want     bool
+	}{
+		{
+			name:     "identical",
+			state:    `{"TimecodeConfig":{"Source":"ZEROBASED"}}`,
+			proposed: `{"TimecodeConfig":{"Source":"ZEROBASED"}}`,
+			want:     true,
+		},
+		{
+			name:     "field name casing",
+			state:    `{"TimecodeConfig":{"Source":"ZEROBASED"}}`,
+			proposed: `{"timecodeConfig": {"source": "ZEROBASED"}}`,
+			want:     true,
+		},
+		{
+			name:     "service defaults",
+			state:    `{"AdAvailOffset":0,"TimecodeConfig":{"Source":"ZEROBASED"},"OutputGroups":[{"Name":"File Group","OutputGroupSettings":{"Type":"FILE_GROUP_SETTINGS"}}]}`,
+			proposed: `{"timecodeConfig":{"source":"ZEROBASED"},"outputGroups":[{"name":"File Group","outputGroupSettings":{"type":"FILE_GROUP_SETTINGS","fileGroupSettings":{}}}]}`,
+			want:     true,
+		},
+		{
+			name:     "changed value",
+			state:    `{"TimecodeConfig":{"Source":"ZEROBASED"}}`,
+			proposed: `{"timecodeConfig":{"source":"EMBEDDED"}}`,
+			want:     false,
+		},
+		{
+			name:     "added value",
+			state:    `{"TimecodeConfig":{"Source":"ZEROBASED"}}`,
+			proposed: `{"timecodeConfig":{"source":"ZEROBASED"},"adAvailOffset":10}`,
+			want:     false,
+		},
+		{
+			name:     "removed list element",
+			state:    `{"OutputGroups":[{"Name":"one"},{"Name":"two"}]}`,
+			proposed: `{"outputGroups":[{"name":"one"}]}`,
+			want:     false,
+		},
+		{
+			name:     "unknown field",
+			state:    `{"TimecodeConfig":{"Source":"ZEROBASED"}}`,
+			proposed: `{"timecodeConfig":{"source":"ZEROBASED"},"notAField":true}`,
+			want:     true,
+		},
+		{
+			name:     "invalid JSON",
+			state:    `{"TimecodeConfig":{"Source":"ZEROBASED"}}`,
+			proposed: `{"timecodeConfig":`,
+			want:     false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfmediaconvert.JobTemplateSettingsJSONEquivalent(testCase.state, testCase.proposed), testCase.want; got != want {
+				t.Errorf("JobTemplateSettingsJSONEquivalent(%q, %q) = %v, want %v", testCase.state, testCase.proposed, got, want)
+			}
+		})
+	}
+}
+
+func TestPresetSettingsJSONEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		state    string
+		proposed string
+		want     bool
+	}{
+		{
+			name:     "field name casing",
+			state:    `{"ContainerSettings":{"Container":"MP4","Mp4Settings":{"CslgAtom":"INCLUDE"}}}`,
+			proposed: `{"containerSettings":{"container":"MP4"}}`,
+			want:     true,
+		},
+		{
+			name:     "changed value",
+			state:    `{"ContainerSettings":{"Container":"MP4"}}`,
+			proposed: `{"containerSettings":{"container":"MOV"}}`,
+			want:     false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfmediaconvert.PresetSettingsJSONEquivalent(testCase.state, testCase.proposed), testCase.want; got != want {
+				t.Errorf("PresetSettingsJSONEquivalent(%q, %q) = %v, want %v", testCase.state, testCase.proposed, got, want)
+			}
+		})
+	}
+}
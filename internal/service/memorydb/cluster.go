@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/memorydb"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -46,7 +47,17 @@ func ResourceCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(clusterDeletedTimeout),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// The node type of a regional cluster is managed by its Multi-Region Cluster.
+				if v := d.Get("multi_region_cluster_name").(string); v != "" && d.Id() != "" && d.HasChange("node_type") {
+					return fmt.Errorf("node_type of a MemoryDB Cluster that belongs to Multi-Region Cluster (%s) cannot be changed; update the Multi-Region Cluster instead", v)
+				}
+
+				return nil
+			},
+		),
 
 		Schema: map[string]*schema.Schema{
 			"acl_name": {
@@ -105,6 +116,11 @@ func ResourceCluster() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
+			"multi_region_cluster_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			names.AttrName: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -308,6 +324,21 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.MaintenanceWindow = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("multi_region_cluster_name"); ok {
+		multiRegionClusterName := v.(string)
+		multiRegionCluster, err := FindMultiRegionClusterByName(ctx, conn, multiRegionClusterName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading MemoryDB Multi-Region Cluster (%s): %s", multiRegionClusterName, err)
+		}
+
+		if nodeType := aws.StringValue(input.NodeType); nodeType != aws.StringValue(multiRegionCluster.NodeType) {
+			return sdkdiag.AppendErrorf(diags, "creating MemoryDB Cluster (%s): node_type (%s) is not compatible with Multi-Region Cluster (%s) node type (%s)", name, nodeType, multiRegionClusterName, aws.StringValue(multiRegionCluster.NodeType))
+		}
+
+		input.MultiRegionClusterName = aws.String(multiRegionClusterName)
+	}
+
 	if v, ok := d.GetOk(names.AttrParameterGroupName); ok {
 		input.ParameterGroupName = aws.String(v.(string))
 	}
@@ -360,6 +391,12 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(name)
 
+	if v, ok := d.GetOk("multi_region_cluster_name"); ok {
+		if err := waitMultiRegionClusterMemberAvailable(ctx, conn, v.(string), name); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) to join Multi-Region Cluster (%s): %s", name, v.(string), err)
+		}
+	}
+
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
@@ -515,6 +552,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set(names.AttrEngineVersion, cluster.EngineVersion)
 	d.Set(names.AttrKMSKeyARN, cluster.KmsKeyId) // KmsKeyId is actually an ARN here.
 	d.Set("maintenance_window", cluster.MaintenanceWindow)
+	d.Set("multi_region_cluster_name", cluster.MultiRegionClusterName)
 	d.Set(names.AttrName, cluster.Name)
 	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.StringValue(cluster.Name)))
 	d.Set("node_type", cluster.NodeType)
@@ -94,6 +94,34 @@ func ClusterSNSTopicStatus_Values() []string {
 	}
 }
 
+const (
+	MultiRegionClusterStatusAvailable = "available"
+	MultiRegionClusterStatusCreating  = "creating"
+	MultiRegionClusterStatusDeleting  = "deleting"
+	MultiRegionClusterStatusUpdating  = "updating"
+)
+
+func MultiRegionClusterStatus_Values() []string {
+	return []string{
+		MultiRegionClusterStatusAvailable,
+		MultiRegionClusterStatusCreating,
+		MultiRegionClusterStatusDeleting,
+		MultiRegionClusterStatusUpdating,
+	}
+}
+
+const (
+	MultiRegionClusterUpdateStrategyCoordinated   = "coordinated"
+	MultiRegionClusterUpdateStrategyUncoordinated = "uncoordinated"
+)
+
+func MultiRegionClusterUpdateStrategy_Values() []string {
+	return []string{
+		MultiRegionClusterUpdateStrategyCoordinated,
+		MultiRegionClusterUpdateStrategyUncoordinated,
+	}
+}
+
 const (
 	SnapshotStatusAvailable = "available"
 	SnapshotStatusCopying   = "copying"
@@ -72,6 +72,36 @@ func FindClusterByName(ctx context.Context, conn *memorydb.MemoryDB, name string
 	return output.Clusters[0], nil
 }
 
+func FindMultiRegionClusterByName(ctx context.Context, conn *memorydb.MemoryDB, name string) (*memorydb.MultiRegionCluster, error) {
+	input := memorydb.DescribeMultiRegionClustersInput{
+		MultiRegionClusterName: aws.String(name),
+		ShowClusterDetails:     aws.Bool(true),
+	}
+
+	output, err := conn.DescribeMultiRegionClustersWithContext(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeMultiRegionClusterNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.MultiRegionClusters) == 0 || output.MultiRegionClusters[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.MultiRegionClusters); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.MultiRegionClusters[0], nil
+}
+
 func FindParameterGroupByName(ctx context.Context, conn *memorydb.MemoryDB, name string) (*memorydb.ParameterGroup, error) {
 	input := memorydb.DescribeParameterGroupsInput{
 		ParameterGroupName: aws.String(name),
@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeACLs,DescribeClusters,DescribeParameterGroups,DescribeSnapshots,DescribeSubnetGroups,DescribeUsers"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeACLs,DescribeClusters,DescribeMultiRegionClusters,DescribeParameterGroups,DescribeSnapshots,DescribeSubnetGroups,DescribeUsers"; DO NOT EDIT.
 
 package memorydb
 
@@ -42,6 +42,22 @@ func describeClustersPages(ctx context.Context, conn memorydbiface.MemoryDBAPI,
 	}
 	return nil
 }
+func describeMultiRegionClustersPages(ctx context.Context, conn memorydbiface.MemoryDBAPI, input *memorydb.DescribeMultiRegionClustersInput, fn func(*memorydb.DescribeMultiRegionClustersOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeMultiRegionClustersWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func describeParameterGroupsPages(ctx context.Context, conn memorydbiface.MemoryDBAPI, input *memorydb.DescribeParameterGroupsInput, fn func(*memorydb.DescribeParameterGroupsOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeParameterGroupsWithContext(ctx, input)
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package memorydb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_memorydb_multi_region_cluster", name="Multi-Region Cluster")
+// @Tags(identifierAttribute="arn")
+func ResourceMultiRegionCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMultiRegionClusterCreate,
+		ReadWithoutTimeout:   resourceMultiRegionClusterRead,
+		UpdateWithoutTimeout: resourceMultiRegionClusterUpdate,
+		DeleteWithoutTimeout: resourceMultiRegionClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(multiRegionClusterAvailableTimeout),
+			Update: schema.DefaultTimeout(multiRegionClusterAvailableTimeout),
+			Delete: schema.DefaultTimeout(multiRegionClusterDeletedTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrEngine: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrEngineVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"multi_region_cluster_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"multi_region_cluster_name_suffix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateResourceName(multiRegionClusterSuffixMaxLength),
+			},
+			"multi_region_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"node_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validMultiRegionClusterNodeType,
+			},
+			"num_shards": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tls_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"update_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(MultiRegionClusterUpdateStrategy_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceMultiRegionClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MemoryDBConn(ctx)
+
+	suffix := d.Get("multi_region_cluster_name_suffix").(string)
+	input := &memorydb.CreateMultiRegionClusterInput{
+		MultiRegionClusterNameSuffix: aws.String(suffix),
+		NodeType:                     aws.String(d.Get("node_type").(string)),
+		Tags:                         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrEngine); ok {
+		input.Engine = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrEngineVersion); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("multi_region_parameter_group_name"); ok {
+		input.MultiRegionParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("num_shards"); ok {
+		input.NumShards = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOkExists("tls_enabled"); ok {
+		input.TLSEnabled = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Multi-Region Cluster: %s", input)
+	output, err := conn.CreateMultiRegionClusterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MemoryDB Multi-Region Cluster (%s): %s", suffix, err)
+	}
+
+	d.SetId(aws.StringValue(output.MultiRegionCluster.MultiRegionClusterName))
+
+	if err := waitMultiRegionClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Multi-Region Cluster (%s) to be created: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceMultiRegionClusterRead(ctx, d, meta)...)
+}
+
+func resourceMultiRegionClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MemoryDBConn(ctx)
+
+	if d.HasChangesExcept("update_strategy", names.AttrTags, names.AttrTagsAll) {
+		input := &memorydb.UpdateMultiRegionClusterInput{
+			MultiRegionClusterName: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrEngineVersion) {
+			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+		}
+
+		if d.HasChange("multi_region_parameter_group_name") {
+			input.MultiRegionParameterGroupName = aws.String(d.Get("multi_region_parameter_group_name").(string))
+		}
+
+		if d.HasChange("node_type") {
+			input.NodeType = aws.String(d.Get("node_type").(string))
+		}
+
+		if d.HasChange("num_shards") {
+			input.ShardConfiguration = &memorydb.ShardConfigurationRequest{
+				ShardCount: aws.Int64(int64(d.Get("num_shards").(int))),
+			}
+		}
+
+		if v, ok := d.GetOk("update_strategy"); ok {
+			input.UpdateStrategy = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB Multi-Region Cluster (%s)", d.Id())
+		_, err := conn.UpdateMultiRegionClusterWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MemoryDB Multi-Region Cluster (%s): %s", d.Id(), err)
+		}
+
+		if err := waitMultiRegionClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Multi-Region Cluster (%s) to be modified: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMultiRegionClusterRead(ctx, d, meta)...)
+}
+
+func resourceMultiRegionClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MemoryDBConn(ctx)
+
+	multiRegionCluster, err := FindMultiRegionClusterByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Multi-Region Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MemoryDB Multi-Region Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, multiRegionCluster.ARN)
+	if err := d.Set("clusters", flattenRegionalClusters(multiRegionCluster.Clusters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting clusters: %s", err)
+	}
+	d.Set(names.AttrDescription, multiRegionCluster.Description)
+	d.Set(names.AttrEngine, multiRegionCluster.Engine)
+	d.Set(names.AttrEngineVersion, multiRegionCluster.EngineVersion)
+	d.Set("multi_region_cluster_name", multiRegionCluster.MultiRegionClusterName)
+	d.Set("multi_region_parameter_group_name", multiRegionCluster.MultiRegionParameterGroupName)
+	d.Set("node_type", multiRegionCluster.NodeType)
+	d.Set("num_shards", multiRegionCluster.NumberOfShards)
+	d.Set(names.AttrStatus, multiRegionCluster.Status)
+	d.Set("tls_enabled", multiRegionCluster.TLSEnabled)
+
+	return diags
+}
+
+func resourceMultiRegionClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MemoryDBConn(ctx)
+
+	log.Printf("[DEBUG] Deleting MemoryDB Multi-Region Cluster: (%s)", d.Id())
+	_, err := conn.DeleteMultiRegionClusterWithContext(ctx, &memorydb.DeleteMultiRegionClusterInput{
+		MultiRegionClusterName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeMultiRegionClusterNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MemoryDB Multi-Region Cluster (%s): %s", d.Id(), err)
+	}
+
+	if err := waitMultiRegionClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Multi-Region Cluster (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func flattenRegionalClusters(apiObjects []*memorydb.RegionalCluster) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:    aws.StringValue(apiObject.ARN),
+			"cluster_name":   aws.StringValue(apiObject.ClusterName),
+			names.AttrRegion: aws.StringValue(apiObject.Region),
+			names.AttrStatus: aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
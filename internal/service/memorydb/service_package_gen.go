@@ -72,6 +72,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceMultiRegionCluster,
+			TypeName: "aws_memorydb_multi_region_cluster",
+			Name:     "Multi-Region Cluster",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceParameterGroup,
 			TypeName: "aws_memorydb_parameter_group",
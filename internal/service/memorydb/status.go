@@ -89,6 +89,49 @@ func statusClusterSecurityGroups(ctx context.Context, conn *memorydb.MemoryDB, c
 	}
 }
 
+// statusMultiRegionCluster fetches the MemoryDB Multi-Region Cluster and its status.
+func statusMultiRegionCluster(ctx context.Context, conn *memorydb.MemoryDB, multiRegionClusterName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		multiRegionCluster, err := FindMultiRegionClusterByName(ctx, conn, multiRegionClusterName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return multiRegionCluster, aws.StringValue(multiRegionCluster.Status), nil
+	}
+}
+
+// statusMultiRegionClusterMember fetches the MemoryDB Multi-Region Cluster and
+// the replication status of one of its regional clusters.
+func statusMultiRegionClusterMember(ctx context.Context, conn *memorydb.MemoryDB, multiRegionClusterName, clusterName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		multiRegionCluster, err := FindMultiRegionClusterByName(ctx, conn, multiRegionClusterName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range multiRegionCluster.Clusters {
+			if aws.StringValue(v.ClusterName) == clusterName {
+				return v, aws.StringValue(v.Status), nil
+			}
+		}
+
+		// The regional cluster is not yet reported as part of the
+		// Multi-Region Cluster, so replication is still being set up.
+		return multiRegionCluster, ClusterStatusCreating, nil
+	}
+}
+
 // statusSnapshot fetches the MemoryDB Snapshot and its status.
 func statusSnapshot(ctx context.Context, conn *memorydb.MemoryDB, snapshotName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
 		Name: "aws_memorydb_acl",
 		F:    sweepACLs,
 		Dependencies: []string{
@@ -24,12 +24,20 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
 		Name: "aws_memorydb_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_multi_region_cluster", &resource.Sweeper{
+		Name: "aws_memorydb_multi_region_cluster",
+		F:    sweepMultiRegionClusters,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
 		Name: "aws_memorydb_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -37,7 +45,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
 		Name: "aws_memorydb_snapshot",
 		F:    sweepSnapshots,
 		Dependencies: []string{
@@ -45,7 +53,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
 		Name: "aws_memorydb_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -53,7 +61,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
 		Name: "aws_memorydb_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -156,6 +164,50 @@ func sweepClusters(region string) error {
 	return nil
 }
 
+func sweepMultiRegionClusters(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.MemoryDBConn(ctx)
+	input := &memorydb.DescribeMultiRegionClustersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = describeMultiRegionClustersPages(ctx, conn, input, func(page *memorydb.DescribeMultiRegionClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MultiRegionClusters {
+			r := ResourceMultiRegionCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.MultiRegionClusterName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MemoryDB Multi-Region Cluster sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing MemoryDB Multi-Region Clusters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MemoryDB Multi-Region Clusters (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepParameterGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -10,12 +10,13 @@ import (
 )
 
 const (
-	aclNameMaxLength            = 40
-	clusterNameMaxLength        = 40
-	parameterGroupNameMaxLength = 255
-	snapshotNameMaxLength       = 255
-	subnetGroupNameMaxLength    = 255
-	userNameMaxLength           = 40
+	aclNameMaxLength                  = 40
+	clusterNameMaxLength              = 40
+	multiRegionClusterSuffixMaxLength = 40
+	parameterGroupNameMaxLength       = 255
+	snapshotNameMaxLength             = 255
+	subnetGroupNameMaxLength          = 255
+	userNameMaxLength                 = 40
 )
 
 // validateResourceName returns a validation function applicable to all MemoryDB
@@ -45,3 +46,11 @@ func validateResourceNamePrefix(maxLength int) schema.SchemaValidateFunc {
 			"Only lowercase alphanumeric characters and hyphens are allowed."),
 	)
 }
+
+// validMultiRegionClusterNodeType validates the node type of a Multi-Region
+// Cluster. Only memory-optimized (db.r*) node types support Multi-Region
+// replication.
+var validMultiRegionClusterNodeType = validation.StringMatch(
+	regexache.MustCompile(`^db\.r[0-9]+[a-z]*\.[0-9a-z]+$`),
+	"Multi-Region Clusters only support memory-optimized (db.r*) node types.",
+)
@@ -22,6 +22,11 @@ const (
 
 	clusterSecurityGroupsActiveTimeout = 10 * time.Minute
 
+	multiRegionClusterAvailableTimeout = 120 * time.Minute
+	multiRegionClusterDeletedTimeout   = 120 * time.Minute
+
+	multiRegionClusterMemberAvailableTimeout = 60 * time.Minute
+
 	userActiveTimeout  = 5 * time.Minute
 	userDeletedTimeout = 5 * time.Minute
 
@@ -115,6 +120,49 @@ func waitClusterSecurityGroupsActive(ctx context.Context, conn *memorydb.MemoryD
 	return err
 }
 
+// waitMultiRegionClusterAvailable waits for MemoryDB Multi-Region Cluster to reach an available state after modifications.
+func waitMultiRegionClusterAvailable(ctx context.Context, conn *memorydb.MemoryDB, multiRegionClusterName string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{MultiRegionClusterStatusCreating, MultiRegionClusterStatusUpdating},
+		Target:  []string{MultiRegionClusterStatusAvailable},
+		Refresh: statusMultiRegionCluster(ctx, conn, multiRegionClusterName),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// waitMultiRegionClusterDeleted waits for MemoryDB Multi-Region Cluster to be deleted.
+func waitMultiRegionClusterDeleted(ctx context.Context, conn *memorydb.MemoryDB, multiRegionClusterName string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{MultiRegionClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: statusMultiRegionCluster(ctx, conn, multiRegionClusterName),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// waitMultiRegionClusterMemberAvailable waits for a MemoryDB Cluster to finish
+// joining a Multi-Region Cluster, i.e. for cross-region replication to be set up.
+func waitMultiRegionClusterMemberAvailable(ctx context.Context, conn *memorydb.MemoryDB, multiRegionClusterName, clusterName string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ClusterStatusCreating, ClusterStatusUpdating},
+		Target:  []string{ClusterStatusAvailable},
+		Refresh: statusMultiRegionClusterMember(ctx, conn, multiRegionClusterName, clusterName),
+		Timeout: multiRegionClusterMemberAvailableTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 // waitUserActive waits for MemoryDB user to reach an active state after modifications.
 func waitUserActive(ctx context.Context, conn *memorydb.MemoryDB, userId string) error {
 	stateConf := &retry.StateChangeConf{
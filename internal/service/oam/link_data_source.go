@@ -26,6 +26,38 @@ func DataSourceLink() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"link_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_group_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrFilter: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"metric_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrFilter: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"link_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -76,6 +108,9 @@ func dataSourceLinkRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.SetId(aws.ToString(out.Arn))
 
 	d.Set(names.AttrARN, out.Arn)
+	if err := d.Set("link_configuration", flattenLinkConfiguration(out.LinkConfiguration)); err != nil {
+		return create.AppendDiagError(diags, names.ObservabilityAccessManager, create.ErrActionSetting, DSNameLink, d.Id(), err)
+	}
 	d.Set("link_id", out.Id)
 	d.Set("label", out.Label)
 	d.Set("label_template", out.LabelTemplate)
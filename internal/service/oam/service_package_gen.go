@@ -37,6 +37,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSink,
 			TypeName: "aws_oam_sink",
 		},
+		{
+			Factory:  DataSourceSinkPolicyDocument,
+			TypeName: "aws_oam_sink_policy_document",
+		},
 		{
 			Factory:  DataSourceSinks,
 			TypeName: "aws_oam_sinks",
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oam
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/oam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_oam_sink_policy_document")
+func DataSourceSinkPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSinkPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				AtLeastOneOf: []string{"account_ids", "organization_ids", "organization_paths"},
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organization_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^o-[0-9a-z]{10,32}$`), "must be a valid AWS Organizations organization ID"),
+				},
+				AtLeastOneOf: []string{"account_ids", "organization_ids", "organization_paths"},
+			},
+			"organization_paths": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				AtLeastOneOf: []string{"account_ids", "organization_ids", "organization_paths"},
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.ResourceType](),
+				},
+			},
+		},
+	}
+}
+
+const (
+	DSNameSinkPolicyDocument = "Sink Policy Document Data Source"
+)
+
+type sinkPolicyDocument struct {
+	Version   string                         `json:"Version"`
+	Statement []*sinkPolicyDocumentStatement `json:"Statement"`
+}
+
+type sinkPolicyDocumentStatement struct {
+	Action    []string                       `json:"Action"`
+	Condition map[string]map[string][]string `json:"Condition"`
+	Effect    string                         `json:"Effect"`
+	Principal interface{}                    `json:"Principal"`
+	Resource  string                         `json:"Resource"`
+}
+
+func dataSourceSinkPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceTypes := sortedStringSet(d.Get("resource_types").(*schema.Set))
+	newStatement := func(principal interface{}) *sinkPolicyDocumentStatement {
+		return &sinkPolicyDocumentStatement{
+			Action: []string{"oam:CreateLink", "oam:UpdateLink"},
+			Condition: map[string]map[string][]string{
+				"ForAllValues:StringEquals": {
+					"oam:ResourceTypes": resourceTypes,
+				},
+			},
+			Effect:    "Allow",
+			Principal: principal,
+			Resource:  "*",
+		}
+	}
+
+	doc := &sinkPolicyDocument{
+		Version: "2012-10-17",
+	}
+
+	if v, ok := d.GetOk("account_ids"); ok && v.(*schema.Set).Len() > 0 {
+		doc.Statement = append(doc.Statement, newStatement(map[string][]string{
+			"AWS": sortedStringSet(v.(*schema.Set)),
+		}))
+	}
+
+	if v, ok := d.GetOk("organization_ids"); ok && v.(*schema.Set).Len() > 0 {
+		statement := newStatement("*")
+		statement.Condition["ForAnyValue:StringEquals"] = map[string][]string{
+			"aws:PrincipalOrgID": sortedStringSet(v.(*schema.Set)),
+		}
+		doc.Statement = append(doc.Statement, statement)
+	}
+
+	if v, ok := d.GetOk("organization_paths"); ok && v.(*schema.Set).Len() > 0 {
+		statement := newStatement("*")
+		statement.Condition["ForAnyValue:StringLike"] = map[string][]string{
+			"aws:PrincipalOrgPaths": sortedStringSet(v.(*schema.Set)),
+		}
+		doc.Statement = append(doc.Statement, statement)
+	}
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ObservabilityAccessManager, create.ErrActionReading, DSNameSinkPolicyDocument, "", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set(names.AttrJSON, jsonString)
+
+	return diags
+}
+
+func sortedStringSet(s *schema.Set) []string {
+	v := flex.ExpandStringValueSet(s)
+	slices.Sort(v)
+
+	return v
+}
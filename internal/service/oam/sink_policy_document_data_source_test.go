@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oam_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccObservabilityAccessManagerSinkPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_oam_sink_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ObservabilityAccessManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSinkPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrJSON, testAccSinkPolicyDocumentExpectedJSON),
+				),
+			},
+			{
+				Config:      testAccSinkPolicyDocumentDataSourceConfig_noPrincipals,
+				ExpectError: regexache.MustCompile(`one of .account_ids,organization_ids,organization_paths. must be`),
+			},
+		},
+	})
+}
+
+const testAccSinkPolicyDocumentDataSourceConfig_basic = `
+data "aws_oam_sink_policy_document" "test" {
+  account_ids        = ["222222222222", "111111111111"]
+  organization_ids   = ["o-abcdef1234"]
+  organization_paths = ["o-abcdef1234/r-ab12/ou-ab12-11111111/*"]
+  resource_types     = ["AWS::Logs::LogGroup", "AWS::CloudWatch::Metric"]
+}
+`
+
+const testAccSinkPolicyDocumentDataSourceConfig_noPrincipals = `
+data "aws_oam_sink_policy_document" "test" {
+  resource_types = ["AWS::CloudWatch::Metric"]
+}
+`
+
+const testAccSinkPolicyDocumentExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "oam:CreateLink",
+        "oam:UpdateLink"
+      ],
+      "Condition": {
+        "ForAllValues:StringEquals": {
+          "oam:ResourceTypes": [
+            "AWS::CloudWatch::Metric",
+            "AWS::Logs::LogGroup"
+          ]
+        }
+      },
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": [
+          "111111111111",
+          "222222222222"
+        ]
+      },
+      "Resource": "*"
+    },
+    {
+      "Action": [
+        "oam:CreateLink",
+        "oam:UpdateLink"
+      ],
+      "Condition": {
+        "ForAllValues:StringEquals": {
+          "oam:ResourceTypes": [
+            "AWS::CloudWatch::Metric",
+            "AWS::Logs::LogGroup"
+          ]
+        },
+        "ForAnyValue:StringEquals": {
+          "aws:PrincipalOrgID": [
+            "o-abcdef1234"
+          ]
+        }
+      },
+      "Effect": "Allow",
+      "Principal": "*",
+      "Resource": "*"
+    },
+    {
+      "Action": [
+        "oam:CreateLink",
+        "oam:UpdateLink"
+      ],
+      "Condition": {
+        "ForAllValues:StringEquals": {
+          "oam:ResourceTypes": [
+            "AWS::CloudWatch::Metric",
+            "AWS::Logs::LogGroup"
+          ]
+        },
+        "ForAnyValue:StringLike": {
+          "aws:PrincipalOrgPaths": [
+            "o-abcdef1234/r-ab12/ou-ab12-11111111/*"
+          ]
+        }
+      },
+      "Effect": "Allow",
+      "Principal": "*",
+      "Resource": "*"
+    }
+  ]
+}`
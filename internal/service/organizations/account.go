@@ -190,16 +190,8 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if newParentAccountID, oldParentAccountID := v.(string), aws.ToString(oldParentAccountID); newParentAccountID != oldParentAccountID {
-			input := &organizations.MoveAccountInput{
-				AccountId:           aws.String(d.Id()),
-				DestinationParentId: aws.String(newParentAccountID),
-				SourceParentId:      aws.String(oldParentAccountID),
-			}
-
-			_, err := conn.MoveAccount(ctx, input)
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "moving AWS Organizations Account (%s): %s", d.Id(), err)
+			if err := moveAccount(ctx, conn, d.Id(), oldParentAccountID, newParentAccountID); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
 	}
@@ -247,16 +239,8 @@ func resourceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	if d.HasChange("parent_id") {
 		o, n := d.GetChange("parent_id")
 
-		input := &organizations.MoveAccountInput{
-			AccountId:           aws.String(d.Id()),
-			SourceParentId:      aws.String(o.(string)),
-			DestinationParentId: aws.String(n.(string)),
-		}
-
-		_, err := conn.MoveAccount(ctx, input)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "moving AWS Organizations Account (%s): %s", d.Id(), err)
+		if err := moveAccount(ctx, conn, d.Id(), o.(string), n.(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 
@@ -315,6 +299,26 @@ func resourceAccountImportState(ctx context.Context, d *schema.ResourceData, met
 	return []*schema.ResourceData{d}, nil
 }
 
+func moveAccount(ctx context.Context, conn *organizations.Client, id, sourceParentID, destinationParentID string) error {
+	input := &organizations.MoveAccountInput{
+		AccountId:           aws.String(id),
+		DestinationParentId: aws.String(destinationParentID),
+		SourceParentId:      aws.String(sourceParentID),
+	}
+
+	// Concurrent changes elsewhere in the organization (e.g. OU or policy updates) can cause transient failures.
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, accountMoveTimeout,
+		func() (interface{}, error) {
+			return conn.MoveAccount(ctx, input)
+		})
+
+	if err != nil {
+		return fmt.Errorf("moving AWS Organizations Account (%s) from %s to %s: %w", id, sourceParentID, destinationParentID, err)
+	}
+
+	return nil
+}
+
 func findAccountByID(ctx context.Context, conn *organizations.Client, id string) (*awstypes.Account, error) {
 	input := &organizations.DescribeAccountInput{
 		AccountId: aws.String(id),
@@ -9,9 +9,11 @@ import (
 	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -135,20 +137,41 @@ func testAccAccount_ParentID(t *testing.T) {
 		CheckDestroy:             testAccCheckAccountDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
+				// Create moves the new account out of the organization root.
 				Config: testAccAccountConfig_parentId1(name, email),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAccountExists(ctx, resourceName, &v),
+					testAccCheckAccountParentID(ctx, resourceName, parentIdResourceName1),
 					resource.TestCheckResourceAttrPair(resourceName, "parent_id", parentIdResourceName1, names.AttrID),
 				),
 			},
 			testAccAccountImportStep(resourceName),
 			{
 				Config: testAccAccountConfig_parentId2(name, email),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAccountExists(ctx, resourceName, &v),
+					testAccCheckAccountParentID(ctx, resourceName, parentIdResourceName2),
 					resource.TestCheckResourceAttrPair(resourceName, "parent_id", parentIdResourceName2, names.AttrID),
 				),
 			},
+			{
+				Config: testAccAccountConfig_parentIdRoot(name, email),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountExists(ctx, resourceName, &v),
+					testAccCheckAccountParentID(ctx, resourceName, "data.aws_organizations_organization.test"),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_id", "data.aws_organizations_organization.test", "roots.0.id"),
+				),
+			},
 		},
 	})
 }
@@ -282,6 +305,39 @@ func testAccCheckAccountExists(ctx context.Context, n string, v *awstypes.Accoun
 	}
 }
 
+func testAccCheckAccountParentID(ctx context.Context, n, parentResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		rsParent, ok := s.RootModule().Resources[parentResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", parentResourceName)
+		}
+
+		want := rsParent.Primary.ID
+		if v, ok := rsParent.Primary.Attributes["roots.0.id"]; ok {
+			want = v
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsClient(ctx)
+
+		output, err := tforganizations.FindParentAccountID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output); got != want {
+			return fmt.Errorf("Organizations Account (%s) parent = %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccAccountConfig_basic(name, email string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_account" "test" {
@@ -347,6 +403,29 @@ resource "aws_organizations_account" "test" {
 `, name, email)
 }
 
+func testAccAccountConfig_parentIdRoot(name, email string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test1" {
+  name      = "test1"
+  parent_id = data.aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_organizational_unit" "test2" {
+  name      = "test2"
+  parent_id = data.aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_account" "test" {
+  name              = %[1]q
+  email             = %[2]q
+  parent_id         = data.aws_organizations_organization.test.roots[0].id
+  close_on_deletion = true
+}
+`, name, email)
+}
+
 func testAccAccountConfig_tags1(name, email, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_account" "test" {
@@ -8,5 +8,6 @@ import (
 )
 
 const (
+	accountMoveTimeout              = 2 * time.Minute
 	organizationFinalizationTimeout = 4 * time.Minute
 )
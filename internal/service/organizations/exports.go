@@ -6,6 +6,7 @@ package organizations
 // Exports for use in other modules.
 var (
 	DisableServicePrincipal                = disableServicePrincipal
+	FindAccounts                           = findAccounts
 	FindDelegatedAdministratorByTwoPartKey = findDelegatedAdministratorByTwoPartKey
 	FindEnabledServicePrincipalNames       = findEnabledServicePrincipalNames
 	FindOrganization                       = findOrganization
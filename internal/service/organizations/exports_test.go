@@ -15,6 +15,7 @@ var (
 
 	FindAccountByID                  = findAccountByID
 	FindOrganizationalUnitByID       = findOrganizationalUnitByID
+	FindParentAccountID              = findParentAccountID
 	FindPolicyAttachmentByTwoPartKey = findPolicyAttachmentByTwoPartKey
 	FindPolicyByID                   = findPolicyByID
 	FindResourcePolicy               = findResourcePolicy
@@ -337,6 +337,8 @@ func TestAccPipesPipe_logConfiguration_cloudwatchLogsLogDestination(t *testing.T
 					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.level", "INFO"),
 					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.cloudwatch_logs_log_destination.#", acctest.Ct1),
 					resource.TestCheckResourceAttrSet(resourceName, "log_configuration.0.cloudwatch_logs_log_destination.0.log_group_arn"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.include_execution_data.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "log_configuration.0.include_execution_data.*", "ALL"),
 				),
 			},
 			{
@@ -1413,6 +1415,55 @@ func TestAccPipesPipe_sqsSourceBatchJobTarget(t *testing.T) {
 	})
 }
 
+func TestAccPipesPipe_sqsSourceTimestreamTarget(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pipe pipes.DescribePipeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pipes_pipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PipesEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PipesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipeConfig_basicSQSSourceTimestreamTarget(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipeExists(ctx, resourceName, &pipe),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "pipes", regexache.MustCompile(regexp.QuoteMeta(`pipe/`+rName))),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTarget, "aws_timestreamwrite_table.target", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.batch_job_parameters.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.sqs_queue_parameters.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.dimension_mapping.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.dimension_mapping.0.dimension_name", "device"),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.dimension_mapping.0.dimension_value", "$.data.device"),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.dimension_mapping.0.dimension_value_type", "VARCHAR"),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.epoch_time_unit", "SECONDS"),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.multi_measure_mapping.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.multi_measure_mapping.0.multi_measure_attribute_mapping.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.multi_measure_mapping.0.multi_measure_name", "metrics"),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.single_measure_mapping.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.time_field_type", "EPOCH"),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.time_value", "$.data.time"),
+					resource.TestCheckResourceAttr(resourceName, "target_parameters.0.timestream_parameters.0.version_value", "$.data.version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccPipesPipe_sqsSourceECSTaskTarget(t *testing.T) {
 	acctest.Skip(t, "ValidationException: [numeric instance is lower than the required minimum (minimum: 1, found: 0)]")
 
@@ -1949,7 +2000,8 @@ resource "aws_pipes_pipe" "test" {
   source   = aws_sqs_queue.source.arn
   target   = aws_sqs_queue.target.arn
   log_configuration {
-    level = "INFO"
+    level                  = "INFO"
+    include_execution_data = ["ALL"]
     cloudwatch_logs_log_destination {
       log_group_arn = aws_cloudwatch_log_group.target.arn
     }
@@ -3137,6 +3189,89 @@ resource "aws_pipes_pipe" "test" {
 `, rName))
 }
 
+func testAccPipeConfig_basicSQSSourceTimestreamTarget(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPipeConfig_base(rName),
+		testAccPipeConfig_baseSQSSource(rName),
+		fmt.Sprintf(`
+resource "aws_iam_role_policy" "target" {
+  role = aws_iam_role.test.id
+  name = "%[1]s-target"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect = "Allow"
+        Action = [
+          "timestream:WriteRecords",
+        ],
+        Resource = [
+          aws_timestreamwrite_table.target.arn,
+        ]
+      },
+      {
+        Effect = "Allow"
+        Action = [
+          "timestream:DescribeEndpoints",
+        ],
+        Resource = "*"
+      },
+    ]
+  })
+}
+
+resource "aws_timestreamwrite_database" "target" {
+  database_name = "%[1]s-target"
+}
+
+resource "aws_timestreamwrite_table" "target" {
+  database_name = aws_timestreamwrite_database.target.database_name
+  table_name    = "%[1]s-target"
+}
+
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_timestreamwrite_table.target.arn
+
+  target_parameters {
+    timestream_parameters {
+      epoch_time_unit = "SECONDS"
+      time_field_type = "EPOCH"
+      time_value      = "$.data.time"
+      version_value   = "$.data.version"
+
+      dimension_mapping {
+        dimension_name       = "device"
+        dimension_value      = "$.data.device"
+        dimension_value_type = "VARCHAR"
+      }
+
+      multi_measure_mapping {
+        multi_measure_name = "metrics"
+
+        multi_measure_attribute_mapping {
+          measure_value                = "$.data.temperature"
+          measure_value_type           = "DOUBLE"
+          multi_measure_attribute_name = "temperature"
+        }
+
+        multi_measure_attribute_mapping {
+          measure_value                = "$.data.humidity"
+          measure_value_type           = "DOUBLE"
+          multi_measure_attribute_name = "humidity"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccPipeConfig_basicSQSSourceECSTaskTarget(rName string) string {
 	return acctest.ConfigCompose(
 		testAccPipeConfig_base(rName),
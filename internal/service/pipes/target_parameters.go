@@ -38,6 +38,7 @@ func targetParametersSchema() *schema.Schema {
 						"target_parameters.0.sagemaker_pipeline_parameters",
 						"target_parameters.0.sqs_queue_parameters",
 						"target_parameters.0.step_function_state_machine_parameters",
+						"target_parameters.0.timestream_parameters",
 					},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -172,6 +173,7 @@ func targetParametersSchema() *schema.Schema {
 						"target_parameters.0.sagemaker_pipeline_parameters",
 						"target_parameters.0.sqs_queue_parameters",
 						"target_parameters.0.step_function_state_machine_parameters",
+						"target_parameters.0.timestream_parameters",
 					},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -206,6 +208,7 @@ func targetParametersSchema() *schema.Schema {
 						"target_parameters.0.sagemaker_pipeline_parameters",
 						"target_parameters.0.sqs_queue_parameters",
 						"target_parameters.0.step_function_state_machine_parameters",
+						"target_parameters.0.timestream_parameters",
 					},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -517,6 +520,7 @@ func targetParametersSchema() *schema.Schema {
 						"target_parameters.0.sagemaker_pipeline_parameters",
 						"target_parameters.0.sqs_queue_parameters",
 						"target_parameters.0.step_function_state_machine_parameters",
+						"target_parameters.0.timestream_parameters",
 					},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -575,6 +579,7 @@ func targetParametersSchema() *schema.Schema {
 						"target_parameters.0.sagemaker_pipeline_parameters",
 						"target_parameters.0.sqs_queue_parameters",
 						"target_parameters.0.step_function_state_machine_parameters",
+						"target_parameters.0.timestream_parameters",
 					},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -619,6 +624,7 @@ func targetParametersSchema() *schema.Schema {
 						"target_parameters.0.sagemaker_pipeline_parameters",
 						"target_parameters.0.sqs_queue_parameters",
 						"target_parameters.0.step_function_state_machine_parameters",
+						"target_parameters.0.timestream_parameters",
 					},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -645,6 +651,7 @@ func targetParametersSchema() *schema.Schema {
 						"target_parameters.0.sagemaker_pipeline_parameters",
 						"target_parameters.0.sqs_queue_parameters",
 						"target_parameters.0.step_function_state_machine_parameters",
+						"target_parameters.0.timestream_parameters",
 					},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -671,6 +678,7 @@ func targetParametersSchema() *schema.Schema {
 						"target_parameters.0.sagemaker_pipeline_parameters",
 						"target_parameters.0.sqs_queue_parameters",
 						"target_parameters.0.step_function_state_machine_parameters",
+						"target_parameters.0.timestream_parameters",
 					},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -724,6 +732,7 @@ func targetParametersSchema() *schema.Schema {
 						"target_parameters.0.redshift_data_parameters",
 						"target_parameters.0.sqs_queue_parameters",
 						"target_parameters.0.step_function_state_machine_parameters",
+						"target_parameters.0.timestream_parameters",
 					},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -767,6 +776,7 @@ func targetParametersSchema() *schema.Schema {
 						"target_parameters.0.redshift_data_parameters",
 						"target_parameters.0.sagemaker_pipeline_parameters",
 						"target_parameters.0.step_function_state_machine_parameters",
+						"target_parameters.0.timestream_parameters",
 					},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -798,6 +808,7 @@ func targetParametersSchema() *schema.Schema {
 						"target_parameters.0.redshift_data_parameters",
 						"target_parameters.0.sagemaker_pipeline_parameters",
 						"target_parameters.0.sqs_queue_parameters",
+						"target_parameters.0.timestream_parameters",
 					},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
@@ -809,6 +820,143 @@ func targetParametersSchema() *schema.Schema {
 						},
 					},
 				},
+				"timestream_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					ConflictsWith: []string{
+						"target_parameters.0.batch_job_parameters",
+						"target_parameters.0.cloudwatch_logs_parameters",
+						"target_parameters.0.ecs_task_parameters",
+						"target_parameters.0.eventbridge_event_bus_parameters",
+						"target_parameters.0.http_parameters",
+						"target_parameters.0.kinesis_stream_parameters",
+						"target_parameters.0.lambda_function_parameters",
+						"target_parameters.0.redshift_data_parameters",
+						"target_parameters.0.sagemaker_pipeline_parameters",
+						"target_parameters.0.sqs_queue_parameters",
+						"target_parameters.0.step_function_state_machine_parameters",
+					},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"dimension_mapping": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 128,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dimension_name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+										"dimension_value": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 2048),
+										},
+										"dimension_value_type": {
+											Type:             schema.TypeString,
+											Required:         true,
+											ValidateDiagFunc: enum.Validate[types.DimensionValueType](),
+										},
+									},
+								},
+							},
+							"epoch_time_unit": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Computed:         true,
+								ValidateDiagFunc: enum.Validate[types.EpochTimeUnit](),
+							},
+							"multi_measure_mapping": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1024,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"multi_measure_attribute_mapping": {
+											Type:     schema.TypeList,
+											Required: true,
+											MinItems: 1,
+											MaxItems: 256,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"measure_value": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringLenBetween(1, 2048),
+													},
+													"measure_value_type": {
+														Type:             schema.TypeString,
+														Required:         true,
+														ValidateDiagFunc: enum.Validate[types.MeasureValueType](),
+													},
+													"multi_measure_attribute_name": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringLenBetween(1, 256),
+													},
+												},
+											},
+										},
+										"multi_measure_name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+									},
+								},
+							},
+							"single_measure_mapping": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 8192,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"measure_name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 1024),
+										},
+										"measure_value": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 2048),
+										},
+										"measure_value_type": {
+											Type:             schema.TypeString,
+											Required:         true,
+											ValidateDiagFunc: enum.Validate[types.MeasureValueType](),
+										},
+									},
+								},
+							},
+							"time_field_type": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Computed:         true,
+								ValidateDiagFunc: enum.Validate[types.TimeFieldType](),
+							},
+							"time_value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"timestamp_format": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"version_value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -869,6 +1017,10 @@ func expandPipeTargetParameters(tfMap map[string]interface{}) *types.PipeTargetP
 		apiObject.StepFunctionStateMachineParameters = expandPipeTargetStateMachineParameters(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := tfMap["timestream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TimestreamParameters = expandPipeTargetTimestreamParameters(v[0].(map[string]interface{}))
+	}
+
 	return apiObject
 }
 
@@ -1858,6 +2010,236 @@ func expandPipeTargetStateMachineParameters(tfMap map[string]interface{}) *types
 	return apiObject
 }
 
+func expandPipeTargetTimestreamParameters(tfMap map[string]interface{}) *types.PipeTargetTimestreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetTimestreamParameters{}
+
+	if v, ok := tfMap["dimension_mapping"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DimensionMappings = expandDimensionMappings(v)
+	}
+
+	if v, ok := tfMap["epoch_time_unit"].(string); ok && v != "" {
+		apiObject.EpochTimeUnit = types.EpochTimeUnit(v)
+	}
+
+	if v, ok := tfMap["multi_measure_mapping"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MultiMeasureMappings = expandMultiMeasureMappings(v)
+	}
+
+	if v, ok := tfMap["single_measure_mapping"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SingleMeasureMappings = expandSingleMeasureMappings(v)
+	}
+
+	if v, ok := tfMap["time_field_type"].(string); ok && v != "" {
+		apiObject.TimeFieldType = types.TimeFieldType(v)
+	}
+
+	if v, ok := tfMap["time_value"].(string); ok && v != "" {
+		apiObject.TimeValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp_format"].(string); ok && v != "" {
+		apiObject.TimestampFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["version_value"].(string); ok && v != "" {
+		apiObject.VersionValue = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDimensionMapping(tfMap map[string]interface{}) *types.DimensionMapping {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DimensionMapping{}
+
+	if v, ok := tfMap["dimension_name"].(string); ok && v != "" {
+		apiObject.DimensionName = aws.String(v)
+	}
+
+	if v, ok := tfMap["dimension_value"].(string); ok && v != "" {
+		apiObject.DimensionValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["dimension_value_type"].(string); ok && v != "" {
+		apiObject.DimensionValueType = types.DimensionValueType(v)
+	}
+
+	return apiObject
+}
+
+func expandDimensionMappings(tfList []interface{}) []types.DimensionMapping {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.DimensionMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandDimensionMapping(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMultiMeasureMapping(tfMap map[string]interface{}) *types.MultiMeasureMapping {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.MultiMeasureMapping{}
+
+	if v, ok := tfMap["multi_measure_attribute_mapping"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MultiMeasureAttributeMappings = expandMultiMeasureAttributeMappings(v)
+	}
+
+	if v, ok := tfMap["multi_measure_name"].(string); ok && v != "" {
+		apiObject.MultiMeasureName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMultiMeasureMappings(tfList []interface{}) []types.MultiMeasureMapping {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.MultiMeasureMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandMultiMeasureMapping(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMultiMeasureAttributeMapping(tfMap map[string]interface{}) *types.MultiMeasureAttributeMapping {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.MultiMeasureAttributeMapping{}
+
+	if v, ok := tfMap["measure_value"].(string); ok && v != "" {
+		apiObject.MeasureValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["measure_value_type"].(string); ok && v != "" {
+		apiObject.MeasureValueType = types.MeasureValueType(v)
+	}
+
+	if v, ok := tfMap["multi_measure_attribute_name"].(string); ok && v != "" {
+		apiObject.MultiMeasureAttributeName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMultiMeasureAttributeMappings(tfList []interface{}) []types.MultiMeasureAttributeMapping {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.MultiMeasureAttributeMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandMultiMeasureAttributeMapping(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSingleMeasureMapping(tfMap map[string]interface{}) *types.SingleMeasureMapping {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SingleMeasureMapping{}
+
+	if v, ok := tfMap["measure_name"].(string); ok && v != "" {
+		apiObject.MeasureName = aws.String(v)
+	}
+
+	if v, ok := tfMap["measure_value"].(string); ok && v != "" {
+		apiObject.MeasureValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["measure_value_type"].(string); ok && v != "" {
+		apiObject.MeasureValueType = types.MeasureValueType(v)
+	}
+
+	return apiObject
+}
+
+func expandSingleMeasureMappings(tfList []interface{}) []types.SingleMeasureMapping {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.SingleMeasureMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandSingleMeasureMapping(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
 func flattenPipeTargetParameters(apiObject *types.PipeTargetParameters) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -1913,6 +2295,10 @@ func flattenPipeTargetParameters(apiObject *types.PipeTargetParameters) map[stri
 		tfMap["step_function_state_machine_parameters"] = []interface{}{flattenPipeTargetStateMachineParameters(v)}
 	}
 
+	if v := apiObject.TimestreamParameters; v != nil {
+		tfMap["timestream_parameters"] = []interface{}{flattenPipeTargetTimestreamParameters(v)}
+	}
+
 	return tfMap
 }
 
@@ -2699,3 +3085,158 @@ func flattenPipeTargetStateMachineParameters(apiObject *types.PipeTargetStateMac
 
 	return tfMap
 }
+
+func flattenPipeTargetTimestreamParameters(apiObject *types.PipeTargetTimestreamParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"epoch_time_unit": apiObject.EpochTimeUnit,
+		"time_field_type": apiObject.TimeFieldType,
+	}
+
+	if v := apiObject.DimensionMappings; v != nil {
+		tfMap["dimension_mapping"] = flattenDimensionMappings(v)
+	}
+
+	if v := apiObject.MultiMeasureMappings; v != nil {
+		tfMap["multi_measure_mapping"] = flattenMultiMeasureMappings(v)
+	}
+
+	if v := apiObject.SingleMeasureMappings; v != nil {
+		tfMap["single_measure_mapping"] = flattenSingleMeasureMappings(v)
+	}
+
+	if v := apiObject.TimeValue; v != nil {
+		tfMap["time_value"] = aws.ToString(v)
+	}
+
+	if v := apiObject.TimestampFormat; v != nil {
+		tfMap["timestamp_format"] = aws.ToString(v)
+	}
+
+	if v := apiObject.VersionValue; v != nil {
+		tfMap["version_value"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenDimensionMapping(apiObject types.DimensionMapping) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"dimension_value_type": apiObject.DimensionValueType,
+	}
+
+	if v := apiObject.DimensionName; v != nil {
+		tfMap["dimension_name"] = aws.ToString(v)
+	}
+
+	if v := apiObject.DimensionValue; v != nil {
+		tfMap["dimension_value"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenDimensionMappings(apiObjects []types.DimensionMapping) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenDimensionMapping(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenMultiMeasureMapping(apiObject types.MultiMeasureMapping) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MultiMeasureAttributeMappings; v != nil {
+		tfMap["multi_measure_attribute_mapping"] = flattenMultiMeasureAttributeMappings(v)
+	}
+
+	if v := apiObject.MultiMeasureName; v != nil {
+		tfMap["multi_measure_name"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenMultiMeasureMappings(apiObjects []types.MultiMeasureMapping) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenMultiMeasureMapping(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenMultiMeasureAttributeMapping(apiObject types.MultiMeasureAttributeMapping) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"measure_value_type": apiObject.MeasureValueType,
+	}
+
+	if v := apiObject.MeasureValue; v != nil {
+		tfMap["measure_value"] = aws.ToString(v)
+	}
+
+	if v := apiObject.MultiMeasureAttributeName; v != nil {
+		tfMap["multi_measure_attribute_name"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenMultiMeasureAttributeMappings(apiObjects []types.MultiMeasureAttributeMapping) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenMultiMeasureAttributeMapping(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenSingleMeasureMapping(apiObject types.SingleMeasureMapping) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"measure_value_type": apiObject.MeasureValueType,
+	}
+
+	if v := apiObject.MeasureName; v != nil {
+		tfMap["measure_name"] = aws.ToString(v)
+	}
+
+	if v := apiObject.MeasureValue; v != nil {
+		tfMap["measure_value"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenSingleMeasureMappings(apiObjects []types.SingleMeasureMapping) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenSingleMeasureMapping(apiObject))
+	}
+
+	return tfList
+}
@@ -197,6 +197,10 @@ func resourceLedgerDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return diags
 	}
 
+	if errs.IsA[*types.ResourcePreconditionNotMetException](err) && d.Get(names.AttrDeletionProtection).(bool) {
+		return sdkdiag.AppendErrorf(diags, "deleting QLDB Ledger (%s): deletion protection is enabled, set deletion_protection to false and apply before destroying: %s", d.Id(), err)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting QLDB Ledger (%s): %s", d.Id(), err)
 	}
@@ -5,14 +5,17 @@ package ram
 
 // Exports for use in tests only.
 var (
+	ResourcePermission              = resourcePermission
 	ResourcePrincipalAssociation    = resourcePrincipalAssociation
 	ResourceResourceAssociation     = resourceResourceAssociation
 	ResourceResourceShare           = resourceResourceShare
 	ResourceResourceShareAccepter   = resourceResourceShareAccepter
 	ResourceSharingWithOrganization = resourceSharingWithOrganization
 
+	FindPermissionByARN                      = findPermissionByARN
 	FindPrincipalAssociationByTwoPartKey     = findPrincipalAssociationByTwoPartKey
 	FindResourceAssociationByTwoPartKey      = findResourceAssociationByTwoPartKey
+	FindResourceSharePermissionsByARN        = findResourceSharePermissionsByARN
 	FindResourceShareOwnerOtherAccountsByARN = findResourceShareOwnerOtherAccountsByARN
 	FindResourceShareOwnerSelfByARN          = findResourceShareOwnerSelfByARN
 	FindSharingWithOrganization              = findSharingWithOrganization
@@ -0,0 +1,513 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// RAM retains at most this many versions of a customer managed permission.
+	permissionMaxVersions = 5
+)
+
+// @SDKResource("aws_ram_permission", name="Permission")
+// @Tags
+func resourcePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionCreate,
+		ReadWithoutTimeout:   resourcePermissionRead,
+		UpdateWithoutTimeout: resourcePermissionUpdate,
+		DeleteWithoutTimeout: resourcePermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrLastUpdatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 36),
+					validation.StringMatch(regexache.MustCompile(`^[\w.-]+$`), "must contain only alphanumeric characters, hyphens, periods and underscores"),
+				),
+			},
+			"permission_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_template": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"replace_permission_associations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			names.AttrResourceType: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy_template").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name := d.Get(names.AttrName).(string)
+	input := &ram.CreatePermissionInput{
+		Name:           aws.String(name),
+		PolicyTemplate: aws.String(policy),
+		ResourceType:   aws.String(d.Get(names.AttrResourceType).(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	output, err := conn.CreatePermission(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RAM Permission (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Permission.Arn))
+
+	return append(diags, resourcePermissionRead(ctx, d, meta)...)
+}
+
+func resourcePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	permission, err := findPermissionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RAM Permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, permission.Arn)
+	d.Set(names.AttrCreationTime, aws.ToTime(permission.CreationTime).Format(time.RFC3339))
+	d.Set(names.AttrLastUpdatedTime, aws.ToTime(permission.LastUpdatedTime).Format(time.RFC3339))
+	d.Set(names.AttrName, permission.Name)
+	d.Set("permission_type", permission.PermissionType)
+	d.Set(names.AttrResourceType, permission.ResourceType)
+	d.Set(names.AttrStatus, permission.Status)
+	d.Set(names.AttrVersion, permission.Version)
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy_template").(string), aws.ToString(permission.Permission))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set("policy_template", policyToSet)
+
+	setTagsOut(ctx, permission.Tags)
+
+	return diags
+}
+
+func resourcePermissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	if d.HasChange("policy_template") {
+		policy, err := structure.NormalizeJsonString(d.Get("policy_template").(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		fromVersion, err := strconv.ParseInt(d.Get(names.AttrVersion).(string), 10, 32)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// Make room for the new version by removing the oldest non-default version.
+		if err := pruneOldPermissionVersions(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting RAM Permission (%s) versions: %s", d.Id(), err)
+		}
+
+		input := &ram.CreatePermissionVersionInput{
+			PermissionArn:  aws.String(d.Id()),
+			PolicyTemplate: aws.String(policy),
+		}
+
+		output, err := conn.CreatePermissionVersion(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating RAM Permission (%s) version: %s", d.Id(), err)
+		}
+
+		toVersion, err := strconv.ParseInt(aws.ToString(output.Permission.Version), 10, 32)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		_, err = conn.SetDefaultPermissionVersion(ctx, &ram.SetDefaultPermissionVersionInput{
+			PermissionArn:     aws.String(d.Id()),
+			PermissionVersion: aws.Int32(int32(toVersion)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting RAM Permission (%s) default version (%d): %s", d.Id(), toVersion, err)
+		}
+
+		if d.Get("replace_permission_associations").(bool) {
+			if err := replacePermissionAssociations(ctx, conn, d.Id(), int32(fromVersion), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "replacing RAM Permission (%s) version (%d) associations: %s", d.Id(), fromVersion, err)
+			}
+		}
+	}
+
+	if d.HasChange(names.AttrTagsAll) {
+		o, n := d.GetChange(names.AttrTagsAll)
+
+		if err := permissionUpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RAM Permission (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePermissionRead(ctx, d, meta)...)
+}
+
+func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	log.Printf("[DEBUG] Deleting RAM Permission: %s", d.Id())
+	_, err := conn.DeletePermission(ctx, &ram.DeletePermissionInput{
+		PermissionArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.UnknownResourceException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RAM Permission (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPermissionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// pruneOldPermissionVersions deletes the oldest non-default version of the specified permission
+// if the permission already has the maximum number of versions.
+func pruneOldPermissionVersions(ctx context.Context, conn *ram.Client, arn string) error {
+	versions, err := findPermissionVersionsByARN(ctx, conn, arn)
+
+	if err != nil {
+		return err
+	}
+
+	if len(versions) < permissionMaxVersions {
+		return nil
+	}
+
+	versions = slices.DeleteFunc(versions, func(v awstypes.ResourceSharePermissionSummary) bool {
+		return aws.ToBool(v.DefaultVersion)
+	})
+	if len(versions) == 0 {
+		return nil
+	}
+
+	oldest := slices.MinFunc(versions, func(a, b awstypes.ResourceSharePermissionSummary) int {
+		return aws.ToTime(a.CreationTime).Compare(aws.ToTime(b.CreationTime))
+	})
+
+	version, err := strconv.ParseInt(aws.ToString(oldest.Version), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.DeletePermissionVersion(ctx, &ram.DeletePermissionVersionInput{
+		PermissionArn:     aws.String(arn),
+		PermissionVersion: aws.Int32(int32(version)),
+	})
+
+	return err
+}
+
+// replacePermissionAssociations moves all resource shares using the specified version of a permission
+// to the permission's current default version.
+func replacePermissionAssociations(ctx context.Context, conn *ram.Client, arn string, fromVersion int32, timeout time.Duration) error {
+	input := &ram.ReplacePermissionAssociationsInput{
+		FromPermissionArn:     aws.String(arn),
+		FromPermissionVersion: aws.Int32(fromVersion),
+		ToPermissionArn:       aws.String(arn),
+	}
+
+	output, err := conn.ReplacePermissionAssociations(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = waitReplacePermissionAssociationsWorkCompleted(ctx, conn, aws.ToString(output.ReplacePermissionAssociationsWork.Id), timeout)
+
+	return err
+}
+
+// permissionUpdateTags updates RAM permission tags.
+// The generated updateTags identifies resources by resource share ARN only.
+func permissionUpdateTags(ctx context.Context, conn *ram.Client, identifier string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	removedTags := oldTags.Removed(newTags)
+	removedTags = removedTags.IgnoreSystem(names.RAM)
+	if len(removedTags) > 0 {
+		input := &ram.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     removedTags.Keys(),
+		}
+
+		_, err := conn.UntagResource(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	updatedTags := oldTags.Updated(newTags)
+	updatedTags = updatedTags.IgnoreSystem(names.RAM)
+	if len(updatedTags) > 0 {
+		input := &ram.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        Tags(updatedTags),
+		}
+
+		_, err := conn.TagResource(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+func findPermissionByARN(ctx context.Context, conn *ram.Client, arn string) (*awstypes.ResourceSharePermissionDetail, error) {
+	input := &ram.GetPermissionInput{
+		PermissionArn: aws.String(arn),
+	}
+
+	output, err := conn.GetPermission(ctx, input)
+
+	if errs.IsA[*awstypes.UnknownResourceException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Permission == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Permission.Status; status == awstypes.PermissionStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.Permission, nil
+}
+
+func findPermissionVersionsByARN(ctx context.Context, conn *ram.Client, arn string) ([]awstypes.ResourceSharePermissionSummary, error) {
+	input := &ram.ListPermissionVersionsInput{
+		PermissionArn: aws.String(arn),
+	}
+	var output []awstypes.ResourceSharePermissionSummary
+
+	pages := ram.NewListPermissionVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.UnknownResourceException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Permissions...)
+	}
+
+	return output, nil
+}
+
+func findReplacePermissionAssociationsWorkByID(ctx context.Context, conn *ram.Client, id string) (*awstypes.ReplacePermissionAssociationsWork, error) {
+	input := &ram.ListReplacePermissionAssociationsWorkInput{
+		WorkIds: []string{id},
+	}
+	var output []awstypes.ReplacePermissionAssociationsWork
+
+	pages := ram.NewListReplacePermissionAssociationsWorkPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ReplacePermissionAssociationsWorks...)
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func statusPermission(ctx context.Context, conn *ram.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPermissionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusReplacePermissionAssociationsWork(ctx context.Context, conn *ram.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReplacePermissionAssociationsWorkByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitPermissionDeleted(ctx context.Context, conn *ram.Client, arn string, timeout time.Duration) (*awstypes.ResourceSharePermissionDetail, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PermissionStatusAttachable, awstypes.PermissionStatusUnattachable, awstypes.PermissionStatusDeleting),
+		Target:  []string{},
+		Refresh: statusPermission(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ResourceSharePermissionDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplacePermissionAssociationsWorkCompleted(ctx context.Context, conn *ram.Client, id string, timeout time.Duration) (*awstypes.ReplacePermissionAssociationsWork, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ReplacePermissionAssociationsWorkStatusInProgress),
+		Target:  enum.Slice(awstypes.ReplacePermissionAssociationsWorkStatusCompleted),
+		Refresh: statusReplacePermissionAssociationsWork(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ReplacePermissionAssociationsWork); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
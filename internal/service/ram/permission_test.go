@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfram "github.com/hashicorp/terraform-provider-aws/internal/service/ram"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRAMPermission_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permission awstypes.ResourceSharePermissionDetail
+	resourceName := "aws_ram_permission.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionConfig_basic(rName, `"ec2:DescribeSubnets"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPermissionExists(ctx, resourceName, &permission),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ram", regexache.MustCompile(`permission/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "permission_type", "CUSTOMER_MANAGED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "ec2:Subnet"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ATTACHABLE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace_permission_associations"},
+			},
+		},
+	})
+}
+
+func TestAccRAMPermission_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permission awstypes.ResourceSharePermissionDetail
+	resourceName := "aws_ram_permission.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionConfig_basic(rName, `"ec2:DescribeSubnets"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionExists(ctx, resourceName, &permission),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfram.ResourcePermission(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRAMPermission_policyTemplate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permission awstypes.ResourceSharePermissionDetail
+	resourceName := "aws_ram_permission.test"
+	resourceShareResourceName := "aws_ram_resource_share.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionConfig_resourceShare(rName, `"ec2:DescribeSubnets"`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPermissionExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceShareResourceName, "permission_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceShareResourceName, "permission_arns.*", resourceName, names.AttrARN),
+					testAccCheckResourceSharePermissionVersion(ctx, resourceShareResourceName, resourceName, acctest.Ct1),
+				),
+			},
+			{
+				// The resource share is moved to the new default version.
+				Config: testAccPermissionConfig_resourceShare(rName, `"ec2:DescribeSubnets", "ec2:DescribeSubnetAttribute"`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPermissionExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceShareResourceName, "permission_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceShareResourceName, "permission_arns.*", resourceName, names.AttrARN),
+					testAccCheckResourceSharePermissionVersion(ctx, resourceShareResourceName, resourceName, acctest.Ct2),
+				),
+			},
+			{
+				// The resource share stays on the previous version.
+				Config: testAccPermissionConfig_resourceShare(rName, `"ec2:DescribeSubnets"`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPermissionExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "replace_permission_associations", acctest.CtFalse),
+					testAccCheckResourceSharePermissionVersion(ctx, resourceShareResourceName, resourceName, acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRAMPermission_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permission awstypes.ResourceSharePermissionDetail
+	resourceName := "aws_ram_permission.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace_permission_associations"},
+			},
+			{
+				Config: testAccPermissionConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccPermissionConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionExists(ctx context.Context, n string, v *awstypes.ResourceSharePermissionDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMClient(ctx)
+
+		output, err := tfram.FindPermissionByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckResourceSharePermissionVersion(ctx context.Context, resourceShareResourceName, permissionResourceName, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rsResourceShare, ok := s.RootModule().Resources[resourceShareResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceShareResourceName)
+		}
+
+		rsPermission, ok := s.RootModule().Resources[permissionResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", permissionResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMClient(ctx)
+
+		output, err := tfram.FindResourceSharePermissionsByARN(ctx, conn, rsResourceShare.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output {
+			if aws.ToString(v.Arn) != rsPermission.Primary.ID {
+				continue
+			}
+
+			if got := aws.ToString(v.Version); got != want {
+				return fmt.Errorf("RAM Resource Share (%s) Permission (%s) version = %s, want %s", rsResourceShare.Primary.ID, rsPermission.Primary.ID, got, want)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("RAM Resource Share (%s) Permission (%s) not associated", rsResourceShare.Primary.ID, rsPermission.Primary.ID)
+	}
+}
+
+func testAccCheckPermissionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ram_permission" {
+				continue
+			}
+
+			_, err := tfram.FindPermissionByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RAM Permission %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPermissionConfig_basic(rName, actions string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_permission" "test" {
+  name          = %[1]q
+  resource_type = "ec2:Subnet"
+
+  policy_template = jsonencode({
+    Effect = "Allow"
+    Action = [%[2]s]
+  })
+}
+`, rName, actions)
+}
+
+func testAccPermissionConfig_resourceShare(rName, actions string, replace bool) string {
+	return fmt.Sprintf(`
+resource "aws_ram_permission" "test" {
+  name          = %[1]q
+  resource_type = "ec2:Subnet"
+
+  policy_template = jsonencode({
+    Effect = "Allow"
+    Action = [%[2]s]
+  })
+
+  replace_permission_associations = %[3]t
+}
+
+resource "aws_ram_resource_share" "test" {
+  name            = %[1]q
+  permission_arns = [aws_ram_permission.test.arn]
+}
+`, rName, actions, replace)
+}
+
+func testAccPermissionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_permission" "test" {
+  name          = %[1]q
+  resource_type = "ec2:Subnet"
+
+  policy_template = jsonencode({
+    Effect = "Allow"
+    Action = ["ec2:DescribeSubnets"]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccPermissionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_permission" "test" {
+  name          = %[1]q
+  resource_type = "ec2:Subnet"
+
+  policy_template = jsonencode({
+    Effect = "Allow"
+    Action = ["ec2:DescribeSubnets"]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -46,6 +47,7 @@ func resourceResourceShare() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -67,7 +69,6 @@ func resourceResourceShare() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: verify.ValidARN,
@@ -141,20 +142,10 @@ func resourceResourceShareRead(ctx context.Context, d *schema.ResourceData, meta
 
 	setTagsOut(ctx, resourceShare.Tags)
 
-	input := &ram.ListResourceSharePermissionsInput{
-		ResourceShareArn: aws.String(d.Id()),
-	}
-	var permissions []awstypes.ResourceSharePermissionSummary
-
-	pages := ram.NewListResourceSharePermissionsPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+	permissions, err := findResourceSharePermissionsByARN(ctx, conn, d.Id())
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s) permissions: %s", d.Id(), err)
-		}
-
-		permissions = append(permissions, page.Permissions...)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s) permissions: %s", d.Id(), err)
 	}
 
 	permissionARNs := tfslices.ApplyToAll(permissions, func(r awstypes.ResourceSharePermissionSummary) string {
@@ -183,6 +174,61 @@ func resourceResourceShareUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("permission_arns") {
+		o, n := d.GetChange("permission_arns")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		// Associate new permissions first, replacing any existing permission for the same resource type.
+		for _, arn := range add {
+			input := &ram.AssociateResourceSharePermissionInput{
+				PermissionArn:    aws.String(arn),
+				Replace:          aws.Bool(true),
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			_, err := conn.AssociateResourceSharePermission(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating RAM Resource Share (%s) permission (%s): %s", d.Id(), arn, err)
+			}
+		}
+
+		// Permissions replaced above are no longer associated.
+		permissions, err := findResourceSharePermissionsByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s) permissions: %s", d.Id(), err)
+		}
+
+		del = slices.DeleteFunc(del, func(arn string) bool {
+			return !slices.ContainsFunc(permissions, func(v awstypes.ResourceSharePermissionSummary) bool {
+				return aws.ToString(v.Arn) == arn
+			})
+		})
+
+		for _, arn := range del {
+			input := &ram.DisassociateResourceSharePermissionInput{
+				PermissionArn:    aws.String(arn),
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			_, err := conn.DisassociateResourceSharePermission(ctx, input)
+
+			if errs.IsA[*awstypes.UnknownResourceException](err) {
+				continue
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating RAM Resource Share (%s) permission (%s): %s", d.Id(), arn, err)
+			}
+		}
+
+		if _, err := waitResourceShareOwnedBySelfActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RAM Resource Share (%s) update: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceResourceShareRead(ctx, d, meta)...)
 }
 
@@ -265,6 +311,26 @@ func findResourceShares(ctx context.Context, conn *ram.Client, input *ram.GetRes
 	return output, nil
 }
 
+func findResourceSharePermissionsByARN(ctx context.Context, conn *ram.Client, arn string) ([]awstypes.ResourceSharePermissionSummary, error) {
+	input := &ram.ListResourceSharePermissionsInput{
+		ResourceShareArn: aws.String(arn),
+	}
+	var output []awstypes.ResourceSharePermissionSummary
+
+	pages := ram.NewListResourceSharePermissionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Permissions...)
+	}
+
+	return output, nil
+}
+
 func statusResourceShareOwnerSelf(ctx context.Context, conn *ram.Client, arn string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findResourceShareOwnerSelfByARN(ctx, conn, arn)
@@ -36,6 +36,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourcePermission,
+			TypeName: "aws_ram_permission",
+			Name:     "Permission",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  resourcePrincipalAssociation,
 			TypeName: "aws_ram_principal_association",
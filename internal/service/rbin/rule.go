@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rbin/types"
 	awsarn "github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -150,7 +151,10 @@ func ResourceRule() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceRuleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -172,6 +176,10 @@ func resourceRuleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		in.Description = aws.String(d.Get(names.AttrDescription).(string))
 	}
 
+	if v, ok := d.GetOk("lock_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.LockConfiguration = expandLockConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk(names.AttrResourceTags); ok && v.(*schema.Set).Len() > 0 {
 		in.ResourceTags = expandResourceTags(v.(*schema.Set).List())
 	}
@@ -220,6 +228,20 @@ func resourceRuleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set(names.AttrARN, ruleArn)
 
 	d.Set(names.AttrDescription, out.Description)
+	// A rule that is pending unlock still reports its lock configuration.
+	if out.LockConfiguration != nil && out.LockState == types.LockStateLocked {
+		if err := d.Set("lock_configuration", []interface{}{flattenLockConfiguration(out.LockConfiguration)}); err != nil {
+			return create.AppendDiagError(diags, names.RBin, create.ErrActionSetting, ResNameRule, d.Id(), err)
+		}
+	} else {
+		d.Set("lock_configuration", nil)
+	}
+	if out.LockEndTime != nil {
+		d.Set("lock_end_time", aws.ToTime(out.LockEndTime).Format(time.RFC3339))
+	} else {
+		d.Set("lock_end_time", nil)
+	}
+	d.Set("lock_state", string(out.LockState))
 	d.Set(names.AttrResourceType, string(out.ResourceType))
 	d.Set(names.AttrStatus, string(out.Status))
 
@@ -238,6 +260,23 @@ func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RBinClient(ctx)
 
+	// Removing the lock configuration unlocks the rule. The rule is then pending unlock and can't be
+	// modified until the unlock delay expires, so CustomizeDiff rejects any other change in the same apply.
+	if d.HasChange("lock_configuration") {
+		if v, ok := d.GetOk("lock_configuration"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+			_, err := conn.UnlockRule(ctx, &rbin.UnlockRuleInput{
+				Identifier: aws.String(d.Id()),
+			})
+			if err != nil {
+				return create.AppendDiagError(diags, names.RBin, create.ErrActionUpdating, ResNameRule, d.Id(), fmt.Errorf("unlocking: %w", err))
+			}
+
+			if _, err := waitRuleUnlocked(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.AppendDiagError(diags, names.RBin, create.ErrActionWaitingForUpdate, ResNameRule, d.Id(), fmt.Errorf("unlocking: %w", err))
+			}
+		}
+	}
+
 	update := false
 
 	in := &rbin.UpdateRuleInput{
@@ -259,23 +298,55 @@ func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		update = true
 	}
 
-	if !update {
-		return diags
-	}
+	if update {
+		log.Printf("[DEBUG] Updating RBin Rule (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateRule(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.RBin, create.ErrActionUpdating, ResNameRule, d.Id(), err)
+		}
 
-	log.Printf("[DEBUG] Updating RBin Rule (%s): %#v", d.Id(), in)
-	out, err := conn.UpdateRule(ctx, in)
-	if err != nil {
-		return create.AppendDiagError(diags, names.RBin, create.ErrActionUpdating, ResNameRule, d.Id(), err)
+		if _, err := waitRuleUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.RBin, create.ErrActionWaitingForUpdate, ResNameRule, d.Id(), err)
+		}
 	}
 
-	if _, err := waitRuleUpdated(ctx, conn, aws.ToString(out.Identifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return create.AppendDiagError(diags, names.RBin, create.ErrActionWaitingForUpdate, ResNameRule, d.Id(), err)
+	// Lock the rule last so that the update above is applied while it's still unlocked.
+	if d.HasChange("lock_configuration") {
+		if v, ok := d.GetOk("lock_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			_, err := conn.LockRule(ctx, &rbin.LockRuleInput{
+				Identifier:        aws.String(d.Id()),
+				LockConfiguration: expandLockConfiguration(v.([]interface{})[0].(map[string]interface{})),
+			})
+			if err != nil {
+				return create.AppendDiagError(diags, names.RBin, create.ErrActionUpdating, ResNameRule, d.Id(), fmt.Errorf("locking: %w", err))
+			}
+
+			if _, err := waitRuleLocked(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.AppendDiagError(diags, names.RBin, create.ErrActionWaitingForUpdate, ResNameRule, d.Id(), fmt.Errorf("locking: %w", err))
+			}
+		}
 	}
 
 	return append(diags, resourceRuleRead(ctx, d, meta)...)
 }
 
+func resourceRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The lock configuration of a locked rule can't be modified, only removed (which unlocks the rule).
+	if diff.Id() != "" && diff.HasChange("lock_configuration") && types.LockState(diff.Get("lock_state").(string)) == types.LockStateLocked {
+		o, n := diff.GetChange("lock_configuration")
+		if len(o.([]interface{})) > 0 && len(n.([]interface{})) > 0 {
+			return errors.New("lock_configuration of a locked RBin Rule can't be modified; remove the lock_configuration block to unlock the rule first")
+		}
+
+		// Unlocking leaves the rule pending unlock, so other changes can't be applied together with it.
+		if len(n.([]interface{})) == 0 && diff.HasChanges(names.AttrDescription, names.AttrResourceTags, names.AttrRetentionPeriod) {
+			return errors.New("removing lock_configuration unlocks the RBin Rule, which can't be modified until the unlock delay expires; remove the lock_configuration block in a separate apply")
+		}
+	}
+
+	return nil
+}
+
 func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RBinClient(ctx)
@@ -353,6 +424,41 @@ func waitRuleDeleted(ctx context.Context, conn *rbin.Client, id string, timeout
 	return nil, err
 }
 
+func waitRuleLocked(ctx context.Context, conn *rbin.Client, id string, timeout time.Duration) (*rbin.GetRuleOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.LockStateUnlocked, types.LockStatePendingUnlock),
+		Target:                    enum.Slice(types.LockStateLocked),
+		Refresh:                   statusRuleLock(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*rbin.GetRuleOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+// waitRuleUnlocked waits for an unlock request to be accepted.
+// The rule stays in the pending_unlock state until the unlock delay has passed.
+func waitRuleUnlocked(ctx context.Context, conn *rbin.Client, id string, timeout time.Duration) (*rbin.GetRuleOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.LockStateLocked),
+		Target:  enum.Slice(types.LockStatePendingUnlock, types.LockStateUnlocked),
+		Refresh: statusRuleLock(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*rbin.GetRuleOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
 func statusRule(ctx context.Context, conn *rbin.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		out, err := findRuleByID(ctx, conn, id)
@@ -368,6 +474,21 @@ func statusRule(ctx context.Context, conn *rbin.Client, id string) retry.StateRe
 	}
 }
 
+func statusRuleLock(ctx context.Context, conn *rbin.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findRuleByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.LockState), nil
+	}
+}
+
 func findRuleByID(ctx context.Context, conn *rbin.Client, id string) (*rbin.GetRuleOutput, error) {
 	in := &rbin.GetRuleInput{
 		Identifier: aws.String(id),
@@ -421,6 +542,10 @@ func flattenResourceTags(rTags []types.ResourceTag) []interface{} {
 }
 
 func flattenRetentionPeriod(retPeriod *types.RetentionPeriod) []interface{} {
+	if retPeriod == nil {
+		return nil
+	}
+
 	m := map[string]interface{}{}
 
 	if v := retPeriod.RetentionPeriodUnit; v != "" {
@@ -496,3 +621,59 @@ func expandRetentionPeriod(tfList []interface{}) *types.RetentionPeriod {
 
 	return &a
 }
+
+func expandLockConfiguration(tfMap map[string]interface{}) *types.LockConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &types.LockConfiguration{}
+
+	if v, ok := tfMap["unlock_delay"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.UnlockDelay = expandUnlockDelay(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandUnlockDelay(tfMap map[string]interface{}) *types.UnlockDelay {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &types.UnlockDelay{}
+
+	if v, ok := tfMap["unlock_delay_unit"].(string); ok && v != "" {
+		a.UnlockDelayUnit = types.UnlockDelayUnit(v)
+	}
+
+	if v, ok := tfMap["unlock_delay_value"].(int); ok {
+		a.UnlockDelayValue = aws.Int32(int32(v))
+	}
+
+	return a
+}
+
+func flattenLockConfiguration(lockConfig *types.LockConfiguration) map[string]interface{} {
+	m := map[string]interface{}{}
+
+	if v := lockConfig.UnlockDelay; v != nil {
+		m["unlock_delay"] = []interface{}{flattenUnlockDelay(v)}
+	}
+
+	return m
+}
+
+func flattenUnlockDelay(unlockDelay *types.UnlockDelay) map[string]interface{} {
+	m := map[string]interface{}{}
+
+	if v := unlockDelay.UnlockDelayUnit; v != "" {
+		m["unlock_delay_unit"] = string(v)
+	}
+
+	if v := unlockDelay.UnlockDelayValue; v != nil {
+		m["unlock_delay_value"] = aws.ToInt32(v)
+	}
+
+	return m
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rbin"
 	"github.com/aws/aws-sdk-go-v2/service/rbin/types"
@@ -182,6 +183,59 @@ func TestAccRBinRule_lock_config(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "lock_configuration.0.unlock_delay.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "lock_configuration.0.unlock_delay.0.unlock_delay_unit", "DAYS"),
 					resource.TestCheckResourceAttr(resourceName, "lock_configuration.0.unlock_delay.0.unlock_delay_value", "7"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", string(types.LockStateLocked)),
+				),
+			},
+			{
+				Config:      testAccRuleConfig_lockConfig(resourceType, "DAYS", "8"),
+				ExpectError: regexache.MustCompile(`lock_configuration of a locked RBin Rule can't be modified`),
+			},
+		},
+	})
+}
+
+func TestAccRBinRule_lockUnlock(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule rbin.GetRuleOutput
+	resourceType := "EBS_SNAPSHOT"
+	resourceName := "aws_rbin_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, rbin.ServiceID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rbin.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_unlocked(resourceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "lock_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", string(types.LockStateUnlocked)),
+				),
+			},
+			{
+				Config: testAccRuleConfig_lockConfig(resourceType, "DAYS", "7"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "lock_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", string(types.LockStateLocked)),
+				),
+			},
+			{
+				Config:      testAccRuleConfig_unlockedDescription(resourceType, "unlocked"),
+				ExpectError: regexache.MustCompile(`removing lock_configuration unlocks the RBin Rule`),
+			},
+			{
+				Config: testAccRuleConfig_unlocked(resourceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "lock_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", string(types.LockStatePendingUnlock)),
+					resource.TestCheckResourceAttrSet(resourceName, "lock_end_time"),
 				),
 			},
 		},
@@ -305,6 +359,33 @@ resource "aws_rbin_rule" "test" {
 `, resourceType, delay_unit1, delay_value1)
 }
 
+func testAccRuleConfig_unlocked(resourceType string) string {
+	return fmt.Sprintf(`
+resource "aws_rbin_rule" "test" {
+  resource_type = %[1]q
+
+  retention_period {
+    retention_period_value = 10
+    retention_period_unit  = "DAYS"
+  }
+}
+`, resourceType)
+}
+
+func testAccRuleConfig_unlockedDescription(resourceType, description string) string {
+	return fmt.Sprintf(`
+resource "aws_rbin_rule" "test" {
+  description   = %[2]q
+  resource_type = %[1]q
+
+  retention_period {
+    retention_period_value = 10
+    retention_period_unit  = "DAYS"
+  }
+}
+`, resourceType, description)
+}
+
 func testAccRuleConfigTags1(resourceType, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_rbin_rule" "test" {
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rbin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rbin"
+	"github.com/aws/aws-sdk-go-v2/service/rbin/types"
+	awsarn "github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_rbin_rules", name="Rules")
+func DataSourceRules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"lock_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.LockState](),
+			},
+			names.AttrResourceTags: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_tag_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource_tag_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrResourceType: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.ResourceType](),
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lock_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRetentionPeriod: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"retention_period_unit": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"retention_period_value": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	DSNameRules = "Rules Data Source"
+)
+
+func dataSourceRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := client.RBinClient(ctx)
+
+	resourceType := d.Get(names.AttrResourceType).(string)
+	in := &rbin.ListRulesInput{
+		ResourceType: types.ResourceType(resourceType),
+	}
+
+	if v, ok := d.GetOk("lock_state"); ok {
+		in.LockState = types.LockState(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrResourceTags); ok && v.(*schema.Set).Len() > 0 {
+		in.ResourceTags = expandResourceTags(v.(*schema.Set).List())
+	}
+
+	out, err := findRules(ctx, conn, in)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.RBin, create.ErrActionReading, DSNameRules, resourceType, err)
+	}
+
+	var ids []string
+	var rules []interface{}
+
+	for _, v := range out {
+		id := aws.ToString(v.Identifier)
+		ids = append(ids, id)
+
+		rules = append(rules, map[string]interface{}{
+			names.AttrARN: awsarn.ARN{
+				Partition: client.Partition,
+				Service:   rbin.ServiceID,
+				Region:    client.Region,
+				AccountID: client.AccountID,
+				Resource:  fmt.Sprintf("rule/%s", id),
+			}.String(),
+			names.AttrDescription:     aws.ToString(v.Description),
+			names.AttrID:              id,
+			"lock_state":              string(v.LockState),
+			names.AttrRetentionPeriod: flattenRetentionPeriod(v.RetentionPeriod),
+		})
+	}
+
+	d.SetId(client.Region)
+	d.Set(names.AttrIDs, ids)
+
+	if err := d.Set("rules", rules); err != nil {
+		return create.AppendDiagError(diags, names.RBin, create.ErrActionSetting, DSNameRules, resourceType, err)
+	}
+
+	return diags
+}
+
+func findRules(ctx context.Context, conn *rbin.Client, in *rbin.ListRulesInput) ([]types.RuleSummary, error) {
+	var out []types.RuleSummary
+
+	pages := rbin.NewListRulesPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.Rules...)
+	}
+
+	return out, nil
+}
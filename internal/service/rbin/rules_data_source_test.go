@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rbin_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/rbin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRBinRulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_rbin_rules.test"
+	resourceName := "aws_rbin_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, rbin.ServiceID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rbin.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.description", resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.lock_state", "unlocked"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.retention_period.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.retention_period.0.retention_period_unit", "DAYS"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.retention_period.0.retention_period_value", acctest.Ct10),
+				),
+			},
+		},
+	})
+}
+
+func testAccRulesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rbin_rule" "test" {
+  description   = %[1]q
+  resource_type = "EBS_SNAPSHOT"
+
+  resource_tags {
+    resource_tag_key   = %[1]q
+    resource_tag_value = %[1]q
+  }
+
+  retention_period {
+    retention_period_value = 10
+    retention_period_unit  = "DAYS"
+  }
+}
+
+data "aws_rbin_rules" "test" {
+  resource_type = aws_rbin_rule.test.resource_type
+
+  resource_tags {
+    resource_tag_key   = %[1]q
+    resource_tag_value = %[1]q
+  }
+}
+`, rName)
+}
@@ -583,6 +583,52 @@ func TestAccRDSInstance_DBSubnetGroupName_vpcSecurityGroupIDs(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_waiters(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_waiters(rName, "30s", "15s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "waiters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "waiters.0.delay", "30s"),
+					resource.TestCheckResourceAttr(resourceName, "waiters.0.jitter", "10s"),
+					resource.TestCheckResourceAttr(resourceName, "waiters.0.poll_interval", "15s"),
+				),
+			},
+			{
+				// Changing only the waiters must not modify the DB instance.
+				Config: testAccInstanceConfig_waiters(rName, "1m", "30s"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v2),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "waiters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "waiters.0.delay", "1m"),
+					resource.TestCheckResourceAttr(resourceName, "waiters.0.poll_interval", "30s"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_deletionProtection(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -8298,6 +8344,28 @@ resource "aws_db_instance" "test" {
 `, deletionProtection, rName))
 }
 
+func testAccInstanceConfig_waiters(rName, delay, pollInterval string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage   = 5
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  password            = "avoid-plaintext-passwords"
+  username            = "tfacctest"
+  skip_final_snapshot = true
+
+  waiters {
+    delay         = %[2]q
+    jitter        = "10s"
+    poll_interval = %[3]q
+  }
+}
+`, rName, delay, pollInterval))
+}
+
 func testAccInstanceConfig_CloudWatchLogsExport_db2(rName, customerId, siteId string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassDB2(),
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_db_proxy_endpoint", name="DB Proxy Endpoint")
+func dataSourceProxyEndpoint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProxyEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_proxy_endpoint_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"db_proxy_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validIdentifier,
+			},
+			names.AttrEndpoint: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"target_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrVPCSecurityGroupIDs: {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_subnet_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceProxyEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	dbProxyName, dbProxyEndpointName := d.Get("db_proxy_name").(string), d.Get("db_proxy_endpoint_name").(string)
+	id := proxyEndpointCreateResourceID(dbProxyName, dbProxyEndpointName)
+	dbProxyEndpoint, err := findDBProxyEndpointByTwoPartKey(ctx, conn, dbProxyName, dbProxyEndpointName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS DB Proxy Endpoint (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrARN, dbProxyEndpoint.DBProxyEndpointArn)
+	d.Set("db_proxy_endpoint_name", dbProxyEndpoint.DBProxyEndpointName)
+	d.Set("db_proxy_name", dbProxyEndpoint.DBProxyName)
+	d.Set(names.AttrEndpoint, dbProxyEndpoint.Endpoint)
+	d.Set("is_default", dbProxyEndpoint.IsDefault)
+	d.Set("target_role", dbProxyEndpoint.TargetRole)
+	d.Set(names.AttrVPCID, dbProxyEndpoint.VpcId)
+	d.Set(names.AttrVPCSecurityGroupIDs, dbProxyEndpoint.VpcSecurityGroupIds)
+	d.Set("vpc_subnet_ids", dbProxyEndpoint.VpcSubnetIds)
+
+	return diags
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSProxyEndpointDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	dataSourceName := "data.aws_db_proxy_endpoint.test"
+	resourceName := "aws_db_proxy_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccDBProxyEndpointPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyEndpointDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_proxy_endpoint_name", resourceName, "db_proxy_endpoint_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_proxy_name", resourceName, "db_proxy_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrEndpoint, resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttrPair(dataSourceName, "is_default", resourceName, "is_default"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_role", resourceName, "target_role"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVPCID, resourceName, names.AttrVPCID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_security_group_ids.#", resourceName, "vpc_security_group_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_subnet_ids.#", resourceName, "vpc_subnet_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProxyEndpointDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccProxyEndpointConfig_basic(rName), `
+data "aws_db_proxy_endpoint" "test" {
+  db_proxy_name          = aws_db_proxy_endpoint.test.db_proxy_name
+  db_proxy_endpoint_name = aws_db_proxy_endpoint.test.db_proxy_endpoint_name
+}
+`)
+}
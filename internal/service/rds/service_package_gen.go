@@ -62,6 +62,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_db_proxy",
 			Name:     "DB Proxy",
 		},
+		{
+			Factory:  dataSourceProxyEndpoint,
+			TypeName: "aws_db_proxy_endpoint",
+			Name:     "DB Proxy Endpoint",
+		},
 		{
 			Factory:  DataSourceSnapshot,
 			TypeName: "aws_db_snapshot",
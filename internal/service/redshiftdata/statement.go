@@ -5,6 +5,7 @@ package redshiftdata
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -45,11 +47,22 @@ func resourceStatement() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
-			names.AttrDatabase: {
-				Type:     schema.TypeString,
-				Required: true,
+			"capture_result": {
+				Type:     schema.TypeBool,
+				Optional: true,
 				ForceNew: true,
 			},
+			"column_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrDatabase: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{names.AttrDatabase, "session_id"},
+			},
 			"db_user": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -75,12 +88,38 @@ func resourceStatement() *schema.Resource {
 					},
 				},
 			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"result_rows": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"secret_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"session_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ConflictsWith: []string{
+					names.AttrClusterIdentifier,
+					"db_user",
+					"secret_arn",
+					"workgroup_name",
+				},
+			},
+			"session_keep_alive_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 86400),
+			},
 			"sql": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -110,11 +149,14 @@ func resourceStatementCreate(ctx context.Context, d *schema.ResourceData, meta i
 	conn := meta.(*conns.AWSClient).RedshiftDataClient(ctx)
 
 	input := &redshiftdata.ExecuteStatementInput{
-		Database:  aws.String(d.Get(names.AttrDatabase).(string)),
 		Sql:       aws.String(d.Get("sql").(string)),
 		WithEvent: aws.Bool(d.Get("with_event").(bool)),
 	}
 
+	if v, ok := d.GetOk(names.AttrDatabase); ok {
+		input.Database = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk(names.AttrClusterIdentifier); ok {
 		input.ClusterIdentifier = aws.String(v.(string))
 	}
@@ -131,6 +173,14 @@ func resourceStatementCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.SecretArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("session_id"); ok {
+		input.SessionId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("session_keep_alive_seconds"); ok {
+		input.SessionKeepAliveSeconds = aws.Int32(int32(v.(int)))
+	}
+
 	if v, ok := d.GetOk("statement_name"); ok {
 		input.StatementName = aws.String(v.(string))
 	}
@@ -146,11 +196,33 @@ func resourceStatementCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	d.SetId(aws.ToString(output.Id))
+	d.Set("session_id", output.SessionId)
 
-	if _, err := waitStatementFinished(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	statement, err := waitStatementFinished(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Data Statement (%s) finish: %s", d.Id(), err)
 	}
 
+	// Results are only retained by the service for a limited time, so they are captured once at creation.
+	if d.Get("capture_result").(bool) && aws.ToBool(statement.HasResultSet) {
+		columnNames, records, err := findStatementResultByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Redshift Data Statement (%s) result: %s", d.Id(), err)
+		}
+
+		result, err := json.Marshal(records)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "encoding Redshift Data Statement (%s) result: %s", d.Id(), err)
+		}
+
+		d.Set("column_names", columnNames)
+		d.Set("result", string(result))
+		d.Set("result_rows", len(records))
+	}
+
 	return append(diags, resourceStatementRead(ctx, d, meta)...)
 }
 
@@ -170,15 +242,18 @@ func resourceStatementRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Statement (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrClusterIdentifier, sub.ClusterIdentifier)
+	// Statements run in an existing session inherit its connection settings, which must not be configured.
+	if _, ok := d.GetOk("session_id"); !ok || d.Get(names.AttrDatabase).(string) != "" {
+		d.Set(names.AttrClusterIdentifier, sub.ClusterIdentifier)
+		d.Set("secret_arn", sub.SecretArn)
+		d.Set("workgroup_name", sub.WorkgroupName)
+	}
 	d.Set(names.AttrDatabase, d.Get(names.AttrDatabase).(string))
 	d.Set("db_user", d.Get("db_user").(string))
 	if err := d.Set(names.AttrParameters, flattenParameters(sub.QueryParameters)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
 	}
-	d.Set("secret_arn", sub.SecretArn)
 	d.Set("sql", sub.QueryString)
-	d.Set("workgroup_name", sub.WorkgroupName)
 
 	return diags
 }
@@ -217,6 +292,43 @@ func FindStatementByID(ctx context.Context, conn *redshiftdata.Client, id string
 	return output, nil
 }
 
+func findStatementResultByID(ctx context.Context, conn *redshiftdata.Client, id string) ([]string, [][]interface{}, error) {
+	input := &redshiftdata.GetStatementResultInput{
+		Id: aws.String(id),
+	}
+
+	var columnNames []string
+	records := make([][]interface{}, 0)
+
+	pages := redshiftdata.NewGetStatementResultPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if columnNames == nil {
+			for _, v := range page.ColumnMetadata {
+				columnNames = append(columnNames, aws.ToString(v.Name))
+			}
+		}
+
+		for _, v := range page.Records {
+			records = append(records, flattenFields(v))
+		}
+	}
+
+	return columnNames, records, nil
+}
+
 func statusStatement(ctx context.Context, conn *redshiftdata.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindStatementByID(ctx, conn, id)
@@ -330,3 +442,30 @@ func flattenParameters(apiObjects []types.SqlParameter) []interface{} {
 
 	return tfList
 }
+
+func flattenField(apiObject types.Field) interface{} {
+	switch v := apiObject.(type) {
+	case *types.FieldMemberBlobValue:
+		return v.Value
+	case *types.FieldMemberBooleanValue:
+		return v.Value
+	case *types.FieldMemberDoubleValue:
+		return v.Value
+	case *types.FieldMemberLongValue:
+		return v.Value
+	case *types.FieldMemberStringValue:
+		return v.Value
+	default:
+		return nil
+	}
+}
+
+func flattenFields(apiObjects []types.Field) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenField(apiObject))
+	}
+
+	return tfList
+}
@@ -82,6 +82,69 @@ func TestAccRedshiftDataStatement_workgroup(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftDataStatement_session(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 redshiftdata.DescribeStatementOutput
+	resourceName1 := "aws_redshiftdata_statement.test"
+	resourceName2 := "aws_redshiftdata_statement.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatementConfig_session(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStatementExists(ctx, resourceName1, &v1),
+					testAccCheckStatementExists(ctx, resourceName2, &v2),
+					resource.TestCheckResourceAttrSet(resourceName1, "session_id"),
+					resource.TestCheckResourceAttr(resourceName1, "session_keep_alive_seconds", "300"),
+					resource.TestCheckResourceAttrPair(resourceName2, "session_id", resourceName1, "session_id"),
+					resource.TestCheckResourceAttr(resourceName2, names.AttrDatabase, ""),
+					resource.TestCheckResourceAttr(resourceName2, "workgroup_name", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataStatement_captureResult(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v redshiftdata.DescribeStatementOutput
+	resourceName := "aws_redshiftdata_statement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatementConfig_captureResult(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStatementExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capture_result", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "column_names.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "column_names.0", "a"),
+					resource.TestCheckResourceAttr(resourceName, "column_names.1", "b"),
+					resource.TestCheckResourceAttr(resourceName, "result", `[[1,"x"]]`),
+					resource.TestCheckResourceAttr(resourceName, "result_rows", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"capture_result", "column_names", names.AttrDatabase, "db_user", "result", "result_rows"},
+			},
+		},
+	})
+}
+
 func testAccCheckStatementExists(ctx context.Context, n string, v *redshiftdata.DescribeStatementOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -144,3 +207,48 @@ resource "aws_redshiftdata_statement" "test" {
 }
 `, rName)
 }
+
+func testAccStatementConfig_session(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+resource "aws_redshiftdata_statement" "test" {
+  workgroup_name             = aws_redshiftserverless_workgroup.test.workgroup_name
+  database                   = "dev"
+  session_keep_alive_seconds = 300
+  sql                        = "CREATE TEMP TABLE t (a INT);"
+}
+
+resource "aws_redshiftdata_statement" "test2" {
+  session_id = aws_redshiftdata_statement.test.session_id
+  sql        = "INSERT INTO t VALUES (1);"
+}
+`, rName)
+}
+
+func testAccStatementConfig_captureResult(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+resource "aws_redshiftdata_statement" "test" {
+  workgroup_name = aws_redshiftserverless_workgroup.test.workgroup_name
+  database       = "dev"
+  capture_result = true
+  sql            = "SELECT 1 AS a, 'x' AS b;"
+}
+`, rName)
+}
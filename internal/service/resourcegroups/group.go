@@ -6,13 +6,16 @@ package resourcegroups
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -113,10 +116,87 @@ func resourceGroup() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceGroupConfigurationCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+const (
+	groupConfigurationTypeCapacityReservationPool = "AWS::EC2::CapacityReservationPool"
+	groupConfigurationTypeGeneric                 = "AWS::ResourceGroups::Generic"
+	groupConfigurationTypeHostManagement          = "AWS::EC2::HostManagement"
+)
+
+// groupConfigurationParameterNames lists the parameters accepted by each resource-type-specific configuration.
+// Configuration types not listed here are passed through without validation.
+var groupConfigurationParameterNames = map[string][]string{
+	groupConfigurationTypeCapacityReservationPool: {},
+	groupConfigurationTypeGeneric: {
+		"allowed-resource-types",
+		"deletion-protection",
+	},
+	groupConfigurationTypeHostManagement: {
+		"allowed-host-based-license-configurations",
+		"allowed-host-families",
+		"any-host-based-license-configuration",
+		"auto-allocate-host",
+		"auto-release-host",
+	},
+}
+
+func resourceGroupConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	configuredParameters := make(map[string][]string)
+
+	for _, tfMapRaw := range d.Get(names.AttrConfiguration).(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		configurationType := tfMap[names.AttrType].(string)
+		if configurationType == "" {
+			continue
+		}
+
+		var parameterNames []string
+		for _, v := range tfMap[names.AttrParameters].(*schema.Set).List() {
+			if v, ok := v.(map[string]interface{}); ok {
+				parameterNames = append(parameterNames, v[names.AttrName].(string))
+			}
+		}
+
+		if validNames, ok := groupConfigurationParameterNames[configurationType]; ok {
+			for _, name := range parameterNames {
+				if name != "" && !slices.Contains(validNames, name) {
+					return fmt.Errorf("configuration type %s does not support parameter %q", configurationType, name)
+				}
+			}
+		}
+
+		configuredParameters[configurationType] = parameterNames
+	}
+
+	if v, ok := configuredParameters[groupConfigurationTypeHostManagement]; ok {
+		if slices.Contains(v, "allowed-host-based-license-configurations") && slices.Contains(v, "any-host-based-license-configuration") {
+			return fmt.Errorf("configuration type %s: only one of allowed-host-based-license-configurations or any-host-based-license-configuration can be specified", groupConfigurationTypeHostManagement)
+		}
+	}
+
+	for _, configurationType := range []string{groupConfigurationTypeCapacityReservationPool, groupConfigurationTypeHostManagement} {
+		if _, ok := configuredParameters[configurationType]; !ok {
+			continue
+		}
+
+		if v, ok := configuredParameters[groupConfigurationTypeGeneric]; !ok || !slices.Contains(v, "allowed-resource-types") {
+			return fmt.Errorf("configuration type %s requires a %s configuration with the allowed-resource-types parameter", configurationType, groupConfigurationTypeGeneric)
+		}
+	}
+
+	return nil
+}
+
 func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ResourceGroupsClient(ctx)
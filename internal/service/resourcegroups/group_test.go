@@ -251,6 +251,28 @@ func TestAccResourceGroupsGroup_configurationParametersOptional(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupsGroup_configurationValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGroupConfig_configurationInvalidParameter(rName),
+				ExpectError: regexache.MustCompile(`configuration type AWS::EC2::CapacityReservationPool does not support parameter "auto-allocate-host"`),
+			},
+			{
+				Config:      testAccGroupConfig_configurationMissingGeneric(rName),
+				ExpectError: regexache.MustCompile(`configuration type AWS::EC2::CapacityReservationPool requires a AWS::ResourceGroups::Generic configuration`),
+			},
+		},
+	})
+}
+
 func TestAccResourceGroupsGroup_resourceQueryAndConfiguration(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.Group
@@ -502,3 +524,41 @@ JSON
 }
 `, rName, query, configType)
 }
+
+func testAccGroupConfig_configurationInvalidParameter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  configuration {
+    type = "AWS::EC2::CapacityReservationPool"
+
+    parameters {
+      name   = "auto-allocate-host"
+      values = ["true"]
+    }
+  }
+
+  configuration {
+    type = "AWS::ResourceGroups::Generic"
+
+    parameters {
+      name   = "allowed-resource-types"
+      values = ["AWS::EC2::CapacityReservation"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccGroupConfig_configurationMissingGeneric(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  configuration {
+    type = "AWS::EC2::CapacityReservationPool"
+  }
+}
+`, rName)
+}
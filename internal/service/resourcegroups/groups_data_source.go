@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroups
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_resourcegroups_groups", name="Groups")
+func dataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrFilter: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.GroupFilterName](),
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient(ctx)
+
+	input := &resourcegroups.ListGroupsInput{}
+
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		input.Filters = expandGroupFilters(v.(*schema.Set).List())
+	}
+
+	groups, err := findGroupIdentifiers(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Groups: %s", err)
+	}
+
+	var arns, nms []string
+
+	for _, group := range groups {
+		arns = append(arns, aws.ToString(group.GroupArn))
+		nms = append(nms, aws.ToString(group.GroupName))
+	}
+
+	sort.Strings(arns)
+	sort.Strings(nms)
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, arns)
+	d.Set(names.AttrNames, nms)
+
+	return diags
+}
+
+func findGroupIdentifiers(ctx context.Context, conn *resourcegroups.Client, input *resourcegroups.ListGroupsInput) ([]types.GroupIdentifier, error) {
+	var output []types.GroupIdentifier
+
+	pages := resourcegroups.NewListGroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.GroupIdentifiers...)
+	}
+
+	return output, nil
+}
+
+func expandGroupFilters(tfList []interface{}) []types.GroupFilter {
+	var apiObjects []types.GroupFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.GroupFilter{
+			Name:   types.GroupFilterName(tfMap[names.AttrName].(string)),
+			Values: flex.ExpandStringValueList(tfMap[names.AttrValues].([]interface{})),
+		})
+	}
+
+	return apiObjects
+}
@@ -6,14 +6,18 @@ package rolesanywhere
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -38,6 +42,34 @@ func ResourceProfile() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"attribute_mapping": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_field": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.CertificateField](),
+						},
+						"mapping_rule": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"specifier": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 60),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"duration_seconds": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -120,6 +152,13 @@ func resourceProfileCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.StringValue(output.Profile.ProfileId))
 
+	// Attribute mappings can't be specified on create, and the service applies its own defaults.
+	if v, ok := d.GetOk("attribute_mapping"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateProfileAttributeMappings(ctx, conn, d.Id(), output.Profile.AttributeMappings, expandAttributeMappings(v.(*schema.Set).List())); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting RolesAnywhere Profile (%s) attribute mappings: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceProfileRead(ctx, d, meta)...)
 }
 
@@ -140,6 +179,9 @@ func resourceProfileRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	d.Set(names.AttrARN, profile.ProfileArn)
+	if err := d.Set("attribute_mapping", flattenAttributeMappings(profile.AttributeMappings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attribute_mapping: %s", err)
+	}
 	d.Set("duration_seconds", profile.DurationSeconds)
 	d.Set(names.AttrEnabled, profile.Enabled)
 	d.Set("managed_policy_arns", profile.ManagedPolicyArns)
@@ -155,7 +197,7 @@ func resourceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("attribute_mapping", names.AttrEnabled, names.AttrTags, names.AttrTagsAll) {
 		input := &rolesanywhere.UpdateProfileInput{
 			ProfileId: aws.String(d.Id()),
 		}
@@ -187,17 +229,25 @@ func resourceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("attribute_mapping") {
+		o, n := d.GetChange("attribute_mapping")
+
+		if err := updateProfileAttributeMappings(ctx, conn, d.Id(), expandAttributeMappings(o.(*schema.Set).List()), expandAttributeMappings(n.(*schema.Set).List())); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RolesAnywhere Profile (%s) attribute mappings: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange(names.AttrEnabled) {
 		_, n := d.GetChange(names.AttrEnabled)
 		if n == true {
 			err := enableProfile(ctx, d.Id(), meta)
 			if err != nil {
-				sdkdiag.AppendErrorf(diags, "enabling RolesAnywhere Profile (%s): %s", d.Id(), err)
+				return sdkdiag.AppendErrorf(diags, "enabling RolesAnywhere Profile (%s): %s", d.Id(), err)
 			}
 		} else {
 			err := disableProfile(ctx, d.Id(), meta)
 			if err != nil {
-				sdkdiag.AppendErrorf(diags, "disabling RolesAnywhere Profile (%s): %s", d.Id(), err)
+				return sdkdiag.AppendErrorf(diags, "disabling RolesAnywhere Profile (%s): %s", d.Id(), err)
 			}
 		}
 	}
@@ -247,3 +297,111 @@ func enableProfile(ctx context.Context, profileId string, meta interface{}) erro
 	_, err := conn.EnableProfile(ctx, input)
 	return err
 }
+
+// updateProfileAttributeMappings makes the profile's attribute mappings match want.
+// Specifiers missing from want are deleted and each wanted certificate field's rules are put in full.
+func updateProfileAttributeMappings(ctx context.Context, conn *rolesanywhere.Client, profileID string, have, want []types.AttributeMapping) error {
+	wantSpecifiers := make(map[types.CertificateField][]string)
+	for _, v := range want {
+		wantSpecifiers[v.CertificateField] = mappingRuleSpecifiers(v.MappingRules)
+	}
+
+	for _, v := range have {
+		var del []string
+
+		for _, specifier := range mappingRuleSpecifiers(v.MappingRules) {
+			if !slices.Contains(wantSpecifiers[v.CertificateField], specifier) {
+				del = append(del, specifier)
+			}
+		}
+
+		if len(del) == 0 {
+			continue
+		}
+
+		input := &rolesanywhere.DeleteAttributeMappingInput{
+			CertificateField: v.CertificateField,
+			ProfileId:        aws.String(profileID),
+			Specifiers:       del,
+		}
+
+		if _, err := conn.DeleteAttributeMapping(ctx, input); err != nil {
+			return fmt.Errorf("deleting %s mapping rules: %w", v.CertificateField, err)
+		}
+	}
+
+	for _, v := range want {
+		input := &rolesanywhere.PutAttributeMappingInput{
+			CertificateField: v.CertificateField,
+			MappingRules:     v.MappingRules,
+			ProfileId:        aws.String(profileID),
+		}
+
+		if _, err := conn.PutAttributeMapping(ctx, input); err != nil {
+			return fmt.Errorf("putting %s mapping rules: %w", v.CertificateField, err)
+		}
+	}
+
+	return nil
+}
+
+func mappingRuleSpecifiers(apiObjects []types.MappingRule) []string {
+	var specifiers []string
+
+	for _, v := range apiObjects {
+		specifiers = append(specifiers, aws.StringValue(v.Specifier))
+	}
+
+	return specifiers
+}
+
+func expandAttributeMappings(tfList []interface{}) []types.AttributeMapping {
+	var apiObjects []types.AttributeMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.AttributeMapping{
+			CertificateField: types.CertificateField(tfMap["certificate_field"].(string)),
+		}
+
+		for _, tfMapRaw := range tfMap["mapping_rule"].(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.MappingRules = append(apiObject.MappingRules, types.MappingRule{
+				Specifier: aws.String(tfMap["specifier"].(string)),
+			})
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAttributeMappings(apiObjects []types.AttributeMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		var tfMappingRules []interface{}
+
+		for _, v := range apiObject.MappingRules {
+			tfMappingRules = append(tfMappingRules, map[string]interface{}{
+				"specifier": aws.StringValue(v.Specifier),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"certificate_field": apiObject.CertificateField,
+			"mapping_rule":      tfMappingRules,
+		})
+	}
+
+	return tfList
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -22,6 +24,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	defaultNotificationThreshold = 45
+	// Notification settings that haven't been customized are reported as configured by the service.
+	notificationSettingConfiguredByService = "rolesanywhere.amazonaws.com"
+)
+
 // @SDKResource("aws_rolesanywhere_trust_anchor", name="Trust Anchor")
 // @Tags(identifierAttribute="arn")
 func ResourceTrustAnchor() *schema.Resource {
@@ -49,6 +57,36 @@ func ResourceTrustAnchor() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"notification_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.NotificationChannelAll,
+							ValidateDiagFunc: enum.Validate[types.NotificationChannel](),
+						},
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"event": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.NotificationEvent](),
+						},
+						"threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultNotificationThreshold,
+							ValidateFunc: validation.IntBetween(1, 360),
+						},
+					},
+				},
+			},
 			names.AttrSource: {
 				Type:     schema.TypeList,
 				Required: true,
@@ -103,6 +141,10 @@ func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:    getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("notification_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotificationSettings = expandNotificationSettings(v.(*schema.Set).List())
+	}
+
 	log.Printf("[DEBUG] Creating RolesAnywhere Trust Anchor (%s): %#v", d.Id(), input)
 	output, err := conn.CreateTrustAnchor(ctx, input)
 
@@ -135,6 +177,10 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set(names.AttrEnabled, trustAnchor.Enabled)
 	d.Set(names.AttrName, trustAnchor.Name)
 
+	if err := d.Set("notification_settings", flattenNotificationSettingDetails(trustAnchor.NotificationSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting notification_settings: %s", err)
+	}
+
 	if err := d.Set(names.AttrSource, flattenSource(trustAnchor.Source)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
 	}
@@ -146,7 +192,7 @@ func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChanges(names.AttrName, names.AttrSource) {
 		input := &rolesanywhere.UpdateTrustAnchorInput{
 			TrustAnchorId: aws.String(d.Id()),
 			Name:          aws.String(d.Get(names.AttrName).(string)),
@@ -159,17 +205,56 @@ func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
 		}
+	}
+
+	if d.HasChange("notification_settings") {
+		o, n := d.GetChange("notification_settings")
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+
+		// Settings removed from configuration revert to the service defaults.
+		want := expandNotificationSettings(newSet.List())
+		var reset []types.NotificationSettingKey
+		for _, v := range expandNotificationSettings(oldSet.List()) {
+			key := types.NotificationSettingKey{Channel: v.Channel, Event: v.Event}
+			if !slices.ContainsFunc(want, func(v types.NotificationSetting) bool {
+				return v.Channel == key.Channel && v.Event == key.Event
+			}) {
+				reset = append(reset, key)
+			}
+		}
+
+		if len(reset) > 0 {
+			_, err := conn.ResetNotificationSettings(ctx, &rolesanywhere.ResetNotificationSettingsInput{
+				NotificationSettingKeys: reset,
+				TrustAnchorId:           aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "resetting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+			}
+		}
+
+		if put := newSet.Difference(oldSet); put.Len() > 0 {
+			_, err := conn.PutNotificationSettings(ctx, &rolesanywhere.PutNotificationSettingsInput{
+				NotificationSettings: expandNotificationSettings(put.List()),
+				TrustAnchorId:        aws.String(d.Id()),
+			})
 
-		if d.HasChange(names.AttrEnabled) {
-			_, n := d.GetChange(names.AttrEnabled)
-			if n == true {
-				if err := enableTrustAnchor(ctx, d.Id(), meta); err != nil {
-					sdkdiag.AppendErrorf(diags, "enabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
-				}
-			} else {
-				if err := disableTrustAnchor(ctx, d.Id(), meta); err != nil {
-					sdkdiag.AppendErrorf(diags, "disabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
-				}
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "putting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange(names.AttrEnabled) {
+		_, n := d.GetChange(names.AttrEnabled)
+		if n == true {
+			if err := enableTrustAnchor(ctx, d.Id(), meta); err != nil {
+				return sdkdiag.AppendErrorf(diags, "enabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
+			}
+		} else {
+			if err := disableTrustAnchor(ctx, d.Id(), meta); err != nil {
+				return sdkdiag.AppendErrorf(diags, "disabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
 			}
 		}
 	}
@@ -279,6 +364,54 @@ func expandSourceDataCertificateBundle(tfMap map[string]interface{}) *types.Sour
 	return result
 }
 
+func expandNotificationSettings(tfList []interface{}) []types.NotificationSetting {
+	var apiObjects []types.NotificationSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.NotificationSetting{
+			Enabled: aws.Bool(tfMap[names.AttrEnabled].(bool)),
+			Event:   types.NotificationEvent(tfMap["event"].(string)),
+		}
+
+		if v, ok := tfMap["channel"].(string); ok && v != "" {
+			apiObject.Channel = types.NotificationChannel(v)
+		}
+
+		if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+			apiObject.Threshold = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenNotificationSettingDetails(apiObjects []types.NotificationSettingDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		// Only report settings that were explicitly configured, not the service defaults.
+		if aws.StringValue(apiObject.ConfiguredBy) == notificationSettingConfiguredByService {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"channel":         apiObject.Channel,
+			names.AttrEnabled: aws.BoolValue(apiObject.Enabled),
+			"event":           apiObject.Event,
+			"threshold":       aws.Int32Value(apiObject.Threshold),
+		})
+	}
+
+	return tfList
+}
+
 func disableTrustAnchor(ctx context.Context, trustAnchorId string, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
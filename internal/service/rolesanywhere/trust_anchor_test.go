@@ -194,6 +194,56 @@ func TestAccRolesAnywhereTrustAnchor_enabled(t *testing.T) {
 	})
 }
 
+func TestAccRolesAnywhereTrustAnchor_notificationSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_trust_anchor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustAnchorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustAnchorConfig_notificationSettings(t, rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_settings.*", map[string]string{
+						"channel":         "ALL",
+						names.AttrEnabled: acctest.CtTrue,
+						"event":           "CA_CERTIFICATE_EXPIRY",
+						"threshold":       "30",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrustAnchorConfig_notificationSettings(t, rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_settings.*", map[string]string{
+						"threshold": "60",
+					}),
+				),
+			},
+			{
+				Config: testAccTrustAnchorConfig_certificateBundle(t, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTrustAnchorDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereClient(ctx)
@@ -371,6 +421,29 @@ resource "aws_rolesanywhere_trust_anchor" "test" {
 `, rName, acctest.TLSPEMEscapeNewlines(caCertificate), enabled)
 }
 
+func testAccTrustAnchorConfig_notificationSettings(t *testing.T, rName string, threshold int) string {
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+
+  notification_settings {
+    enabled   = true
+    event     = "CA_CERTIFICATE_EXPIRY"
+    threshold = %[3]d
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate), threshold)
+}
+
 func testAccPreCheck(ctx context.Context, t *testing.T) {
 	acctest.PreCheckPartitionHasService(t, names.RolesAnywhereEndpointID)
 
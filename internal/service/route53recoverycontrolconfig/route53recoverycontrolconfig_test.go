@@ -28,6 +28,9 @@ func TestAccRoute53RecoveryControlConfig_serial(t *testing.T) {
 			acctest.CtDisappears:    testAccRoutingControl_disappears,
 			"nonDefaultControlPane": testAccRoutingControl_nonDefaultControlPanel,
 		},
+		"RoutingControlState": {
+			acctest.CtBasic: testAccRoutingControlState_basic,
+		},
 		"SafetyRule": {
 			"assertionRule":      testAccSafetyRule_assertionRule,
 			"gatingRule":         testAccSafetyRule_gatingRule,
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53recoverycontrolconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rc "github.com/aws/aws-sdk-go/service/route53recoverycluster"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_route53recoverycontrolconfig_routing_control_state")
+func ResourceRoutingControlState() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRoutingControlStateCreate,
+		ReadWithoutTimeout:   resourceRoutingControlStateRead,
+		UpdateWithoutTimeout: resourceRoutingControlStateUpdate,
+		DeleteWithoutTimeout: resourceRoutingControlStateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"cluster_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEndpoint: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"routing_control_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"routing_control_state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(r53rc.RoutingControlState_Values(), false),
+			},
+			"safety_rules_to_override": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceRoutingControlStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	arn := d.Get("routing_control_arn").(string)
+
+	if err := updateRoutingControlState(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route53 Recovery Control Config Routing Control State (%s): %s", arn, err)
+	}
+
+	d.SetId(arn)
+
+	return append(diags, resourceRoutingControlStateRead(ctx, d, meta)...)
+}
+
+func resourceRoutingControlStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// On import only the routing control ARN is known.
+	if _, ok := d.GetOk("cluster_arn"); !ok {
+		clusterARN, err := findClusterARNByRoutingControlARN(ctx, meta.(*conns.AWSClient).Route53RecoveryControlConfigConn(ctx), d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Control Config Routing Control State (%s): %s", d.Id(), err)
+		}
+
+		d.Set("cluster_arn", clusterARN)
+	}
+
+	var output *r53rc.GetRoutingControlStateOutput
+	err := withClusterEndpoints(ctx, d, meta, func(conn *r53rc.Route53RecoveryCluster) error {
+		var err error
+
+		output, err = conn.GetRoutingControlStateWithContext(ctx, &r53rc.GetRoutingControlStateInput{
+			RoutingControlArn: aws.String(d.Id()),
+		})
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, r53rc.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Route53 Recovery Control Config Routing Control State (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Control Config Routing Control State (%s): %s", d.Id(), err)
+	}
+
+	d.Set("routing_control_arn", output.RoutingControlArn)
+	d.Set("routing_control_state", output.RoutingControlState)
+
+	return diags
+}
+
+func resourceRoutingControlStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChange("routing_control_state") {
+		if err := updateRoutingControlState(ctx, d, meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route53 Recovery Control Config Routing Control State (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRoutingControlStateRead(ctx, d, meta)...)
+}
+
+func resourceRoutingControlStateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[WARN] Route53 Recovery Control Config Routing Control State (%s) removed from state, the routing control keeps its current state", d.Id())
+
+	return diags
+}
+
+func updateRoutingControlState(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	input := &r53rc.UpdateRoutingControlStateInput{
+		RoutingControlArn:   aws.String(d.Get("routing_control_arn").(string)),
+		RoutingControlState: aws.String(d.Get("routing_control_state").(string)),
+	}
+
+	if v, ok := d.GetOk("safety_rules_to_override"); ok && v.(*schema.Set).Len() > 0 {
+		input.SafetyRulesToOverride = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	return withClusterEndpoints(ctx, d, meta, func(conn *r53rc.Route53RecoveryCluster) error {
+		_, err := conn.UpdateRoutingControlStateWithContext(ctx, input)
+
+		return err
+	})
+}
+
+// withClusterEndpoints calls f with a data plane client for each of the cluster's regional endpoints in random order
+// until a call succeeds or fails with an error that would be returned by every endpoint.
+// The endpoints are refreshed from the control plane when it is reachable and otherwise taken from state,
+// so routing control states can still be changed if the control plane's Region is unavailable.
+func withClusterEndpoints(ctx context.Context, d *schema.ResourceData, meta interface{}, f func(*r53rc.Route53RecoveryCluster) error) error {
+	client := meta.(*conns.AWSClient)
+
+	endpoints := d.Get("cluster_endpoints").([]interface{})
+	output, err := client.Route53RecoveryControlConfigConn(ctx).DescribeClusterWithContext(ctx, &r53rcc.DescribeClusterInput{
+		ClusterArn: aws.String(d.Get("cluster_arn").(string)),
+	})
+
+	switch {
+	case err == nil && output != nil && output.Cluster != nil:
+		endpoints = flattenClusterEndpoints(output.Cluster.ClusterEndpoints)
+		if err := d.Set("cluster_endpoints", endpoints); err != nil {
+			return fmt.Errorf("setting cluster_endpoints: %w", err)
+		}
+	case len(endpoints) > 0:
+		log.Printf("[WARN] Unable to refresh Route53 Recovery Control Config Cluster (%s) endpoints, using the endpoints in state: %v", d.Get("cluster_arn").(string), err)
+	case err != nil:
+		return fmt.Errorf("describing Route53 Recovery Control Config Cluster (%s): %w", d.Get("cluster_arn").(string), err)
+	}
+
+	if len(endpoints) == 0 {
+		return fmt.Errorf("Route53 Recovery Control Config Cluster (%s) has no endpoints", d.Get("cluster_arn").(string))
+	}
+
+	var errs []error
+
+	for _, i := range rand.Perm(len(endpoints)) {
+		tfMap, ok := endpoints[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		endpoint, region := tfMap[names.AttrEndpoint].(string), tfMap[names.AttrRegion].(string)
+		err := f(client.Route53RecoveryClusterConnForEndpoint(ctx, endpoint, region))
+
+		if err == nil {
+			return nil
+		}
+
+		if tfawserr.ErrCodeEquals(err, r53rc.ErrCodeResourceNotFoundException, r53rc.ErrCodeValidationException, r53rc.ErrCodeConflictException, r53rc.ErrCodeAccessDeniedException) {
+			return err
+		}
+
+		log.Printf("[WARN] Route53 Recovery Cluster endpoint (%s) in Region (%s) failed, trying next endpoint: %s", endpoint, region, err)
+		errs = append(errs, fmt.Errorf("%s: %w", endpoint, err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func findClusterARNByRoutingControlARN(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string) (string, error) {
+	output, err := conn.DescribeRoutingControlWithContext(ctx, &r53rcc.DescribeRoutingControlInput{
+		RoutingControlArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.RoutingControl == nil {
+		return "", fmt.Errorf("describing Route53 Recovery Control Config Routing Control (%s): empty response", arn)
+	}
+
+	panel, err := conn.DescribeControlPanelWithContext(ctx, &r53rcc.DescribeControlPanelInput{
+		ControlPanelArn: output.RoutingControl.ControlPanelArn,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if panel == nil || panel.ControlPanel == nil {
+		return "", fmt.Errorf("describing Route53 Recovery Control Config Control Panel (%s): empty response", aws.StringValue(output.RoutingControl.ControlPanelArn))
+	}
+
+	return aws.StringValue(panel.ControlPanel.ClusterArn), nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -74,6 +75,12 @@ func ResourceEndpoint() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"ipv6": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsIPv6Address,
+						},
 						names.AttrSubnetID: {
 							Type:     schema.TypeString,
 							Required: true,
@@ -122,7 +129,10 @@ func ResourceEndpoint() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceEndpointCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -305,6 +315,41 @@ func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+func resourceEndpointCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if v, ok := diff.GetOk("protocols"); ok {
+		if err := validEndpointProtocols(diff.Get("direction").(string), flex.ExpandStringValueSet(v.(*schema.Set))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validEndpointProtocols validates the combination of protocols for a resolver endpoint.
+// See https://docs.aws.amazon.com/Route53/latest/APIReference/API_route53resolver_CreateResolverEndpoint.html#Route53-route53resolver_CreateResolverEndpoint-request-Protocols.
+func validEndpointProtocols(direction string, protocols []string) error {
+	var doh, dohFIPS bool
+
+	for _, protocol := range protocols {
+		switch protocol {
+		case route53resolver.ProtocolDoH:
+			doh = true
+		case route53resolver.ProtocolDoHFips:
+			dohFIPS = true
+		}
+	}
+
+	if doh && dohFIPS {
+		return fmt.Errorf("protocols %q and %q cannot be used together", route53resolver.ProtocolDoH, route53resolver.ProtocolDoHFips)
+	}
+
+	if dohFIPS && direction == route53resolver.ResolverEndpointDirectionOutbound {
+		return fmt.Errorf("protocol %q is not supported for %s endpoints", route53resolver.ProtocolDoHFips, direction)
+	}
+
+	return nil
+}
+
 func FindResolverEndpointByID(ctx context.Context, conn *route53resolver.Route53Resolver, id string) (*route53resolver.ResolverEndpoint, error) {
 	input := &route53resolver.GetResolverEndpointInput{
 		ResolverEndpointId: aws.String(id),
@@ -436,6 +481,9 @@ func endpointHashIPAddress(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
 	buf.WriteString(fmt.Sprintf("%s-%s-", m[names.AttrSubnetID].(string), m["ip"].(string)))
+	if v, ok := m["ipv6"].(string); ok && v != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
 	return create.StringHashcode(buf.String())
 }
 
@@ -453,6 +501,9 @@ func expandEndpointIPAddressUpdate(vIpAddress interface{}) *route53resolver.IpAd
 	if vIpId, ok := mIpAddress["ip_id"].(string); ok && vIpId != "" {
 		ipAddressUpdate.IpId = aws.String(vIpId)
 	}
+	if vIpv6, ok := mIpAddress["ipv6"].(string); ok && vIpv6 != "" {
+		ipAddressUpdate.Ipv6 = aws.String(vIpv6)
+	}
 
 	return ipAddressUpdate
 }
@@ -471,6 +522,9 @@ func expandEndpointIPAddresses(vIpAddresses *schema.Set) []*route53resolver.IpAd
 		if vIp, ok := mIpAddress["ip"].(string); ok && vIp != "" {
 			ipAddressRequest.Ip = aws.String(vIp)
 		}
+		if vIpv6, ok := mIpAddress["ipv6"].(string); ok && vIpv6 != "" {
+			ipAddressRequest.Ipv6 = aws.String(vIpv6)
+		}
 
 		ipAddressRequests = append(ipAddressRequests, ipAddressRequest)
 	}
@@ -490,6 +544,7 @@ func flattenEndpointIPAddresses(ipAddresses []*route53resolver.IpAddressResponse
 			names.AttrSubnetID: aws.StringValue(ipAddress.SubnetId),
 			"ip":               aws.StringValue(ipAddress.Ip),
 			"ip_id":            aws.StringValue(ipAddress.IpId),
+			"ipv6":             aws.StringValue(ipAddress.Ipv6),
 		}
 
 		vIpAddresses = append(vIpAddresses, mIpAddress)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -181,6 +182,7 @@ func TestAccRoute53ResolverEndpoint_resolverEndpointType(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEndpointExists(ctx, resourceName, &ep),
 					resource.TestCheckResourceAttr(resourceName, "resolver_endpoint_type", "DUALSTACK"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address.0.ipv6"),
 				),
 			},
 			{
@@ -194,6 +196,28 @@ func TestAccRoute53ResolverEndpoint_resolverEndpointType(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverEndpoint_protocolsInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEndpointConfig_protocols(rName, "INBOUND", `"DoH", "DoH-FIPS"`),
+				ExpectError: regexache.MustCompile(`protocols "DoH" and "DoH-FIPS" cannot be used together`),
+			},
+			{
+				Config:      testAccEndpointConfig_protocols(rName, "OUTBOUND", `"DoH-FIPS"`),
+				ExpectError: regexache.MustCompile(`protocol "DoH-FIPS" is not supported for OUTBOUND endpoints`),
+			},
+		},
+	})
+}
+
 func testAccCheckEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn(ctx)
@@ -479,3 +503,24 @@ resource "aws_route53_resolver_endpoint" "test" {
 }
 `, rName, resolverEndpointType))
 }
+
+func testAccEndpointConfig_protocols(rName, direction, protocols string) string {
+	return acctest.ConfigCompose(testAccEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_route53_resolver_endpoint" "test" {
+  direction = %[2]q
+  name      = %[1]q
+
+  security_group_ids = aws_security_group.test[*].id
+
+  ip_address {
+    subnet_id = aws_subnet.test[0].id
+  }
+
+  ip_address {
+    subnet_id = aws_subnet.test[1].id
+  }
+
+  protocols = [%[3]s]
+}
+`, rName, direction, protocols))
+}
@@ -6,6 +6,7 @@ package route53resolver
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -90,9 +91,14 @@ func ResourceRule() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"ip": {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							ValidateFunc: validation.IsIPAddress,
 						},
+						"ipv6": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPv6Address,
+						},
 						names.AttrPort: {
 							Type:         schema.TypeInt,
 							Optional:     true,
@@ -249,6 +255,23 @@ func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func resourceRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if v, ok := diff.GetOk("target_ip"); ok {
+		for _, tfMapRaw := range v.(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if tfMap["ip"].(string) != "" && tfMap["ipv6"].(string) != "" {
+				return errors.New(`only one of "ip" or "ipv6" can be specified for a target_ip`)
+			}
+
+			if protocol := tfMap[names.AttrProtocol].(string); protocol == route53resolver.ProtocolDoHFips {
+				return fmt.Errorf("target_ip protocol %q is not supported, only inbound endpoints support it", protocol)
+			}
+		}
+	}
+
 	if diff.Id() != "" {
 		if diff.HasChange("resolver_endpoint_id") {
 			if _, n := diff.GetChange("resolver_endpoint_id"); n.(string) == "" {
@@ -376,6 +399,9 @@ func expandRuleTargetIPs(vTargetIps *schema.Set) []*route53resolver.TargetAddres
 		if vIp, ok := mTargetIp["ip"].(string); ok && vIp != "" {
 			targetAddress.Ip = aws.String(vIp)
 		}
+		if vIpv6, ok := mTargetIp["ipv6"].(string); ok && vIpv6 != "" {
+			targetAddress.Ipv6 = aws.String(vIpv6)
+		}
 		if vPort, ok := mTargetIp[names.AttrPort].(int); ok {
 			targetAddress.Port = aws.Int64(int64(vPort))
 		}
@@ -399,6 +425,7 @@ func flattenRuleTargetIPs(targetAddresses []*route53resolver.TargetAddress) []in
 	for _, targetAddress := range targetAddresses {
 		mTargetIp := map[string]interface{}{
 			"ip":               aws.StringValue(targetAddress.Ip),
+			"ipv6":             aws.StringValue(targetAddress.Ipv6),
 			names.AttrPort:     int(aws.Int64Value(targetAddress.Port)),
 			names.AttrProtocol: aws.StringValue(targetAddress.Protocol),
 		}
@@ -50,3 +50,48 @@ func TestValidResolverName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidEndpointProtocols(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		direction   string
+		protocols   []string
+		expectError bool
+	}{
+		{
+			direction: "INBOUND",
+			protocols: []string{"Do53"},
+		},
+		{
+			direction: "INBOUND",
+			protocols: []string{"Do53", "DoH"},
+		},
+		{
+			direction: "INBOUND",
+			protocols: []string{"Do53", "DoH-FIPS"},
+		},
+		{
+			direction:   "INBOUND",
+			protocols:   []string{"DoH", "DoH-FIPS"},
+			expectError: true,
+		},
+		{
+			direction: "OUTBOUND",
+			protocols: []string{"Do53", "DoH"},
+		},
+		{
+			direction:   "OUTBOUND",
+			protocols:   []string{"DoH-FIPS"},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := tfroute53resolver.ValidEndpointProtocols(testCase.direction, testCase.protocols)
+
+		if got, want := err != nil, testCase.expectError; got != want {
+			t.Errorf("ValidEndpointProtocols(%q, %q) error = %v, expected error: %t", testCase.direction, testCase.protocols, err, want)
+		}
+	}
+}
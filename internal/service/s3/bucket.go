@@ -45,6 +45,10 @@ const (
 	// General timeout for S3 bucket changes to propagate.
 	// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/Welcome.html#ConsistencyModel.
 	bucketPropagationTimeout = 2 * time.Minute
+
+	// Maximum size of a bucket policy, measured after whitespace is removed.
+	// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucket-policies.html.
+	bucketPolicyMaxLength = 20 * 1024
 )
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
@@ -389,7 +393,7 @@ func resourceBucket() *schema.Resource {
 				Optional:              true,
 				Computed:              true,
 				Deprecated:            "Use the aws_s3_bucket_policy resource instead",
-				ValidateFunc:          validation.StringIsJSON,
+				ValidateFunc:          verify.ValidStringIsJSONWithNormalizedLengthAtMost(bucketPolicyMaxLength),
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
@@ -5,6 +5,7 @@ package s3
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,7 +15,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -40,10 +40,15 @@ func resourceBucketPolicy() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"minify_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
 				Required:              true,
-				ValidateFunc:          validation.StringIsJSON,
+				ValidateFunc:          verify.ValidStringIsJSONWithMinifiedLengthAtMost(bucketPolicyMaxLength),
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
@@ -52,6 +57,8 @@ func resourceBucketPolicy() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceBucketPolicyCustomizeDiff,
 	}
 }
 
@@ -59,7 +66,7 @@ func resourceBucketPolicyPut(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
-	policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
+	policy, err := verify.NormalizePolicyString(d.Get(names.AttrPolicy).(string), d.Get("minify_policy").(bool))
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -157,6 +164,25 @@ func resourceBucketPolicyDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// resourceBucketPolicyCustomizeDiff checks the size of the policy as it will be sent to S3.
+// The schema validation only checks the minified size, as it can't see minify_policy.
+func resourceBucketPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges(names.AttrPolicy, "minify_policy") || !d.NewValueKnown(names.AttrPolicy) || !d.NewValueKnown("minify_policy") {
+		return nil
+	}
+
+	policy, err := verify.NormalizePolicyString(d.Get(names.AttrPolicy).(string), d.Get("minify_policy").(bool))
+	if err != nil {
+		return err
+	}
+
+	if n := len(policy); n > bucketPolicyMaxLength {
+		return fmt.Errorf("%q is %d bytes once normalized, which exceeds the maximum of %d bytes; set minify_policy to true to send it with whitespace removed", names.AttrPolicy, n, bucketPolicyMaxLength)
+	}
+
+	return nil
+}
+
 func findBucketPolicy(ctx context.Context, conn *s3.Client, bucket string) (string, error) {
 	input := &s3.GetBucketPolicyInput{
 		Bucket: aws.String(bucket),
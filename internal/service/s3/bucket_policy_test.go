@@ -6,8 +6,10 @@ package s3_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -451,6 +453,74 @@ func TestAccS3BucketPolicy_directoryBucket(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketPolicy_whitespaceMinified(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The raw policy exceeds the 20 KB limit but fits once whitespace is removed.
+				Config: testAccBucketPolicyConfig_whitespace(rName, strings.Repeat(" ", 21*1024)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "minify_policy", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"minify_policy",
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicy_tooLarge(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketPolicyConfig_tooLarge(rName, strings.Repeat("a", 20*1024)),
+				ExpectError: regexache.MustCompile(`exceeds the maximum of 20480 bytes`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicy_tooLargeNormalized(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The policy fits once minified, but "&" is escaped as "\u0026" when normalized.
+				Config:      testAccBucketPolicyConfig_userAgentCondition(rName, strings.Repeat("&", 4*1024)),
+				ExpectError: regexache.MustCompile(`set minify_policy to true`),
+			},
+		},
+	})
+}
+
 func testAccCheckBucketPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -977,3 +1047,71 @@ data "aws_iam_policy_document" "test" {
 }
 `)
 }
+
+func testAccBucketPolicyConfig_whitespace(rName, padding string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  minify_policy = true
+  policy        = <<EOF
+{%[2]s
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "s3:*",
+      "Resource": "${aws_s3_bucket.test.arn}/*",
+      "Condition": {
+        "Bool": {
+          "aws:SecureTransport": "false"
+        }
+      }
+    }
+  ]
+}
+EOF
+}
+`, rName, padding)
+}
+
+func testAccBucketPolicyConfig_userAgentCondition(rName, userAgent string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  policy = <<EOF
+{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::%[1]s/*","Condition":{"StringLike":{"aws:UserAgent":"%[2]s"}}}]}
+EOF
+}
+`, rName, userAgent)
+}
+
+func testAccBucketPolicyConfig_tooLarge(rName, sid string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = %[2]q
+      Effect    = "Deny"
+      Principal = "*"
+      Action    = "s3:*"
+      Resource  = "arn:aws:s3:::%[1]s/*"
+    }]
+  })
+}
+`, rName, sid)
+}
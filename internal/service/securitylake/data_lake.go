@@ -14,8 +14,11 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -124,6 +127,9 @@ func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRe
 											Attributes: map[string]schema.Attribute{
 												"days": schema.Int64Attribute{
 													Optional: true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
 												},
 											},
 										},
@@ -134,9 +140,15 @@ func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRe
 											Attributes: map[string]schema.Attribute{
 												"days": schema.Int64Attribute{
 													Optional: true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
 												},
 												names.AttrStorageClass: schema.StringAttribute{
 													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.OneOf(dataLakeTransitionStorageClassValues()...),
+													},
 												},
 											},
 										},
@@ -175,6 +187,78 @@ func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRe
 	}
 }
 
+func (r *dataLakeResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data dataLakeResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	configurations, diags := data.Configurations.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for i, configuration := range configurations {
+		lifecycleConfiguration, diags := configuration.LifecycleConfiguration.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if lifecycleConfiguration == nil {
+			continue
+		}
+
+		attrPath := path.Root(names.AttrConfiguration).AtListIndex(i).AtName("lifecycle_configuration").AtListIndex(0)
+
+		transitions, diags := lifecycleConfiguration.Transitions.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var maxTransitionDays int64
+		storageClasses := make(map[string]struct{})
+
+		for _, transition := range transitions {
+			if storageClass := transition.StorageClass; !storageClass.IsNull() && !storageClass.IsUnknown() {
+				if _, ok := storageClasses[storageClass.ValueString()]; ok {
+					response.Diagnostics.AddAttributeError(
+						attrPath.AtName("transition"),
+						"Invalid Attribute Configuration",
+						fmt.Sprintf("storage_class %q is specified in more than one transition", storageClass.ValueString()),
+					)
+				}
+				storageClasses[storageClass.ValueString()] = struct{}{}
+			}
+
+			if days := transition.Days; !days.IsNull() && !days.IsUnknown() {
+				maxTransitionDays = max(maxTransitionDays, days.ValueInt64())
+			}
+		}
+
+		expiration, diags := lifecycleConfiguration.Expiration.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if expiration == nil || expiration.Days.IsNull() || expiration.Days.IsUnknown() {
+			continue
+		}
+
+		if days := expiration.Days.ValueInt64(); days <= maxTransitionDays {
+			response.Diagnostics.AddAttributeError(
+				attrPath.AtName("expiration").AtListIndex(0).AtName("days"),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("expiration days (%d) must be greater than the days of every transition (%d)", days, maxTransitionDays),
+			)
+		}
+	}
+}
+
 func (r *dataLakeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var data dataLakeResourceModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
@@ -571,6 +655,18 @@ type dataLakeReplicationConfigurationModel struct {
 	RoleARN fwtypes.ARN                      `tfsdk:"role_arn"`
 }
 
+// dataLakeTransitionStorageClassValues returns the S3 storage classes that Security Lake objects can be transitioned to.
+func dataLakeTransitionStorageClassValues() []string {
+	return []string{
+		"STANDARD_IA",
+		"ONEZONE_IA",
+		"INTELLIGENT_TIERING",
+		"GLACIER_IR",
+		"GLACIER",
+		"DEEP_ARCHIVE",
+	}
+}
+
 func retryDataLakeConflictWithMutex[T any](ctx context.Context, f func() (T, error)) (T, error) {
 	conns.GlobalMutexKV.Lock(dataLakeMutexKey)
 	defer conns.GlobalMutexKV.Unlock(dataLakeMutexKey)
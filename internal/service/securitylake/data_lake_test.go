@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -246,6 +247,36 @@ func testAccDataLake_lifeCycleUpdate(t *testing.T) {
 	})
 }
 
+func testAccDataLake_lifeCycleValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataLakeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataLakeConfig_lifeCycleInvalid(rName, 80, "ONEZONE_IA", 60),
+				ExpectError: regexache.MustCompile(`expiration days \(60\) must be greater than the days of every\s+transition \(80\)`),
+			},
+			{
+				Config:      testAccDataLakeConfig_lifeCycleInvalid(rName, 80, "STANDARD_IA", 300),
+				ExpectError: regexache.MustCompile(`storage_class "STANDARD_IA" is specified in more than one transition`),
+			},
+			{
+				Config:      testAccDataLakeConfig_lifeCycleInvalid(rName, 80, "STANDARD", 300),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
 func testAccDataLake_replication(t *testing.T) {
 	ctx := acctest.Context(t)
 	var datalake types.DataLakeResource
@@ -549,6 +580,38 @@ resource "aws_securitylake_data_lake" "test" {
 `, rName, acctest.Region()))
 }
 
+func testAccDataLakeConfig_lifeCycleInvalid(rName string, transitionDays int, storageClass string, expirationDays int) string {
+	return acctest.ConfigCompose(testAccDataLakeConfigConfig_base, fmt.Sprintf(`
+resource "aws_securitylake_data_lake" "test" {
+  meta_store_manager_role_arn = aws_iam_role.meta_store_manager.arn
+
+  configuration {
+    region = %[2]q
+
+    lifecycle_configuration {
+      transition {
+        days          = 31
+        storage_class = "STANDARD_IA"
+      }
+      transition {
+        days          = %[3]d
+        storage_class = %[4]q
+      }
+      expiration {
+        days = %[5]d
+      }
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_iam_role.meta_store_manager]
+}
+`, rName, acctest.Region(), transitionDays, storageClass, expirationDays))
+}
+
 func testAccDataLakeConfig_lifeCycleUpdate(rName string) string {
 	return acctest.ConfigCompose(testAccDataLakeConfigConfig_base, fmt.Sprintf(`
 resource "aws_securitylake_data_lake" "test" {
@@ -38,12 +38,13 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"sourceVersion":      testAccCustomLogSource_sourceVersion,
 		},
 		"DataLake": {
-			acctest.CtBasic:      testAccDataLake_basic,
-			acctest.CtDisappears: testAccDataLake_disappears,
-			"tags":               testAccDataLake_tags,
-			"lifecycle":          testAccDataLake_lifeCycle,
-			"lifecycleUpdate":    testAccDataLake_lifeCycleUpdate,
-			"replication":        testAccDataLake_replication,
+			acctest.CtBasic:       testAccDataLake_basic,
+			acctest.CtDisappears:  testAccDataLake_disappears,
+			"tags":                testAccDataLake_tags,
+			"lifecycle":           testAccDataLake_lifeCycle,
+			"lifecycleUpdate":     testAccDataLake_lifeCycleUpdate,
+			"lifecycleValidation": testAccDataLake_lifeCycleValidation,
+			"replication":         testAccDataLake_replication,
 		},
 		"Subscriber": {
 			"accessType":         testAccSubscriber_accessType,
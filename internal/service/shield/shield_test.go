@@ -27,6 +27,10 @@ func TestAccShield_serial(t *testing.T) {
 			"disabled":           testAccProactiveEngagement_disabled,
 			acctest.CtDisappears: testAccProactiveEngagement_disappears,
 		},
+		"Subscription": {
+			acctest.CtBasic: testAccSubscription_basic,
+			"autoRenew":     testAccSubscription_autoRenew,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
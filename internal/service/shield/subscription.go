@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscription")
+func newSubscriptionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &subscriptionResource{}, nil
+}
+
+type subscriptionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *subscriptionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_shield_subscription"
+}
+
+func (r *subscriptionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auto_renew": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AutoRenew](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrSkipDestroy: schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *subscriptionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data subscriptionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldClient(ctx)
+
+	input := &shield.CreateSubscriptionInput{}
+
+	_, err := conn.CreateSubscription(ctx, input)
+
+	// An account can only ever have a single subscription.
+	if errs.IsA[*awstypes.ResourceAlreadyExistsException](err) {
+		response.Diagnostics.AddError("creating Shield Subscription", fmt.Sprintf("account %s is already subscribed to Shield Advanced; use terraform import to manage the existing subscription: %s", r.Meta().AccountID, err))
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Shield Subscription", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID)
+
+	if v := data.AutoRenew.ValueEnum(); v != "" {
+		if err := updateSubscriptionAutoRenew(ctx, conn, v); err != nil {
+			response.Diagnostics.AddError("updating Shield Subscription auto-renew", err.Error())
+
+			return
+		}
+	}
+
+	subscription, err := findSubscription(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Shield Subscription", err.Error())
+
+		return
+	}
+
+	data.AutoRenew = fwtypes.StringEnumValue(subscription.AutoRenew)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *subscriptionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data subscriptionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldClient(ctx)
+
+	subscription, err := findSubscription(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Shield Subscription", err.Error())
+
+		return
+	}
+
+	data.AutoRenew = fwtypes.StringEnumValue(subscription.AutoRenew)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *subscriptionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new subscriptionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldClient(ctx)
+
+	if !new.AutoRenew.Equal(old.AutoRenew) {
+		if err := updateSubscriptionAutoRenew(ctx, conn, new.AutoRenew.ValueEnum()); err != nil {
+			response.Diagnostics.AddError("updating Shield Subscription auto-renew", err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete does not end the subscription, which runs for its full commitment period.
+// Instead automatic renewal is disabled, unless skip_destroy is set.
+func (r *subscriptionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data subscriptionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SkipDestroy.ValueBool() {
+		return
+	}
+
+	conn := r.Meta().ShieldClient(ctx)
+
+	err := updateSubscriptionAutoRenew(ctx, conn, awstypes.AutoRenewDisabled)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("disabling Shield Subscription auto-renew", err.Error())
+
+		return
+	}
+}
+
+func updateSubscriptionAutoRenew(ctx context.Context, conn *shield.Client, autoRenew awstypes.AutoRenew) error {
+	input := &shield.UpdateSubscriptionInput{
+		AutoRenew: autoRenew,
+	}
+
+	_, err := conn.UpdateSubscription(ctx, input)
+
+	return err
+}
+
+type subscriptionResourceModel struct {
+	AutoRenew   fwtypes.StringEnum[awstypes.AutoRenew] `tfsdk:"auto_renew"`
+	ID          types.String                           `tfsdk:"id"`
+	SkipDestroy types.Bool                             `tfsdk:"skip_destroy"`
+}
@@ -10,3 +10,13 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+// Signing platform IDs.
+// Notation-OCI-SHA384-ECDSA signs container images and other OCI artifacts using the Notation CLI.
+const (
+	platformIDAWSIoTDeviceManagementSHA256ECDSA = "AWSIoTDeviceManagement-SHA256-ECDSA"
+	platformIDAWSLambdaSHA384ECDSA              = "AWSLambda-SHA384-ECDSA"
+	platformIDAmazonFreeRTOSDefault             = "AmazonFreeRTOS-Default"
+	platformIDAmazonFreeRTOSTICC3220SF          = "AmazonFreeRTOS-TI-CC3220SF"
+	platformIDNotationOCISHA384ECDSA            = "Notation-OCI-SHA384-ECDSA"
+)
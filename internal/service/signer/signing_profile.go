@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/signer"
 	"github.com/aws/aws-sdk-go-v2/service/signer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -96,19 +97,16 @@ func ResourceSigningProfile() *schema.Resource {
 				MaxItems: 1,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrType: {
 							Type:             schema.TypeString,
 							Required:         true,
-							ForceNew:         true,
 							ValidateDiagFunc: enum.Validate[types.ValidityType](),
 						},
 						names.AttrValue: {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -145,7 +143,15 @@ func ResourceSigningProfile() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customdiff.ComputedIf(names.AttrVersion, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("signature_validity_period")
+			}),
+			customdiff.ComputedIf("version_arn", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("signature_validity_period")
+			}),
+		),
 	}
 }
 
@@ -164,12 +170,8 @@ func resourceSigningProfileCreate(ctx context.Context, d *schema.ResourceData, m
 		Tags:        getTagsIn(ctx),
 	}
 
-	if v, exists := d.GetOk("signature_validity_period"); exists {
-		signatureValidityPeriod := v.([]interface{})[0].(map[string]interface{})
-		input.SignatureValidityPeriod = &types.SignatureValidityPeriod{
-			Value: int32(signatureValidityPeriod[names.AttrValue].(int)),
-			Type:  types.ValidityType(signatureValidityPeriod[names.AttrType].(string)),
-		}
+	if v, ok := d.GetOk("signature_validity_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SignatureValidityPeriod = expandSignatureValidityPeriod(v.([]interface{})[0].(map[string]interface{}))
 	}
 
 	if v, ok := d.Get("signing_material").([]interface{}); ok && len(v) > 0 {
@@ -237,8 +239,29 @@ func resourceSigningProfileRead(ctx context.Context, d *schema.ResourceData, met
 
 func resourceSigningProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	if d.HasChange("signature_validity_period") {
+		// Putting an existing signing profile creates a new profile version.
+		input := &signer.PutSigningProfileInput{
+			PlatformId:  aws.String(d.Get("platform_id").(string)),
+			ProfileName: aws.String(d.Id()),
+		}
 
-	// Tags only.
+		if v, ok := d.GetOk("signature_validity_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SignatureValidityPeriod = expandSignatureValidityPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.Get("signing_material").([]interface{}); ok && len(v) > 0 {
+			input.SigningMaterial = expandSigningMaterial(v)
+		}
+
+		_, err := conn.PutSigningProfile(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Signer Signing Profile (%s): %s", d.Id(), err)
+		}
+	}
 
 	return append(diags, resourceSigningProfileRead(ctx, d, meta)...)
 }
@@ -263,6 +286,24 @@ func resourceSigningProfileDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func expandSignatureValidityPeriod(tfMap map[string]interface{}) *types.SignatureValidityPeriod {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SignatureValidityPeriod{}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = types.ValidityType(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(int); ok {
+		apiObject.Value = int32(v)
+	}
+
+	return apiObject
+}
+
 func expandSigningMaterial(in []interface{}) *types.SigningMaterial {
 	if len(in) == 0 {
 		return nil
@@ -314,11 +355,12 @@ func flattenSigningProfileRevocationRecord(apiObject *types.SigningProfileRevoca
 
 func PlatformID_Values() []string {
 	return []string{
-		"AWSLambda-SHA384-ECDSA",
-		"Notation-OCI-SHA384-ECDSA",
-		"AWSIoTDeviceManagement-SHA256-ECDSA",
-		"AmazonFreeRTOS-TI-CC3220SF",
-		"AmazonFreeRTOS-Default"}
+		platformIDAWSLambdaSHA384ECDSA,
+		platformIDNotationOCISHA384ECDSA,
+		platformIDAWSIoTDeviceManagementSHA256ECDSA,
+		platformIDAmazonFreeRTOSTICC3220SF,
+		platformIDAmazonFreeRTOSDefault,
+	}
 }
 
 func findSigningProfileByName(ctx context.Context, conn *signer.Client, name string) (*signer.GetSigningProfileOutput, error) {
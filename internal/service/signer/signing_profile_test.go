@@ -204,7 +204,7 @@ func TestAccSignerSigningProfile_tags(t *testing.T) {
 
 func TestAccSignerSigningProfile_signatureValidityPeriod(t *testing.T) {
 	ctx := acctest.Context(t)
-	var conf signer.GetSigningProfileOutput
+	var conf, conf2 signer.GetSigningProfileOutput
 	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
 	resourceName := "aws_signer_signing_profile.test_sp"
 
@@ -218,7 +218,7 @@ func TestAccSignerSigningProfile_signatureValidityPeriod(t *testing.T) {
 		CheckDestroy:             testAccCheckSigningProfileDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSigningProfileConfig_svp(rName),
+				Config: testAccSigningProfileConfig_svp(rName, 10, "DAYS"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckSigningProfileExists(ctx, resourceName, &conf),
 					resource.TestCheckResourceAttr(resourceName, "signature_validity_period.#", acctest.Ct1),
@@ -226,6 +226,52 @@ func TestAccSignerSigningProfile_signatureValidityPeriod(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "signature_validity_period.0.value", acctest.Ct10),
 				),
 			},
+			{
+				Config: testAccSigningProfileConfig_svp(rName, 2, "MONTHS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningProfileExists(ctx, resourceName, &conf2),
+					testAccCheckSigningProfileNotRecreated(&conf, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "signature_validity_period.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "signature_validity_period.0.type", "MONTHS"),
+					resource.TestCheckResourceAttr(resourceName, "signature_validity_period.0.value", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSignerSigningProfile_notation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf signer.GetSigningProfileOutput
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+	resourceName := "aws_signer_signing_profile.test_sp"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "Notation-OCI-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSigningProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningProfileConfig_notation(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSigningProfileExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "platform_id", "Notation-OCI-SHA384-ECDSA"),
+					resource.TestCheckResourceAttrSet(resourceName, "platform_display_name"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_record.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "signature_validity_period.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "signature_validity_period.0.type", "MONTHS"),
+					resource.TestCheckResourceAttr(resourceName, "signature_validity_period.0.value", "135"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -282,6 +328,20 @@ func testAccCheckSigningProfileExists(ctx context.Context, n string, v *signer.G
 	}
 }
 
+func testAccCheckSigningProfileNotRecreated(before, after *signer.GetSigningProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Arn), aws.ToString(after.Arn); before != after {
+			return fmt.Errorf("Signer Signing Profile (%s/%s) recreated", before, after)
+		}
+
+		if before, after := aws.ToString(before.ProfileVersion), aws.ToString(after.ProfileVersion); before == after {
+			return fmt.Errorf("Signer Signing Profile version (%s) not updated", before)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckSigningProfileDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SignerClient(ctx)
@@ -356,15 +416,29 @@ resource "aws_signer_signing_profile" "test_sp" {
 }`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
 
-func testAccSigningProfileConfig_svp(rName string) string {
+func testAccSigningProfileConfig_svp(rName string, value int, validityType string) string {
 	return fmt.Sprintf(`
 resource "aws_signer_signing_profile" "test_sp" {
   platform_id = "AWSLambda-SHA384-ECDSA"
   name_prefix = %[1]q
 
   signature_validity_period {
-    value = 10
-    type  = "DAYS"
+    value = %[2]d
+    type  = %[3]q
+  }
+}
+`, rName, value, validityType)
+}
+
+func testAccSigningProfileConfig_notation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test_sp" {
+  platform_id = "Notation-OCI-SHA384-ECDSA"
+  name        = %[1]q
+
+  signature_validity_period {
+    value = 135
+    type  = "MONTHS"
   }
 }
 `, rName)
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -44,7 +45,38 @@ func resourceAssociation() *schema.Resource {
 		MigrateState:  associationMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: resourceAssociationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
+			"alarm_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+						"ignore_poll_alarm_failure": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			"apply_only_at_cron_interval": {
 				Type:     schema.TypeBool,
 				Default:  false,
@@ -71,6 +103,11 @@ func resourceAssociation() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 50),
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"compliance_severity": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -138,16 +175,52 @@ func resourceAssociation() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 256),
 			},
+			"schedule_offset": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
 			"sync_compliance": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.AssociationSyncCompliance](),
 			},
+			"target_maps": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      300,
+				ConflictsWith: []string{"targets"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrParameter: {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrKey: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 50),
+									},
+									names.AttrValues: {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 25,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"targets": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 5,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      5,
+				ConflictsWith: []string{"target_maps"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrKey: {
@@ -185,6 +258,10 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.ApplyOnlyAtCronInterval = v.(bool)
 	}
 
+	if v, ok := d.GetOk("alarm_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AlarmConfiguration = expandAlarmConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("association_name"); ok {
 		input.AssociationName = aws.String(v.(string))
 	}
@@ -193,6 +270,10 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.CalendarNames = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("compliance_severity"); ok {
 		input.ComplianceSeverity = awstypes.AssociationComplianceSeverity(v.(string))
 	}
@@ -225,10 +306,18 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.ScheduleExpression = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("schedule_offset"); ok {
+		input.ScheduleOffset = aws.Int32(int32(v.(int)))
+	}
+
 	if v, ok := d.GetOk("sync_compliance"); ok {
 		input.SyncCompliance = awstypes.AssociationSyncCompliance(v.(string))
 	}
 
+	if v, ok := d.GetOk("target_maps"); ok && len(v.([]interface{})) > 0 {
+		input.TargetMaps = expandTargetMaps(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("targets"); ok {
 		input.Targets = expandTargets(v.([]interface{}))
 	}
@@ -267,6 +356,9 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "reading SSM Association (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("alarm_configuration", flattenAlarmConfiguration(association.AlarmConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_configuration: %s", err)
+	}
 	d.Set("apply_only_at_cron_interval", association.ApplyOnlyAtCronInterval)
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -279,6 +371,7 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set(names.AttrAssociationID, association.AssociationId)
 	d.Set("association_name", association.AssociationName)
 	d.Set("automation_target_parameter_name", association.AutomationTargetParameterName)
+	d.Set("calendar_names", association.CalendarNames)
 	d.Set("compliance_severity", association.ComplianceSeverity)
 	d.Set("document_version", association.DocumentVersion)
 	d.Set(names.AttrInstanceID, association.InstanceId)
@@ -292,7 +385,11 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
 	}
 	d.Set(names.AttrScheduleExpression, association.ScheduleExpression)
+	d.Set("schedule_offset", aws.ToInt32(association.ScheduleOffset))
 	d.Set("sync_compliance", association.SyncCompliance)
+	if err := d.Set("target_maps", flattenTargetMaps(association.TargetMaps)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_maps: %s", err)
+	}
 	if err := d.Set("targets", flattenTargets(association.Targets)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting targets: %s", err)
 	}
@@ -313,6 +410,10 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		input.ApplyOnlyAtCronInterval = v.(bool)
 	}
 
+	if v, ok := d.GetOk("alarm_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AlarmConfiguration = expandAlarmConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("association_name"); ok {
 		input.AssociationName = aws.String(v.(string))
 	}
@@ -321,6 +422,11 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		input.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	// An empty list removes all calendars from the association.
+	if v := d.Get("calendar_names").(*schema.Set); v.Len() > 0 || d.HasChange("calendar_names") {
+		input.CalendarNames = flex.ExpandStringValueSet(v)
+	}
+
 	if v, ok := d.GetOk("compliance_severity"); ok {
 		input.ComplianceSeverity = awstypes.AssociationComplianceSeverity(v.(string))
 	}
@@ -349,10 +455,18 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		input.ScheduleExpression = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("schedule_offset"); ok {
+		input.ScheduleOffset = aws.Int32(int32(v.(int)))
+	}
+
 	if d.HasChange("sync_compliance") {
 		input.SyncCompliance = awstypes.AssociationSyncCompliance(d.Get("sync_compliance").(string))
 	}
 
+	if v, ok := d.GetOk("target_maps"); ok && len(v.([]interface{})) > 0 {
+		input.TargetMaps = expandTargetMaps(v.([]interface{}))
+	}
+
 	if _, ok := d.GetOk("targets"); ok {
 		input.Targets = expandTargets(d.Get("targets").([]interface{}))
 	}
@@ -386,6 +500,25 @@ func resourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceAssociationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrScheduleExpression) {
+		return nil
+	}
+
+	// Both settings only apply to cron schedules.
+	isCron := strings.HasPrefix(d.Get(names.AttrScheduleExpression).(string), "cron(")
+
+	if d.Get("apply_only_at_cron_interval").(bool) && !isCron {
+		return errors.New(`"apply_only_at_cron_interval" requires a cron "schedule_expression"`)
+	}
+
+	if v, ok := d.GetOk("schedule_offset"); ok && v.(int) > 0 && !isCron {
+		return errors.New(`"schedule_offset" requires a cron "schedule_expression"`)
+	}
+
+	return nil
+}
+
 func findAssociationByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.AssociationDescription, error) {
 	input := &ssm.DescribeAssociationInput{
 		AssociationId: aws.String(id),
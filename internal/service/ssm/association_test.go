@@ -639,6 +639,178 @@ func TestAccSSMAssociation_syncCompliance(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_scheduleOffset(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAssociationConfig_scheduleOffset(rName, "rate(7 days)", 2),
+				ExpectError: regexache.MustCompile(`"schedule_offset" requires a cron "schedule_expression"`),
+			},
+			{
+				Config: testAccAssociationConfig_scheduleOffset(rName, "cron(0 16 ? * TUE#3 *)", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrScheduleExpression, "cron(0 16 ? * TUE#3 *)"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_offset", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_scheduleOffset(rName, "cron(0 16 ? * TUE#3 *)", 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_offset", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_applyOnlyAtCronIntervalRequiresCron(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAssociationConfig_applyOnlyAtCronIntervalRate(rName),
+				ExpectError: regexache.MustCompile(`"apply_only_at_cron_interval" requires a cron "schedule_expression"`),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_calendarNames(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_calendarNames(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar", names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_calendarNames(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_targetMaps(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_targetMaps(rName, "us-east-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_maps.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_maps.0.parameter.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_maps.0.parameter.*", map[string]string{
+						names.AttrKey: "Region",
+						"values.#":    acctest.Ct1,
+						"values.0":    "us-east-1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_targetMaps(rName, "us-west-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_maps.0.parameter.*", map[string]string{
+						names.AttrKey: "Region",
+						"values.0":    "us-west-2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_alarmConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_alarmConfiguration(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.alarm.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "alarm_configuration.0.alarm.0.name", "aws_cloudwatch_metric_alarm.test", "alarm_name"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.ignore_poll_alarm_failure", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_alarmConfiguration(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.ignore_poll_alarm_failure", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1639,3 +1811,152 @@ resource "aws_ssm_association" "test" {
 }
 `)
 }
+
+func testAccAssociationConfig_baseDocument(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+
+}
+`, rName)
+}
+
+func testAccAssociationConfig_scheduleOffset(rName, scheduleExpression string, scheduleOffset int) string {
+	return acctest.ConfigCompose(testAccAssociationConfig_baseDocument(rName), fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  name                = aws_ssm_document.test.name
+  schedule_expression = %[1]q
+  schedule_offset     = %[2]d
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, scheduleExpression, scheduleOffset))
+}
+
+func testAccAssociationConfig_applyOnlyAtCronIntervalRate(rName string) string {
+	return acctest.ConfigCompose(testAccAssociationConfig_baseDocument(rName), `
+resource "aws_ssm_association" "test" {
+  name                        = aws_ssm_document.test.name
+  schedule_expression         = "rate(30 minutes)"
+  apply_only_at_cron_interval = true
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`)
+}
+
+func testAccAssociationConfig_calendarNames(rName string, withCalendar bool) string {
+	return acctest.ConfigCompose(testAccAssociationConfig_baseDocument(rName), fmt.Sprintf(`
+resource "aws_ssm_document" "calendar" {
+  name            = "%[1]s-calendar"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<CAL
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:DEFAULT_OPEN
+X-WR-CALDESC:
+BEGIN:VTODO
+DTSTAMP:20200320T004207Z
+UID:3b5af39a-d0b3-4049-a839-d7bb8af01f92
+SUMMARY:Add events to this calendar.
+END:VTODO
+END:VCALENDAR
+CAL
+}
+
+resource "aws_ssm_association" "test" {
+  name           = aws_ssm_document.test.name
+  calendar_names = %[2]t ? [aws_ssm_document.calendar.name] : []
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, rName, withCalendar))
+}
+
+func testAccAssociationConfig_targetMaps(rName, region string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  name = "AWS-DisablePublicAccessForSecurityGroup"
+
+  automation_target_parameter_name = "GroupId"
+
+  parameters = {
+    GroupId = "sg-00000000000000000"
+  }
+
+  target_maps {
+    parameter {
+      key    = "Region"
+      values = [%[2]q]
+    }
+  }
+
+  association_name = %[1]q
+}
+`, rName, region)
+}
+
+func testAccAssociationConfig_alarmConfiguration(rName string, ignorePollAlarmFailure bool) string {
+	return acctest.ConfigCompose(testAccAssociationConfig_baseDocument(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+}
+
+resource "aws_ssm_association" "test" {
+  name = aws_ssm_document.test.name
+
+  alarm_configuration {
+    alarm {
+      name = aws_cloudwatch_metric_alarm.test.alarm_name
+    }
+
+    ignore_poll_alarm_failure = %[2]t
+  }
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, rName, ignorePollAlarmFailure))
+}
@@ -6,6 +6,7 @@ package ssm
 import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -44,3 +45,99 @@ func flattenTargets(apiObjects []awstypes.Target) []interface{} {
 
 	return tfList
 }
+
+func expandTargetMaps(tfList []interface{}) []map[string][]string {
+	apiObjects := make([]map[string][]string, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := make(map[string][]string)
+
+		for _, tfMapRaw := range tfMap[names.AttrParameter].(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			apiObject[tfMap[names.AttrKey].(string)] = flex.ExpandStringValueList(tfMap[names.AttrValues].([]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTargetMaps(apiObjects []map[string][]string) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfParameters := make([]interface{}, 0, len(apiObject))
+
+		for k, v := range apiObject {
+			tfParameters = append(tfParameters, map[string]interface{}{
+				names.AttrKey:    k,
+				names.AttrValues: v,
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrParameter: tfParameters,
+		})
+	}
+
+	return tfList
+}
+
+func expandAlarmConfiguration(tfMap map[string]interface{}) *awstypes.AlarmConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.AlarmConfiguration{}
+
+	if v, ok := tfMap["alarm"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.Alarms = append(apiObject.Alarms, awstypes.Alarm{
+				Name: aws.String(tfMap[names.AttrName].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["ignore_poll_alarm_failure"].(bool); ok {
+		apiObject.IgnorePollAlarmFailure = v
+	}
+
+	return apiObject
+}
+
+func flattenAlarmConfiguration(apiObject *awstypes.AlarmConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfAlarms := make([]interface{}, 0, len(apiObject.Alarms))
+
+	for _, v := range apiObject.Alarms {
+		tfAlarms = append(tfAlarms, map[string]interface{}{
+			names.AttrName: aws.ToString(v.Name),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"alarm":                     tfAlarms,
+		"ignore_poll_alarm_failure": apiObject.IgnorePollAlarmFailure,
+	}
+
+	return []interface{}{tfMap}
+}
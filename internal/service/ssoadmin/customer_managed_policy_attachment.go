@@ -31,6 +31,7 @@ func ResourceCustomerManagedPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomerManagedPolicyAttachmentCreate,
 		ReadWithoutTimeout:   resourceCustomerManagedPolicyAttachmentRead,
+		UpdateWithoutTimeout: schema.NoopContext, // Allow provisioning_mode update.
 		DeleteWithoutTimeout: resourceCustomerManagedPolicyAttachmentDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -78,6 +79,12 @@ func ResourceCustomerManagedPolicyAttachment() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"provisioning_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      provisioningModeAutomatic,
+				ValidateFunc: validation.StringInSlice(provisioningMode_Values(), false),
+			},
 		},
 	}
 }
@@ -107,8 +114,10 @@ func resourceCustomerManagedPolicyAttachmentCreate(ctx context.Context, d *schem
 	d.SetId(id)
 
 	// After the policy has been attached to the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if d.Get("provisioning_mode").(string) == provisioningModeAutomatic {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return append(diags, resourceCustomerManagedPolicyAttachmentRead(ctx, d, meta)...)
@@ -141,6 +150,10 @@ func resourceCustomerManagedPolicyAttachmentRead(ctx context.Context, d *schema.
 	d.Set("instance_arn", instanceARN)
 	d.Set("permission_set_arn", permissionSetARN)
 
+	if _, ok := d.GetOk("provisioning_mode"); !ok {
+		d.Set("provisioning_mode", provisioningModeAutomatic) // e.g. on import.
+	}
+
 	return diags
 }
 
@@ -174,8 +187,10 @@ func resourceCustomerManagedPolicyAttachmentDelete(ctx context.Context, d *schem
 	}
 
 	// After the policy has been detached from the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if d.Get("provisioning_mode").(string) == provisioningModeAutomatic {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return diags
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -29,6 +30,7 @@ func ResourceManagedPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceManagedPolicyAttachmentCreate,
 		ReadWithoutTimeout:   resourceManagedPolicyAttachmentRead,
+		UpdateWithoutTimeout: schema.NoopContext, // Allow provisioning_mode update.
 		DeleteWithoutTimeout: resourceManagedPolicyAttachmentDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -63,6 +65,12 @@ func ResourceManagedPolicyAttachment() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"provisioning_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      provisioningModeAutomatic,
+				ValidateFunc: validation.StringInSlice(provisioningMode_Values(), false),
+			},
 		},
 	}
 }
@@ -99,8 +107,10 @@ func resourceManagedPolicyAttachmentCreate(ctx context.Context, d *schema.Resour
 	d.SetId(fmt.Sprintf("%s,%s,%s", managedPolicyARN, permissionSetARN, instanceARN))
 
 	// Provision ALL accounts after attaching the managed policy.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if d.Get("provisioning_mode").(string) == provisioningModeAutomatic {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return append(diags, resourceManagedPolicyAttachmentRead(ctx, d, meta)...)
@@ -132,6 +142,10 @@ func resourceManagedPolicyAttachmentRead(ctx context.Context, d *schema.Resource
 	d.Set("managed_policy_name", policy.Name)
 	d.Set("permission_set_arn", permissionSetARN)
 
+	if _, ok := d.GetOk("provisioning_mode"); !ok {
+		d.Set("provisioning_mode", provisioningModeAutomatic) // e.g. on import.
+	}
+
 	return diags
 }
 
@@ -161,8 +175,10 @@ func resourceManagedPolicyAttachmentDelete(ctx context.Context, d *schema.Resour
 	}
 
 	// Provision ALL accounts after detaching the managed policy.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if d.Get("provisioning_mode").(string) == provisioningModeAutomatic {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return diags
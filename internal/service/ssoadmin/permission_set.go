@@ -78,6 +78,12 @@ func ResourcePermissionSet() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`[\w+=,.@-]+`), "must match [\\w+=,.@-]"),
 				),
 			},
+			"provisioning_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      provisioningModeAutomatic,
+				ValidateFunc: validation.StringInSlice(provisioningMode_Values(), false),
+			},
 			"relay_state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -172,6 +178,10 @@ func resourcePermissionSetRead(ctx context.Context, d *schema.ResourceData, meta
 
 	setTagsOut(ctx, Tags(tags))
 
+	if _, ok := d.GetOk("provisioning_mode"); !ok {
+		d.Set("provisioning_mode", provisioningModeAutomatic) // e.g. on import.
+	}
+
 	return diags
 }
 
@@ -214,8 +224,10 @@ func resourcePermissionSetUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		// Re-provision ALL accounts after making the above changes
-		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
+		if d.Get("provisioning_mode").(string) == provisioningModeAutomatic {
+			if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
 		}
 	}
 
@@ -289,6 +301,18 @@ func FindPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissionSet
 	return output.PermissionSet, nil
 }
 
+const (
+	provisioningModeAutomatic = "AUTOMATIC"
+	provisioningModeManual    = "MANUAL"
+)
+
+func provisioningMode_Values() []string {
+	return []string{
+		provisioningModeAutomatic,
+		provisioningModeManual,
+	}
+}
+
 func provisionPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string, timeout time.Duration) error {
 	input := &ssoadmin.ProvisionPermissionSetInput{
 		InstanceArn:      aws.String(instanceARN),
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -64,6 +65,12 @@ func ResourcePermissionSetInlinePolicy() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"provisioning_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      provisioningModeAutomatic,
+				ValidateFunc: validation.StringInSlice(provisioningMode_Values(), false),
+			},
 		},
 	}
 }
@@ -94,8 +101,10 @@ func resourcePermissionSetInlinePolicyPut(ctx context.Context, d *schema.Resourc
 	d.SetId(fmt.Sprintf("%s,%s", permissionSetARN, instanceARN))
 
 	// (Re)provision ALL accounts after making the above changes.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if d.Get("provisioning_mode").(string) == provisioningModeAutomatic {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return append(diags, resourcePermissionSetInlinePolicyRead(ctx, d, meta)...)
@@ -131,6 +140,10 @@ func resourcePermissionSetInlinePolicyRead(ctx context.Context, d *schema.Resour
 	d.Set("instance_arn", instanceARN)
 	d.Set("permission_set_arn", permissionSetARN)
 
+	if _, ok := d.GetOk("provisioning_mode"); !ok {
+		d.Set("provisioning_mode", provisioningModeAutomatic) // e.g. on import.
+	}
+
 	return diags
 }
 
@@ -159,8 +172,10 @@ func resourcePermissionSetInlinePolicyDelete(ctx context.Context, d *schema.Reso
 	}
 
 	// (Re)provision ALL accounts after making the above changes.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if d.Get("provisioning_mode").(string) == provisioningModeAutomatic {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return diags
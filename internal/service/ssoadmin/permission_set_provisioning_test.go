@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminPermissionSetProvisioning_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_permission_set_provisioning.test"
+	inlinePolicyResourceName := "aws_ssoadmin_permission_set_inline_policy.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetProvisioningConfig_basic(rName, "s3:ListAllMyBuckets"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionSetInlinePolicyExists(ctx, inlinePolicyResourceName),
+					resource.TestCheckResourceAttr(inlinePolicyResourceName, "provisioning_mode", "MANUAL"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_arn", permissionSetResourceName, "instance_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", permissionSetResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+				),
+			},
+			{
+				Config: testAccPermissionSetProvisioningConfig_basic(rName, "s3:GetBucketLocation"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionSetInlinePolicyExists(ctx, inlinePolicyResourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionSetProvisioningConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "1"
+    actions   = [%[2]q]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::*"]
+  }
+}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %[1]q
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_ssoadmin_permission_set_inline_policy" "test" {
+  inline_policy      = data.aws_iam_policy_document.test.json
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  provisioning_mode  = "MANUAL"
+}
+
+resource "aws_ssoadmin_permission_set_provisioning" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  triggers = {
+    inline_policy = sha1(aws_ssoadmin_permission_set_inline_policy.test.inline_policy)
+  }
+}
+`, rName, action)
+}
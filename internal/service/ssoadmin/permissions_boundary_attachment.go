@@ -30,6 +30,7 @@ func ResourcePermissionsBoundaryAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePermissionsBoundaryAttachmentCreate,
 		ReadWithoutTimeout:   resourcePermissionsBoundaryAttachmentRead,
+		UpdateWithoutTimeout: schema.NoopContext, // Allow provisioning_mode update.
 		DeleteWithoutTimeout: resourcePermissionsBoundaryAttachmentDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -54,6 +55,12 @@ func ResourcePermissionsBoundaryAttachment() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"provisioning_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      provisioningModeAutomatic,
+				ValidateFunc: validation.StringInSlice(provisioningMode_Values(), false),
+			},
 			"permissions_boundary": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -121,8 +128,10 @@ func resourcePermissionsBoundaryAttachmentCreate(ctx context.Context, d *schema.
 	d.SetId(id)
 
 	// After the policy has been attached to the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if d.Get("provisioning_mode").(string) == provisioningModeAutomatic {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return append(diags, resourcePermissionsBoundaryAttachmentRead(ctx, d, meta)...)
@@ -155,6 +164,10 @@ func resourcePermissionsBoundaryAttachmentRead(ctx context.Context, d *schema.Re
 		return sdkdiag.AppendErrorf(diags, "setting permissions_boundary: %s", err)
 	}
 
+	if _, ok := d.GetOk("provisioning_mode"); !ok {
+		d.Set("provisioning_mode", provisioningModeAutomatic) // e.g. on import.
+	}
+
 	return diags
 }
 
@@ -183,8 +196,10 @@ func resourcePermissionsBoundaryAttachmentDelete(ctx context.Context, d *schema.
 	}
 
 	// After the policy has been detached from the permission set, provision in all accounts that use this permission set.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if d.Get("provisioning_mode").(string) == provisioningModeAutomatic {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return diags
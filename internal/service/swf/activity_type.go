@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swf
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/swf"
+	"github.com/aws/aws-sdk-go-v2/service/swf/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_swf_activity_type", name="Activity Type")
+func resourceActivityType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceActivityTypeCreate,
+		ReadWithoutTimeout:   resourceActivityTypeRead,
+		DeleteWithoutTimeout: resourceActivityTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_task_heartbeat_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"default_task_list": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"default_task_priority": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTaskPriority,
+			},
+			"default_task_schedule_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"default_task_schedule_to_start_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTimeout,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrVersion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+const (
+	activityTypeResourceIDPartCount = 3
+)
+
+func resourceActivityTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	domain, name, version := d.Get(names.AttrDomain).(string), d.Get(names.AttrName).(string), d.Get(names.AttrVersion).(string)
+	id := errs.Must(flex.FlattenResourceId([]string{domain, name, version}, activityTypeResourceIDPartCount, false))
+	input := &swf.RegisterActivityTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("default_task_heartbeat_timeout"); ok {
+		input.DefaultTaskHeartbeatTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &types.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_close_timeout"); ok {
+		input.DefaultTaskScheduleToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_start_timeout"); ok {
+		input.DefaultTaskScheduleToStartTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.RegisterActivityType(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SWF Activity Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceActivityTypeRead(ctx, d, meta)...)
+}
+
+func resourceActivityTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), activityTypeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domain, name, version := parts[0], parts[1], parts[2]
+	output, err := findActivityTypeByThreePartKey(ctx, conn, domain, name, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SWF Activity Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	configuration, typeInfo := output.Configuration, output.TypeInfo
+	d.Set(names.AttrCreationDate, aws.ToTime(typeInfo.CreationDate).Format(time.RFC3339))
+	d.Set("default_task_heartbeat_timeout", configuration.DefaultTaskHeartbeatTimeout)
+	if configuration.DefaultTaskList != nil {
+		d.Set("default_task_list", configuration.DefaultTaskList.Name)
+	} else {
+		d.Set("default_task_list", nil)
+	}
+	d.Set("default_task_priority", configuration.DefaultTaskPriority)
+	d.Set("default_task_schedule_to_close_timeout", configuration.DefaultTaskScheduleToCloseTimeout)
+	d.Set("default_task_schedule_to_start_timeout", configuration.DefaultTaskScheduleToStartTimeout)
+	d.Set("default_task_start_to_close_timeout", configuration.DefaultTaskStartToCloseTimeout)
+	d.Set(names.AttrDescription, typeInfo.Description)
+	d.Set(names.AttrDomain, domain)
+	d.Set(names.AttrName, typeInfo.ActivityType.Name)
+	d.Set(names.AttrStatus, typeInfo.Status)
+	d.Set(names.AttrVersion, typeInfo.ActivityType.Version)
+
+	return diags
+}
+
+func resourceActivityTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	log.Printf("[DEBUG] Deleting SWF Activity Type: %s", d.Id())
+	_, err := conn.DeprecateActivityType(ctx, &swf.DeprecateActivityTypeInput{
+		ActivityType: &types.ActivityType{
+			Name:    aws.String(d.Get(names.AttrName).(string)),
+			Version: aws.String(d.Get(names.AttrVersion).(string)),
+		},
+		Domain: aws.String(d.Get(names.AttrDomain).(string)),
+	})
+
+	if errs.IsA[*types.TypeDeprecatedFault](err) || errs.IsA[*types.UnknownResourceFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findActivityTypeByThreePartKey(ctx context.Context, conn *swf.Client, domain, name, version string) (*swf.DescribeActivityTypeOutput, error) {
+	input := &swf.DescribeActivityTypeInput{
+		ActivityType: &types.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+		Domain: aws.String(domain),
+	}
+
+	output, err := conn.DescribeActivityType(ctx, input)
+
+	if errs.IsA[*types.UnknownResourceFault](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil || output.TypeInfo == nil || output.TypeInfo.ActivityType == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.TypeInfo.Status; status == types.RegistrationStatusDeprecated {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+var (
+	// validTimeout validates a duration in seconds, or "NONE" for unlimited.
+	validTimeout = validation.StringMatch(regexache.MustCompile(`^(NONE|[0-9]{1,8})$`), `must be a number of seconds or "NONE"`)
+	// validTaskPriority validates a task priority between -2147483648 and 2147483647.
+	validTaskPriority = validation.StringMatch(regexache.MustCompile(`^-?[0-9]{1,10}$`), "must be an integer")
+)
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swf_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfswf "github.com/hashicorp/terraform-provider-aws/internal/service/swf"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSWFActivityType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_activity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivityTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivityTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActivityTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, "default_task_heartbeat_timeout", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDomain, "aws_swf_domain.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "REGISTERED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSWFActivityType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_activity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivityTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivityTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActivityTypeExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfswf.ResourceActivityType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSWFActivityType_defaults(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_activity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivityTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivityTypeConfig_defaults(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActivityTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_task_heartbeat_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", "default"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_priority", "5"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_schedule_to_close_timeout", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_schedule_to_start_timeout", "300"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_start_to_close_timeout", "600"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckActivityTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_swf_activity_type" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfswf.FindActivityTypeByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SWF Activity Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckActivityTypeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFClient(ctx)
+
+		_, err = tfswf.FindActivityTypeByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		return err
+	}
+}
+
+func testAccActivityTypeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), fmt.Sprintf(`
+resource "aws_swf_activity_type" "test" {
+  domain  = aws_swf_domain.test.name
+  name    = %[1]q
+  version = "1.0"
+}
+`, rName))
+}
+
+func testAccActivityTypeConfig_defaults(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), fmt.Sprintf(`
+resource "aws_swf_activity_type" "test" {
+  domain      = aws_swf_domain.test.name
+  name        = %[1]q
+  version     = "1.0"
+  description = "test"
+
+  default_task_heartbeat_timeout         = "60"
+  default_task_list                      = "default"
+  default_task_priority                  = "5"
+  default_task_schedule_to_close_timeout = "NONE"
+  default_task_schedule_to_start_timeout = "300"
+  default_task_start_to_close_timeout    = "600"
+}
+`, rName))
+}
@@ -5,7 +5,11 @@ package swf
 
 // Exports for use in tests only.
 var (
-	FindDomainByName = findDomainByName
+	FindActivityTypeByThreePartKey = findActivityTypeByThreePartKey
+	FindDomainByName               = findDomainByName
+	FindWorkflowTypeByThreePartKey = findWorkflowTypeByThreePartKey
 
-	ResourceDomain = resourceDomain
+	ResourceActivityType = resourceActivityType
+	ResourceDomain       = resourceDomain
+	ResourceWorkflowType = resourceWorkflowType
 )
@@ -0,0 +1,583 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transcribe
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	"github.com/aws/aws-sdk-go-v2/service/transcribe/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_transcribe_call_analytics_category", name="Call Analytics Category")
+func ResourceCallAnalyticsCategory() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCallAnalyticsCategoryCreate,
+		ReadWithoutTimeout:   resourceCallAnalyticsCategoryRead,
+		UpdateWithoutTimeout: resourceCallAnalyticsCategoryUpdate,
+		DeleteWithoutTimeout: resourceCallAnalyticsCategoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"category_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.InputType](),
+			},
+			"last_update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrRule: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interruption_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema(),
+									"negate":              negateSchema(),
+									"participant_role":    participantRoleSchema(),
+									"relative_time_range": relativeTimeRangeSchema(),
+									"threshold":           thresholdSchema(),
+								},
+							},
+						},
+						"non_talk_time_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema(),
+									"negate":              negateSchema(),
+									"relative_time_range": relativeTimeRangeSchema(),
+									"threshold":           thresholdSchema(),
+								},
+							},
+						},
+						"sentiment_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema(),
+									"negate":              negateSchema(),
+									"participant_role":    participantRoleSchema(),
+									"relative_time_range": relativeTimeRangeSchema(),
+									"sentiments": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.SentimentValue](),
+										},
+									},
+								},
+							},
+						},
+						"transcript_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema(),
+									"negate":              negateSchema(),
+									"participant_role":    participantRoleSchema(),
+									"relative_time_range": relativeTimeRangeSchema(),
+									"targets": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 2000),
+										},
+									},
+									"transcript_filter_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.TranscriptFilterType](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func absoluteTimeRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_time": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"first": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"last": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"start_time": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func relativeTimeRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"first": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"last": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"start_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+			},
+		},
+	}
+}
+
+func negateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+}
+
+func participantRoleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: enum.Validate[types.ParticipantRole](),
+	}
+}
+
+func thresholdSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+}
+
+const (
+	ResNameCallAnalyticsCategory = "Call Analytics Category"
+)
+
+func resourceCallAnalyticsCategoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranscribeClient(ctx)
+
+	name := d.Get("category_name").(string)
+	in := &transcribe.CreateCallAnalyticsCategoryInput{
+		CategoryName: aws.String(name),
+		Rules:        expandRules(d.Get(names.AttrRule).([]interface{})),
+	}
+
+	if v, ok := d.GetOk("input_type"); ok {
+		in.InputType = types.InputType(v.(string))
+	}
+
+	out, err := conn.CreateCallAnalyticsCategory(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.Transcribe, create.ErrActionCreating, ResNameCallAnalyticsCategory, name, err)
+	}
+
+	if out == nil || out.CategoryProperties == nil {
+		return create.AppendDiagError(diags, names.Transcribe, create.ErrActionCreating, ResNameCallAnalyticsCategory, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.CategoryProperties.CategoryName))
+
+	return append(diags, resourceCallAnalyticsCategoryRead(ctx, d, meta)...)
+}
+
+func resourceCallAnalyticsCategoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranscribeClient(ctx)
+
+	out, err := FindCallAnalyticsCategoryByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transcribe Call Analytics Category (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Transcribe, create.ErrActionReading, ResNameCallAnalyticsCategory, d.Id(), err)
+	}
+
+	d.Set("category_name", out.CategoryName)
+	if out.CreateTime != nil {
+		d.Set("create_time", aws.ToTime(out.CreateTime).Format(time.RFC3339))
+	}
+	d.Set("input_type", out.InputType)
+	if out.LastUpdateTime != nil {
+		d.Set("last_update_time", aws.ToTime(out.LastUpdateTime).Format(time.RFC3339))
+	}
+
+	if err := d.Set(names.AttrRule, flattenRules(out.Rules)); err != nil {
+		return create.AppendDiagError(diags, names.Transcribe, create.ErrActionSetting, ResNameCallAnalyticsCategory, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceCallAnalyticsCategoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranscribeClient(ctx)
+
+	in := &transcribe.UpdateCallAnalyticsCategoryInput{
+		CategoryName: aws.String(d.Id()),
+		Rules:        expandRules(d.Get(names.AttrRule).([]interface{})),
+	}
+
+	if v, ok := d.GetOk("input_type"); ok {
+		in.InputType = types.InputType(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Transcribe Call Analytics Category (%s): %#v", d.Id(), in)
+	_, err := conn.UpdateCallAnalyticsCategory(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.Transcribe, create.ErrActionUpdating, ResNameCallAnalyticsCategory, d.Id(), err)
+	}
+
+	return append(diags, resourceCallAnalyticsCategoryRead(ctx, d, meta)...)
+}
+
+func resourceCallAnalyticsCategoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranscribeClient(ctx)
+
+	log.Printf("[INFO] Deleting Transcribe Call Analytics Category %s", d.Id())
+
+	_, err := conn.DeleteCallAnalyticsCategory(ctx, &transcribe.DeleteCallAnalyticsCategoryInput{
+		CategoryName: aws.String(d.Id()),
+	})
+
+	var notFoundException *types.NotFoundException
+	if errors.As(err, &notFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Transcribe, create.ErrActionDeleting, ResNameCallAnalyticsCategory, d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCallAnalyticsCategoryByName(ctx context.Context, conn *transcribe.Client, name string) (*types.CategoryProperties, error) {
+	in := &transcribe.GetCallAnalyticsCategoryInput{
+		CategoryName: aws.String(name),
+	}
+
+	out, err := conn.GetCallAnalyticsCategory(ctx, in)
+
+	var notFoundException *types.NotFoundException
+	if errors.As(err, &notFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.CategoryProperties == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.CategoryProperties, nil
+}
+
+func expandRules(tfList []interface{}) []types.Rule {
+	var apiObjects []types.Rule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["interruption_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject := types.InterruptionFilter{
+				AbsoluteTimeRange: expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+				Negate:            aws.Bool(tfMap["negate"].(bool)),
+				RelativeTimeRange: expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+			}
+
+			if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+				apiObject.ParticipantRole = types.ParticipantRole(v)
+			}
+
+			if v, ok := tfMap["threshold"].(int); ok && v > 0 {
+				apiObject.Threshold = aws.Int64(int64(v))
+			}
+
+			apiObjects = append(apiObjects, &types.RuleMemberInterruptionFilter{Value: apiObject})
+		}
+
+		if v, ok := tfMap["non_talk_time_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject := types.NonTalkTimeFilter{
+				AbsoluteTimeRange: expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+				Negate:            aws.Bool(tfMap["negate"].(bool)),
+				RelativeTimeRange: expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+			}
+
+			if v, ok := tfMap["threshold"].(int); ok && v > 0 {
+				apiObject.Threshold = aws.Int64(int64(v))
+			}
+
+			apiObjects = append(apiObjects, &types.RuleMemberNonTalkTimeFilter{Value: apiObject})
+		}
+
+		if v, ok := tfMap["sentiment_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject := types.SentimentFilter{
+				AbsoluteTimeRange: expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+				Negate:            aws.Bool(tfMap["negate"].(bool)),
+				RelativeTimeRange: expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+				Sentiments:        flex.ExpandStringyValueSet[types.SentimentValue](tfMap["sentiments"].(*schema.Set)),
+			}
+
+			if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+				apiObject.ParticipantRole = types.ParticipantRole(v)
+			}
+
+			apiObjects = append(apiObjects, &types.RuleMemberSentimentFilter{Value: apiObject})
+		}
+
+		if v, ok := tfMap["transcript_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject := types.TranscriptFilter{
+				AbsoluteTimeRange:    expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+				Negate:               aws.Bool(tfMap["negate"].(bool)),
+				RelativeTimeRange:    expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+				Targets:              flex.ExpandStringValueSet(tfMap["targets"].(*schema.Set)),
+				TranscriptFilterType: types.TranscriptFilterType(tfMap["transcript_filter_type"].(string)),
+			}
+
+			if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+				apiObject.ParticipantRole = types.ParticipantRole(v)
+			}
+
+			apiObjects = append(apiObjects, &types.RuleMemberTranscriptFilter{Value: apiObject})
+		}
+	}
+
+	return apiObjects
+}
+
+func expandAbsoluteTimeRange(tfList []interface{}) *types.AbsoluteTimeRange {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.AbsoluteTimeRange{}
+
+	if v, ok := tfMap["end_time"].(int); ok && v > 0 {
+		apiObject.EndTime = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["first"].(int); ok && v > 0 {
+		apiObject.First = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["last"].(int); ok && v > 0 {
+		apiObject.Last = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_time"].(int); ok && v > 0 {
+		apiObject.StartTime = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandRelativeTimeRange(tfList []interface{}) *types.RelativeTimeRange {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.RelativeTimeRange{}
+
+	if v, ok := tfMap["end_percentage"].(int); ok && v > 0 {
+		apiObject.EndPercentage = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["first"].(int); ok && v > 0 {
+		apiObject.First = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["last"].(int); ok && v > 0 {
+		apiObject.Last = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["start_percentage"].(int); ok && v > 0 {
+		apiObject.StartPercentage = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenRules(apiObjects []types.Rule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		switch v := apiObject.(type) {
+		case *types.RuleMemberInterruptionFilter:
+			tfMap["interruption_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range": flattenAbsoluteTimeRange(v.Value.AbsoluteTimeRange),
+				"negate":              aws.ToBool(v.Value.Negate),
+				"participant_role":    v.Value.ParticipantRole,
+				"relative_time_range": flattenRelativeTimeRange(v.Value.RelativeTimeRange),
+				"threshold":           int(aws.ToInt64(v.Value.Threshold)),
+			}}
+		case *types.RuleMemberNonTalkTimeFilter:
+			tfMap["non_talk_time_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range": flattenAbsoluteTimeRange(v.Value.AbsoluteTimeRange),
+				"negate":              aws.ToBool(v.Value.Negate),
+				"relative_time_range": flattenRelativeTimeRange(v.Value.RelativeTimeRange),
+				"threshold":           int(aws.ToInt64(v.Value.Threshold)),
+			}}
+		case *types.RuleMemberSentimentFilter:
+			tfMap["sentiment_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range": flattenAbsoluteTimeRange(v.Value.AbsoluteTimeRange),
+				"negate":              aws.ToBool(v.Value.Negate),
+				"participant_role":    v.Value.ParticipantRole,
+				"relative_time_range": flattenRelativeTimeRange(v.Value.RelativeTimeRange),
+				"sentiments":          flex.FlattenStringyValueSet(v.Value.Sentiments),
+			}}
+		case *types.RuleMemberTranscriptFilter:
+			tfMap["transcript_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range":    flattenAbsoluteTimeRange(v.Value.AbsoluteTimeRange),
+				"negate":                 aws.ToBool(v.Value.Negate),
+				"participant_role":       v.Value.ParticipantRole,
+				"relative_time_range":    flattenRelativeTimeRange(v.Value.RelativeTimeRange),
+				"targets":                flex.FlattenStringValueSet(v.Value.Targets),
+				"transcript_filter_type": v.Value.TranscriptFilterType,
+			}}
+		default:
+			continue
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAbsoluteTimeRange(apiObject *types.AbsoluteTimeRange) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"end_time":   int(aws.ToInt64(apiObject.EndTime)),
+		"first":      int(aws.ToInt64(apiObject.First)),
+		"last":       int(aws.ToInt64(apiObject.Last)),
+		"start_time": int(aws.ToInt64(apiObject.StartTime)),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRelativeTimeRange(apiObject *types.RelativeTimeRange) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"end_percentage":   int(aws.ToInt32(apiObject.EndPercentage)),
+		"first":            int(aws.ToInt32(apiObject.First)),
+		"last":             int(aws.ToInt32(apiObject.Last)),
+		"start_percentage": int(aws.ToInt32(apiObject.StartPercentage)),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -60,6 +60,10 @@ func ResourceMedicalVocabulary() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"en-US"}, false), // en-US is the only supported language for this service
 			},
+			"vocabulary_file_etag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"vocabulary_file_uri": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -151,7 +155,8 @@ func resourceMedicalVocabularyUpdate(ctx context.Context, d *schema.ResourceData
 			LanguageCode:   types.LanguageCode(d.Get(names.AttrLanguageCode).(string)),
 		}
 
-		if d.HasChanges("vocabulary_file_uri") {
+		// A changed ETag means the S3 object was rewritten in place, so re-read it.
+		if d.HasChanges("vocabulary_file_etag", "vocabulary_file_uri") {
 			in.VocabularyFileUri = aws.String(d.Get("vocabulary_file_uri").(string))
 		}
 
@@ -69,6 +69,11 @@ func ResourceVocabulary() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vocabulary_file_etag": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"phrases"},
+			},
 			"vocabulary_file_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -170,7 +175,8 @@ func resourceVocabularyUpdate(ctx context.Context, d *schema.ResourceData, meta
 			LanguageCode:   types.LanguageCode(d.Get(names.AttrLanguageCode).(string)),
 		}
 
-		if d.HasChanges("vocabulary_file_uri", "phrases") {
+		// A changed ETag means the S3 object was rewritten in place, so re-read it.
+		if d.HasChanges("vocabulary_file_etag", "vocabulary_file_uri", "phrases") {
 			if d.Get("vocabulary_file_uri").(string) != "" {
 				in.VocabularyFileUri = aws.String(d.Get("vocabulary_file_uri").(string))
 			} else {
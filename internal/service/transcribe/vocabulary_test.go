@@ -135,6 +135,45 @@ func TestAccTranscribeVocabulary_updateS3URI(t *testing.T) {
 	})
 }
 
+func TestAccTranscribeVocabulary_updateFileContent(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var vocabulary transcribe.GetVocabularyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TranscribeEndpointID)
+			testAccVocabulariesPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranscribeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVocabularyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyConfig_fileETag(rName, "test-fixtures/vocabulary_test1.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(ctx, resourceName, &vocabulary),
+					resource.TestCheckResourceAttrPair(resourceName, "vocabulary_file_etag", "aws_s3_object.test", "etag"),
+				),
+			},
+			{
+				Config: testAccVocabularyConfig_fileETag(rName, "test-fixtures/vocabulary_test2.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(ctx, resourceName, &vocabulary),
+					resource.TestCheckResourceAttrPair(resourceName, "vocabulary_file_etag", "aws_s3_object.test", "etag"),
+					resource.TestCheckResourceAttr(resourceName, "vocabulary_file_uri", "s3://"+rName+"/transcribe/test.txt"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTranscribeVocabulary_updateTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -408,3 +447,26 @@ resource "aws_transcribe_vocabulary" "test" {
 }
 `, rName, key1, value1, key2, value2))
 }
+
+func testAccVocabularyConfig_fileETag(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "transcribe/test.txt"
+  source = %[2]q
+  etag   = filemd5(%[2]q)
+}
+
+resource "aws_transcribe_vocabulary" "test" {
+  vocabulary_name      = %[1]q
+  language_code        = "en-US"
+  vocabulary_file_uri  = "s3://${aws_s3_bucket.test.id}/${aws_s3_object.test.key}"
+  vocabulary_file_etag = aws_s3_object.test.etag
+}
+`, rName, source)
+}
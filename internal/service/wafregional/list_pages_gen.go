@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListActivatedRulesInRuleGroup,ListByteMatchSets,ListGeoMatchSets,ListIPSets,ListRateBasedRules,ListRegexMatchSets,ListRegexPatternSets,ListRules,ListRuleGroups,ListSizeConstraintSets,ListSqlInjectionMatchSets,ListSubscribedRuleGroups,ListWebACLs,ListXssMatchSets -Paginator=NextMarker"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListActivatedRulesInRuleGroup,ListByteMatchSets,ListGeoMatchSets,ListIPSets,ListLoggingConfigurations,ListRateBasedRules,ListRegexMatchSets,ListRegexPatternSets,ListRules,ListRuleGroups,ListSizeConstraintSets,ListSqlInjectionMatchSets,ListSubscribedRuleGroups,ListWebACLs,ListXssMatchSets -Paginator=NextMarker"; DO NOT EDIT.
 
 package wafregional
 
@@ -73,6 +73,22 @@ func listIPSetsPages(ctx context.Context, conn *wafregional.Client, input *wafre
 	}
 	return nil
 }
+func listLoggingConfigurationsPages(ctx context.Context, conn *wafregional.Client, input *wafregional.ListLoggingConfigurationsInput, fn func(*wafregional.ListLoggingConfigurationsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListLoggingConfigurations(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextMarker = output.NextMarker
+	}
+	return nil
+}
 func listRateBasedRulesPages(ctx context.Context, conn *wafregional.Client, input *wafregional.ListRateBasedRulesInput, fn func(*wafregional.ListRateBasedRulesOutput, bool) bool) error {
 	for {
 		output, err := conn.ListRateBasedRules(ctx, input)
@@ -127,6 +127,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_wafregional_web_acl_association",
 			Name:     "Web ACL Association",
 		},
+		{
+			Factory:  resourceWebACLLoggingConfiguration,
+			TypeName: "aws_wafregional_web_acl_logging_configuration",
+			Name:     "Web ACL Logging Configuration",
+		},
 		{
 			Factory:  resourceXSSMatchSet,
 			TypeName: "aws_wafregional_xss_match_set",
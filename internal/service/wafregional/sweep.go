@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_wafregional_byte_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_byte_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_byte_match_set",
 		F:    sweepByteMatchSet,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_geo_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_geo_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_geo_match_set",
 		F:    sweepGeoMatchSet,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_ipset", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_ipset", &resource.Sweeper{
 		Name: "aws_wafregional_ipset",
 		F:    sweepIPSet,
 		Dependencies: []string{
@@ -46,7 +46,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -55,7 +55,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_regex_match_set",
 		F:    sweepRegexMatchSet,
 		Dependencies: []string{
@@ -65,7 +65,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_regex_pattern_set", &resource.Sweeper{
 		Name: "aws_wafregional_regex_pattern_set",
 		F:    sweepRegexPatternSet,
 		Dependencies: []string{
@@ -75,7 +75,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
 		Name: "aws_wafregional_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -83,7 +83,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -92,7 +92,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_size_constraint_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_size_constraint_set", &resource.Sweeper{
 		Name: "aws_wafregional_size_constraint_set",
 		F:    sweepSizeConstraintSet,
 		Dependencies: []string{
@@ -102,7 +102,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_sql_injection_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_sql_injection_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_sql_injection_match_set",
 		F:    sweepSQLInjectionMatchSet,
 		Dependencies: []string{
@@ -112,12 +112,20 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
 		Name: "aws_wafregional_web_acl",
 		F:    sweepWebACLs,
+		Dependencies: []string{
+			"aws_wafregional_web_acl_logging_configuration",
+		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_xss_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_web_acl_logging_configuration", &resource.Sweeper{
+		Name: "aws_wafregional_web_acl_logging_configuration",
+		F:    sweepWebACLLoggingConfigurations,
+	})
+
+	sweep.AddTestSweepers("aws_wafregional_xss_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_xss_match_set",
 		F:    sweepXSSMatchSet,
 		Dependencies: []string{
@@ -705,7 +713,51 @@ func sweepWebACLs(region string) error {
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping WAFWeb ACLs (%s): %w", region, err)
+		return fmt.Errorf("error sweeping WAF Regional Web ACLs (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepWebACLLoggingConfigurations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.WAFRegionalClient(ctx)
+	input := &wafregional.ListLoggingConfigurationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = listLoggingConfigurationsPages(ctx, conn, input, func(page *wafregional.ListLoggingConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LoggingConfigurations {
+			r := resourceWebACLLoggingConfiguration()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.ResourceArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WAF Regional Web ACL Logging Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WAF Regional Web ACL Logging Configurations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WAF Regional Web ACL Logging Configurations (%s): %w", region, err)
 	}
 
 	return nil
@@ -5,6 +5,7 @@ package wafregional
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -34,7 +35,7 @@ func resourceWebACL() *schema.Resource {
 		DeleteWithoutTimeout: resourceWebACLDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceWebACLImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -244,13 +245,7 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "reading WAF Regional Web ACL (%s): %s", d.Id(), err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "waf-regional",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  "webacl/" + d.Id(),
-	}.String()
+	arn := webACLARN(meta.(*conns.AWSClient), d.Id())
 	d.Set(names.AttrARN, arn)
 	if err := d.Set(names.AttrDefaultAction, flattenAction(webACL.DefaultAction)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting default_action: %s", err)
@@ -261,26 +256,37 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 	}
 
-	input := &wafregional.GetLoggingConfigurationInput{
-		ResourceArn: aws.String(arn),
+	// The logging configuration may instead be managed by aws_wafregional_web_acl_logging_configuration,
+	// so only refresh it when it is managed here.
+	if len(d.Get(names.AttrLoggingConfiguration).([]interface{})) > 0 {
+		loggingConfiguration, err := findWebACLLoggingConfiguration(ctx, conn, arn)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading WAF Regional Web ACL (%s) logging configuration: %s", d.Id(), err)
+		}
+
+		if err := d.Set(names.AttrLoggingConfiguration, loggingConfiguration); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting logging_configuration: %s", err)
+		}
 	}
 
-	output, err := conn.GetLoggingConfiguration(ctx, input)
+	return diags
+}
 
-	loggingConfiguration := []interface{}{}
-	switch {
-	case err == nil:
-		loggingConfiguration = flattenLoggingConfiguration(output.LoggingConfiguration)
-	case errs.IsA[*awstypes.WAFNonexistentItemException](err):
-	default:
-		return sdkdiag.AppendErrorf(diags, "reading WAF Regional Web ACL (%s) logging configuration: %s", d.Id(), err)
+func resourceWebACLImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).WAFRegionalClient(ctx)
+
+	loggingConfiguration, err := findWebACLLoggingConfiguration(ctx, conn, webACLARN(meta.(*conns.AWSClient), d.Id()))
+
+	if err != nil {
+		return nil, fmt.Errorf("reading WAF Regional Web ACL (%s) logging configuration: %w", d.Id(), err)
 	}
 
 	if err := d.Set(names.AttrLoggingConfiguration, loggingConfiguration); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting logging_configuration: %s", err)
+		return nil, fmt.Errorf("setting logging_configuration: %w", err)
 	}
 
-	return diags
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -335,6 +341,32 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceWebACLRead(ctx, d, meta)...)
 }
 
+func webACLARN(c *conns.AWSClient, id string) string {
+	return arn.ARN{
+		Partition: c.Partition,
+		Service:   "waf-regional",
+		Region:    c.Region,
+		AccountID: c.AccountID,
+		Resource:  "webacl/" + id,
+	}.String()
+}
+
+// findWebACLLoggingConfiguration returns the flattened logging configuration of the specified web ACL,
+// or an empty list if logging is not configured.
+func findWebACLLoggingConfiguration(ctx context.Context, conn *wafregional.Client, arn string) ([]interface{}, error) {
+	output, err := findLoggingConfigurationByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return []interface{}{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenLoggingConfiguration(output), nil
+}
+
 func resourceWebACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFRegionalClient(ctx)
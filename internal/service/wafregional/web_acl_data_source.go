@@ -24,6 +24,10 @@ func dataSourceWebACL() *schema.Resource {
 		ReadWithoutTimeout: dataSourceWebACLRead,
 
 		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -46,7 +50,15 @@ func dataSourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("WAF Regional Web ACL", err))
 	}
 
-	d.SetId(aws.ToString(output.WebACLId))
+	id := aws.ToString(output.WebACLId)
+	webACL, err := findWebACLByID(ctx, conn, id)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAF Regional Web ACL (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrARN, webACL.WebACLArn)
 
 	return diags
 }
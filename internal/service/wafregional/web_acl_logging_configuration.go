@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafregional
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafregional"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafregional/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_wafregional_web_acl_logging_configuration", name="Web ACL Logging Configuration")
+func resourceWebACLLoggingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWebACLLoggingConfigurationPut,
+		ReadWithoutTimeout:   resourceWebACLLoggingConfigurationRead,
+		UpdateWithoutTimeout: resourceWebACLLoggingConfigurationPut,
+		DeleteWithoutTimeout: resourceWebACLLoggingConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_destination": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"redacted_fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_to_match": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									names.AttrType: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.MatchFieldType](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrResourceARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceWebACLLoggingConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFRegionalClient(ctx)
+
+	resourceARN := d.Get(names.AttrResourceARN).(string)
+	input := &wafregional.PutLoggingConfigurationInput{
+		LoggingConfiguration: &awstypes.LoggingConfiguration{
+			LogDestinationConfigs: []string{d.Get("log_destination").(string)},
+			RedactedFields:        expandRedactedFields(d.Get("redacted_fields").([]interface{})),
+			ResourceArn:           aws.String(resourceARN),
+		},
+	}
+
+	output, err := conn.PutLoggingConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting WAF Regional Web ACL Logging Configuration (%s): %s", resourceARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(aws.ToString(output.LoggingConfiguration.ResourceArn))
+	}
+
+	return append(diags, resourceWebACLLoggingConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceWebACLLoggingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFRegionalClient(ctx)
+
+	loggingConfiguration, err := findLoggingConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WAF Regional Web ACL Logging Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAF Regional Web ACL Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	if len(loggingConfiguration.LogDestinationConfigs) > 0 {
+		d.Set("log_destination", loggingConfiguration.LogDestinationConfigs[0])
+	} else {
+		d.Set("log_destination", nil)
+	}
+	if err := d.Set("redacted_fields", flattenRedactedFields(loggingConfiguration.RedactedFields)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting redacted_fields: %s", err)
+	}
+	d.Set(names.AttrResourceARN, loggingConfiguration.ResourceArn)
+
+	return diags
+}
+
+func resourceWebACLLoggingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFRegionalClient(ctx)
+
+	log.Printf("[INFO] Deleting WAF Regional Web ACL Logging Configuration: %s", d.Id())
+	_, err := conn.DeleteLoggingConfiguration(ctx, &wafregional.DeleteLoggingConfigurationInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.WAFNonexistentItemException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WAF Regional Web ACL Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findLoggingConfigurationByARN(ctx context.Context, conn *wafregional.Client, arn string) (*awstypes.LoggingConfiguration, error) {
+	input := &wafregional.GetLoggingConfigurationInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.GetLoggingConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.WAFNonexistentItemException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LoggingConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LoggingConfiguration, nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafregional_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwafregional "github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWAFRegionalWebACLLoggingConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	resourceName := "aws_wafregional_web_acl_logging_configuration.test"
+	webACLResourceName := "aws_wafregional_web_acl.test"
+	streamResourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.WAFRegionalEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFRegionalServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLLoggingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLLoggingConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLLoggingConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "log_destination", streamResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "redacted_fields.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceARN, webACLResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebACLLoggingConfigurationConfig_redactedFields(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLLoggingConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "redacted_fields.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "redacted_fields.0.field_to_match.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWAFRegionalWebACLLoggingConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	resourceName := "aws_wafregional_web_acl_logging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.WAFRegionalEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFRegionalServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLLoggingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLLoggingConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLLoggingConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfwafregional.ResourceWebACLLoggingConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWebACLLoggingConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wafregional_web_acl_logging_configuration" {
+				continue
+			}
+
+			_, err := tfwafregional.FindLoggingConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WAF Regional Web ACL Logging Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWebACLLoggingConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalClient(ctx)
+
+		_, err := tfwafregional.FindLoggingConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccWebACLLoggingConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_web_acl" "test" {
+  name        = %[1]q
+  metric_name = %[1]q
+
+  default_action {
+    type = "ALLOW"
+  }
+
+  lifecycle {
+    ignore_changes = [logging_configuration]
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "firehose.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  # the name must begin with aws-waf-logs-
+  name        = "aws-waf-logs-%[1]s"
+  destination = "extended_s3"
+
+  extended_s3_configuration {
+    role_arn   = aws_iam_role.test.arn
+    bucket_arn = aws_s3_bucket.test.arn
+  }
+}
+`, rName)
+}
+
+func testAccWebACLLoggingConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWebACLLoggingConfigurationConfig_base(rName), `
+resource "aws_wafregional_web_acl_logging_configuration" "test" {
+  log_destination = aws_kinesis_firehose_delivery_stream.test.arn
+  resource_arn    = aws_wafregional_web_acl.test.arn
+}
+`)
+}
+
+func testAccWebACLLoggingConfigurationConfig_redactedFields(rName string) string {
+	return acctest.ConfigCompose(testAccWebACLLoggingConfigurationConfig_base(rName), `
+resource "aws_wafregional_web_acl_logging_configuration" "test" {
+  log_destination = aws_kinesis_firehose_delivery_stream.test.arn
+  resource_arn    = aws_wafregional_web_acl.test.arn
+
+  redacted_fields {
+    field_to_match {
+      type = "URI"
+    }
+
+    field_to_match {
+      data = "referer"
+      type = "HEADER"
+    }
+  }
+}
+`)
+}
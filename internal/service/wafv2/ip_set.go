@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"strings"
 	"time"
 
@@ -24,11 +25,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	ipSetLockRetryTimeout = 5 * time.Minute
+)
+
 // @SDKResource("aws_wafv2_ip_set", name="IP Set")
 // @Tags(identifierAttribute="arn")
 func resourceIPSet() *schema.Resource {
@@ -64,23 +68,7 @@ func resourceIPSet() *schema.Resource {
 					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 						if d.GetRawPlan().GetAttr("addresses").IsWhollyKnown() {
 							o, n := d.GetChange("addresses")
-							oldAddresses := o.(*schema.Set).List()
-							newAddresses := n.(*schema.Set).List()
-							if len(oldAddresses) == len(newAddresses) {
-								for _, ov := range oldAddresses {
-									hasAddress := false
-									for _, nv := range newAddresses {
-										if itypes.CIDRBlocksEqual(ov.(string), nv.(string)) {
-											hasAddress = true
-											break
-										}
-									}
-									if !hasAddress {
-										return false
-									}
-								}
-								return true
-							}
+							return ipSetAddressesEqual(o.(*schema.Set).List(), n.(*schema.Set).List())
 						}
 						return false
 					},
@@ -209,6 +197,23 @@ func resourceIPSetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		log.Printf("[INFO] Updating WAFv2 IPSet: %s", d.Id())
 		_, err := conn.UpdateIPSet(ctx, input)
 
+		if errs.IsA[*awstypes.WAFOptimisticLockException](err) {
+			// The lock token is stale because the IP set was modified out of band.
+			// UpdateIPSet replaces the whole address list and the configuration is
+			// authoritative, so refresh the lock token and send the request again.
+			_, err = tfresource.RetryWhenIsA[*awstypes.WAFOptimisticLockException](ctx, ipSetLockRetryTimeout, func() (interface{}, error) {
+				output, err := findIPSetByThreePartKey(ctx, conn, d.Id(), d.Get(names.AttrName).(string), d.Get(names.AttrScope).(string))
+
+				if err != nil {
+					return nil, err
+				}
+
+				input.LockToken = output.LockToken
+
+				return conn.UpdateIPSet(ctx, input)
+			})
+		}
+
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating WAFv2 IPSet (%s): %s", d.Id(), err)
 		}
@@ -236,6 +241,24 @@ func resourceIPSetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return conn.DeleteIPSet(ctx, input)
 	})
 
+	if errs.IsA[*awstypes.WAFOptimisticLockException](err) {
+		_, err = tfresource.RetryWhenIsOneOf2[*awstypes.WAFAssociatedItemException, *awstypes.WAFOptimisticLockException](ctx, timeout, func() (interface{}, error) {
+			output, err := findIPSetByThreePartKey(ctx, conn, d.Id(), d.Get(names.AttrName).(string), d.Get(names.AttrScope).(string))
+
+			if err != nil {
+				return nil, err
+			}
+
+			input.LockToken = output.LockToken
+
+			return conn.DeleteIPSet(ctx, input)
+		})
+
+		if tfresource.NotFound(err) {
+			return diags
+		}
+	}
+
 	if errs.IsA[*awstypes.WAFNonexistentItemException](err) {
 		return diags
 	}
@@ -273,3 +296,37 @@ func findIPSetByThreePartKey(ctx context.Context, conn *wafv2.Client, id, name,
 
 	return output, nil
 }
+
+// ipSetAddressesEqual reports whether two address lists contain the same CIDR blocks,
+// ignoring differences in notation such as IPv6 zero compression.
+func ipSetAddressesEqual(old, new []interface{}) bool {
+	if len(old) != len(new) {
+		return false
+	}
+
+	addresses := make(map[string]int, len(old))
+	for _, v := range old {
+		addresses[ipSetAddressKey(v.(string))]++
+	}
+
+	for _, v := range new {
+		k := ipSetAddressKey(v.(string))
+		if addresses[k] == 0 {
+			return false
+		}
+		addresses[k]--
+	}
+
+	return true
+}
+
+// ipSetAddressKey returns a key that is equal for two addresses exactly when
+// types.CIDRBlocksEqual considers them equal.
+func ipSetAddressKey(address string) string {
+	ip, ipnet, err := net.ParseCIDR(address)
+	if err != nil {
+		return address
+	}
+
+	return ip.String() + "," + ipnet.String()
+}
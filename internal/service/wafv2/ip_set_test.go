@@ -20,6 +20,66 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestIPSetAddressesEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old, new []interface{}
+		want     bool
+	}{
+		"empty": {
+			want: true,
+		},
+		"different lengths": {
+			old:  []interface{}{"1.1.1.1/32"},
+			new:  []interface{}{"1.1.1.1/32", "2.2.2.2/32"},
+			want: false,
+		},
+		"same order": {
+			old:  []interface{}{"1.1.1.1/32", "2.2.2.2/32"},
+			new:  []interface{}{"1.1.1.1/32", "2.2.2.2/32"},
+			want: true,
+		},
+		"different order": {
+			old:  []interface{}{"1.1.1.1/32", "2.2.2.2/32"},
+			new:  []interface{}{"2.2.2.2/32", "1.1.1.1/32"},
+			want: true,
+		},
+		"different addresses": {
+			old:  []interface{}{"1.1.1.1/32", "2.2.2.2/32"},
+			new:  []interface{}{"1.1.1.1/32", "3.3.3.3/32"},
+			want: false,
+		},
+		"different host bits": {
+			old:  []interface{}{"1.1.1.0/24"},
+			new:  []interface{}{"1.1.1.1/24"},
+			want: false,
+		},
+		"equivalent IPv6 notation": {
+			old:  []interface{}{"1111:0000:0000:0000:0000:0000:0000:0111/128"},
+			new:  []interface{}{"1111::111/128"},
+			want: true,
+		},
+		"duplicates": {
+			old:  []interface{}{"1.1.1.1/32", "1.1.1.1/32"},
+			new:  []interface{}{"1.1.1.1/32", "2.2.2.2/32"},
+			want: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfwafv2.IPSetAddressesEqual(testCase.old, testCase.new), testCase.want; got != want {
+				t.Errorf("IPSetAddressesEqual(%v, %v) = %t, want %t", testCase.old, testCase.new, got, want)
+			}
+		})
+	}
+}
+
 func TestAccWAFV2IPSet_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.IPSet
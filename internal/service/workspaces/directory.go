@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -41,6 +42,27 @@ func ResourceDirectory() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"certificate_based_auth_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_authority_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(types.CertificateBasedAuthStatusEnumDisabled),
+							ValidateDiagFunc: enum.Validate[types.CertificateBasedAuthStatusEnum](),
+						},
+					},
+				},
+			},
 			"customer_user_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -77,6 +99,32 @@ func ResourceDirectory() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"saml_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"relay_state_parameter_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "RelayState",
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(types.SamlStatusEnumDisabled),
+							ValidateDiagFunc: enum.Validate[types.SamlStatusEnum](),
+						},
+						"user_access_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.All(validation.StringLenBetween(8, 200), validation.IsURLWithHTTPorHTTPS),
+						},
+					},
+				},
+			},
 			"self_service_permissions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -284,6 +332,30 @@ func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta i
 		log.Printf("[INFO] Modified WorkSpaces Directory (%s) creation properties", directoryID)
 	}
 
+	if v, ok := d.GetOk("saml_properties"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) SAML properties", directoryID)
+		_, err := conn.ModifySamlProperties(ctx, &workspaces.ModifySamlPropertiesInput{
+			ResourceId:     aws.String(directoryID),
+			SamlProperties: expandSAMLProperties(v.([]interface{})),
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting WorkSpaces Directory (%s) SAML properties: %s", directoryID, err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) SAML properties", directoryID)
+	}
+
+	if v, ok := d.GetOk("certificate_based_auth_properties"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) certificate-based authentication properties", directoryID)
+		_, err := conn.ModifyCertificateBasedAuthProperties(ctx, &workspaces.ModifyCertificateBasedAuthPropertiesInput{
+			ResourceId:                     aws.String(directoryID),
+			CertificateBasedAuthProperties: expandCertificateBasedAuthProperties(v.([]interface{})),
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting WorkSpaces Directory (%s) certificate-based authentication properties: %s", directoryID, err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) certificate-based authentication properties", directoryID)
+	}
+
 	if v, ok := d.GetOk("ip_group_ids"); ok && v.(*schema.Set).Len() > 0 {
 		ipGroupIds := v.(*schema.Set)
 		log.Printf("[DEBUG] Associating WorkSpaces Directory (%s) with IP Groups %s", directoryID, ipGroupIds.List())
@@ -343,6 +415,14 @@ func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting ip_group_ids: %s", err)
 	}
 
+	if err := d.Set("saml_properties", flattenSAMLProperties(directory.SamlProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting saml_properties: %s", err)
+	}
+
+	if err := d.Set("certificate_based_auth_properties", flattenCertificateBasedAuthProperties(directory.CertificateBasedAuthProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting certificate_based_auth_properties: %s", err)
+	}
+
 	if err := d.Set("dns_ip_addresses", flex.FlattenStringValueSet(directory.DnsIpAddresses)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting dns_ip_addresses: %s", err)
 	}
@@ -396,6 +476,48 @@ func resourceDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		log.Printf("[INFO] Modified WorkSpaces Directory (%s) creation properties", d.Id())
 	}
 
+	if d.HasChange("saml_properties") {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) SAML properties", d.Id())
+		properties := d.Get("saml_properties").([]interface{})
+
+		input := &workspaces.ModifySamlPropertiesInput{
+			ResourceId:     aws.String(d.Id()),
+			SamlProperties: expandSAMLProperties(properties),
+		}
+
+		// An empty user access URL must be explicitly deleted.
+		if input.SamlProperties == nil || aws.ToString(input.SamlProperties.UserAccessUrl) == "" {
+			input.PropertiesToDelete = []types.DeletableSamlProperty{types.DeletableSamlPropertySamlPropertiesUserAccessUrl}
+		}
+
+		_, err := conn.ModifySamlProperties(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Directory (%s) SAML properties: %s", d.Id(), err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) SAML properties", d.Id())
+	}
+
+	if d.HasChange("certificate_based_auth_properties") {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) certificate-based authentication properties", d.Id())
+		properties := d.Get("certificate_based_auth_properties").([]interface{})
+
+		input := &workspaces.ModifyCertificateBasedAuthPropertiesInput{
+			CertificateBasedAuthProperties: expandCertificateBasedAuthProperties(properties),
+			ResourceId:                     aws.String(d.Id()),
+		}
+
+		// An empty certificate authority ARN must be explicitly deleted.
+		if input.CertificateBasedAuthProperties == nil || aws.ToString(input.CertificateBasedAuthProperties.CertificateAuthorityArn) == "" {
+			input.PropertiesToDelete = []types.DeletableCertificateBasedAuthProperty{types.DeletableCertificateBasedAuthPropertyCertificateBasedAuthPropertiesCertificateAuthorityArn}
+		}
+
+		_, err := conn.ModifyCertificateBasedAuthProperties(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Directory (%s) certificate-based authentication properties: %s", d.Id(), err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) certificate-based authentication properties", d.Id())
+	}
+
 	if d.HasChange("ip_group_ids") {
 		o, n := d.GetChange("ip_group_ids")
 		old := o.(*schema.Set)
@@ -656,6 +778,73 @@ func FlattenWorkspaceCreationProperties(properties *types.DefaultWorkspaceCreati
 	}
 }
 
+func expandSAMLProperties(properties []interface{}) *types.SamlProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	p := properties[0].(map[string]interface{})
+
+	result := &types.SamlProperties{
+		Status: types.SamlStatusEnum(p[names.AttrStatus].(string)),
+	}
+
+	if p["relay_state_parameter_name"].(string) != "" {
+		result.RelayStateParameterName = aws.String(p["relay_state_parameter_name"].(string))
+	}
+
+	if p["user_access_url"].(string) != "" {
+		result.UserAccessUrl = aws.String(p["user_access_url"].(string))
+	}
+
+	return result
+}
+
+func expandCertificateBasedAuthProperties(properties []interface{}) *types.CertificateBasedAuthProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	p := properties[0].(map[string]interface{})
+
+	result := &types.CertificateBasedAuthProperties{
+		Status: types.CertificateBasedAuthStatusEnum(p[names.AttrStatus].(string)),
+	}
+
+	if p["certificate_authority_arn"].(string) != "" {
+		result.CertificateAuthorityArn = aws.String(p["certificate_authority_arn"].(string))
+	}
+
+	return result
+}
+
+func flattenSAMLProperties(properties *types.SamlProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"relay_state_parameter_name": aws.ToString(properties.RelayStateParameterName),
+			names.AttrStatus:             string(properties.Status),
+			"user_access_url":            aws.ToString(properties.UserAccessUrl),
+		},
+	}
+}
+
+func flattenCertificateBasedAuthProperties(properties *types.CertificateBasedAuthProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate_authority_arn": aws.ToString(properties.CertificateAuthorityArn),
+			names.AttrStatus:            string(properties.Status),
+		},
+	}
+}
+
 func flattenAccessPropertyEnumValues(t []types.AccessPropertyValue) []string {
 	var out []string
 
@@ -290,6 +290,53 @@ func testAccDirectory_workspaceAccessProperties(t *testing.T) {
 	})
 }
 
+func testAccDirectory_samlProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.WorkspaceDirectory
+	rName := sdkacctest.RandString(8)
+
+	resourceName := "aws_workspaces_directory.main"
+
+	domain := acctest.RandomDomainName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_samlProperties(rName, domain, "ENABLED_WITH_DIRECTORY_LOGIN_FALLBACK"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.relay_state_parameter_name", "LinkMode"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.status", "ENABLED_WITH_DIRECTORY_LOGIN_FALLBACK"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.user_access_url", "https://sso.example.com/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDirectoryConfig_samlProperties(rName, domain, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDirectory_workspaceCreationProperties(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.WorkspaceDirectory
@@ -939,6 +986,26 @@ resource "aws_workspaces_directory" "main" {
 `, rName))
 }
 
+func testAccDirectoryConfig_samlProperties(rName, domain, status string) string {
+	return acctest.ConfigCompose(
+		testAccDirectoryConfig_Prerequisites(rName, domain),
+		fmt.Sprintf(`
+resource "aws_workspaces_directory" "main" {
+  directory_id = aws_directory_service_directory.main.id
+
+  saml_properties {
+    relay_state_parameter_name = "LinkMode"
+    status                     = %[2]q
+    user_access_url            = "https://sso.example.com/"
+  }
+
+  tags = {
+    Name = "tf-testacc-workspaces-directory-%[1]s"
+  }
+}
+`, rName, status))
+}
+
 func testAccDirectoryConfig_workspaceCreationProperties(rName, domain string) string {
 	return acctest.ConfigCompose(
 		testAccDirectoryConfig_Prerequisites(rName, domain),
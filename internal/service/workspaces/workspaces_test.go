@@ -17,6 +17,7 @@ func TestAccWorkSpaces_serial(t *testing.T) {
 			acctest.CtBasic:               testAccDirectory_basic,
 			acctest.CtDisappears:          testAccDirectory_disappears,
 			"ipGroupIds":                  testAccDirectory_ipGroupIDs,
+			"samlProperties":              testAccDirectory_samlProperties,
 			"selfServicePermissions":      testAccDirectory_selfServicePermissions,
 			"subnetIDs":                   testAccDirectory_subnetIDs,
 			"tags":                        testAccDirectory_tags,
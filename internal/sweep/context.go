@@ -9,6 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
+type contextKey int
+
+const (
+	regionContextKey contextKey = iota
+	resourceTypeContextKey
+)
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -16,5 +23,19 @@ func Context(region string) context.Context {
 
 	ctx = logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionContextKey, region)
+
 	return ctx
 }
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionContextKey).(string)
+
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeContextKey).(string)
+
+	return v
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	flagSweepDryRun       = flag.Bool("sweep-dry-run", false, "List the resources that sweepers would delete, without deleting anything")
+	flagSweepDryRunReport = flag.String(flagNameSweepDryRunReport, "", "File to write the -sweep-dry-run JSON report to (defaults to stdout)")
+)
+
+// DryRun returns whether sweepers are being run with -sweep-dry-run.
+//
+// Only sweepers registered with Register are run in dry-run mode, with SweepOrchestrator
+// recording rather than deleting resources. Sweepers registered with AddTestSweepers are skipped.
+func DryRun() bool {
+	return *flagSweepDryRun
+}
+
+// Identifiable is implemented by Sweepables that can describe the resource they would delete.
+type Identifiable interface {
+	Identifiers() map[string]any
+}
+
+type dryRunReport struct {
+	Regions          map[string][]dryRunSweep `json:"regions"`
+	NotDryRunCapable map[string][]string      `json:"not_dry_run_capable,omitempty"`
+
+	mu sync.Mutex
+}
+
+type dryRunSweep struct {
+	ResourceType string           `json:"resource_type,omitempty"`
+	Resources    []map[string]any `json:"resources"`
+}
+
+var dryRunResults = &dryRunReport{
+	Regions:          make(map[string][]dryRunSweep),
+	NotDryRunCapable: make(map[string][]string),
+}
+
+// record appends the resources that would have been swept.
+// Sweepers are run in dependency order, so the per-Region order of entries reflects the resolved dependency graph.
+func (r *dryRunReport) record(ctx context.Context, sweepables []Sweepable) {
+	sweep := dryRunSweep{
+		ResourceType: resourceTypeFromContext(ctx),
+		Resources:    make([]map[string]any, 0, len(sweepables)),
+	}
+
+	for _, sweepable := range sweepables {
+		var identifiers map[string]any
+
+		if v, ok := sweepable.(Identifiable); ok {
+			identifiers = v.Identifiers()
+		} else {
+			identifiers = map[string]any{
+				"sweepable": fmt.Sprintf("%T", sweepable),
+			}
+		}
+
+		sweep.Resources = append(sweep.Resources, identifiers)
+	}
+
+	region := regionFromContext(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Regions[region] = append(r.Regions[region], sweep)
+}
+
+// recordNotCapable records a sweeper that was skipped because it cannot be dry run.
+func (r *dryRunReport) recordNotCapable(ctx context.Context) {
+	region := regionFromContext(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.NotDryRunCapable[region] = append(r.NotDryRunCapable[region], resourceTypeFromContext(ctx))
+}
+
+func (r *dryRunReport) write(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+func dryRunOrchestrator(ctx context.Context, sweepables []Sweepable) error {
+	tflog.Info(ctx, "Dry run, not sweeping resources", map[string]any{
+		"count": len(sweepables),
+	})
+
+	dryRunResults.record(ctx, sweepables)
+
+	return nil
+}
+
+// WriteDryRunReport writes the JSON report of resources that would have been swept.
+// It is a no-op unless sweepers are being run with -sweep-dry-run.
+func WriteDryRunReport() error {
+	if !DryRun() {
+		return nil
+	}
+
+	if path := *flagSweepDryRunReport; path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("creating sweeper dry run report (%s): %w", path, err)
+		}
+		defer f.Close()
+
+		return dryRunResults.write(f)
+	}
+
+	return dryRunResults.write(os.Stdout)
+}
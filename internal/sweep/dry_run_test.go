@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	id      string
+	deleted bool
+}
+
+func (s *testSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	s.deleted = true
+
+	return nil
+}
+
+func (s *testSweepable) Identifiers() map[string]any {
+	return map[string]any{
+		"id": s.id,
+	}
+}
+
+type testAnonymousSweepable struct{}
+
+func (testAnonymousSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func TestSweepOrchestratorDryRun(t *testing.T) {
+	defer func(v bool) { *flagSweepDryRun = v }(*flagSweepDryRun)
+	*flagSweepDryRun = true
+
+	defer func(v *dryRunReport) { dryRunResults = v }(dryRunResults)
+	dryRunResults = &dryRunReport{
+		Regions:          make(map[string][]dryRunSweep),
+		NotDryRunCapable: make(map[string][]string),
+	}
+
+	ctx := logWithResourceType(Context("tf-acc-test-region"), "aws_wafregional_web_acl")
+	sweepable := &testSweepable{id: "abc123"}
+
+	if err := SweepOrchestrator(ctx, []Sweepable{sweepable, testAnonymousSweepable{}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if sweepable.deleted {
+		t.Error("resource was deleted in dry run mode")
+	}
+
+	var sb strings.Builder
+	if err := dryRunResults.write(&sb); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{
+  "regions": {
+    "tf-acc-test-region": [
+      {
+        "resource_type": "aws_wafregional_web_acl",
+        "resources": [
+          {
+            "id": "abc123"
+          },
+          {
+            "sweepable": "sweep.testAnonymousSweepable"
+          }
+        ]
+      }
+    ]
+  }
+}
+`
+	if got := sb.String(); got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestAddTestSweepersDryRun(t *testing.T) {
+	defer func(v bool) { *flagSweepDryRun = v }(*flagSweepDryRun)
+	*flagSweepDryRun = true
+
+	defer func(v *dryRunReport) { dryRunResults = v }(dryRunResults)
+	dryRunResults = &dryRunReport{
+		Regions:          make(map[string][]dryRunSweep),
+		NotDryRunCapable: make(map[string][]string),
+	}
+
+	var called bool
+	sweeper := &resource.Sweeper{
+		Name: "aws_sweep_test_not_dry_run_capable",
+		F: func(region string) error {
+			called = true
+
+			return nil
+		},
+	}
+
+	AddTestSweepers(sweeper.Name, sweeper)
+
+	if err := sweeper.F("tf-acc-test-region"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if called {
+		t.Error("sweeper was run in dry run mode")
+	}
+
+	var sb strings.Builder
+	if err := dryRunResults.write(&sb); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{
+  "regions": {},
+  "not_dry_run_capable": {
+    "tf-acc-test-region": [
+      "aws_sweep_test_not_dry_run_capable"
+    ]
+  }
+}
+`
+	if got := sb.String(); got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
@@ -118,6 +118,16 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+func (sr *sweepResource) Identifiers() map[string]any {
+	identifiers := make(map[string]any, len(sr.attributes))
+
+	for _, attr := range sr.attributes {
+		identifiers[attr.path] = attr.value
+	}
+
+	return identifiers
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -32,5 +32,7 @@ func logger(ctx context.Context, loggerName, region string) context.Context {
 }
 
 func logWithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = context.WithValue(ctx, resourceTypeContextKey, resourceType)
+
 	return tflog.SetField(ctx, loggingKeyResourceType, resourceType)
 }
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const (
+	// Defined by terraform-plugin-testing.
+	flagNameSweep = "sweep"
+
+	flagNameSweepDryRunReport = "sweep-dry-run-report"
+	flagNameSweepParallel     = "sweep-parallel"
+)
+
+var (
+	flagSweepConcurrency = flag.Int("sweep-concurrency", 0, "Maximum number of resources each sweeper deletes concurrently (0 is unlimited)")
+	flagSweepParallel    = flag.Int(flagNameSweepParallel, 1, "Number of regions to sweep concurrently")
+)
+
+// Regions returns the regions passed via -sweep.
+func Regions() []string {
+	f := flag.Lookup(flagNameSweep)
+	if f == nil || f.Value.String() == "" {
+		return nil
+	}
+
+	return strings.Split(f.Value.String(), ",")
+}
+
+// ParallelRegions returns whether the -sweep regions are to be swept concurrently.
+func ParallelRegions() bool {
+	return *flagSweepParallel > 1 && len(Regions()) > 1
+}
+
+// Parallel sweeps the specified regions concurrently, at most -sweep-parallel at a time.
+// Each region is swept by a copy of the current test binary so that sweeper dependency
+// resolution and -sweep-allow-failures behave exactly as they do for a single region.
+func Parallel(ctx context.Context, regions ...string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("finding sweeper executable: %w", err)
+	}
+
+	reports := make(map[string]string)
+	if DryRun() {
+		for _, region := range regions {
+			f, err := os.CreateTemp("", "sweep-dry-run-*.json")
+			if err != nil {
+				return fmt.Errorf("creating sweeper dry run report for region (%s): %w", region, err)
+			}
+			f.Close()
+
+			reports[region] = f.Name()
+		}
+	}
+
+	var g multierror.Group
+	sem := make(chan struct{}, *flagSweepParallel)
+
+	for _, region := range regions {
+		region := region
+		args := regionArgs(region, reports[region])
+
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			cmd := exec.CommandContext(ctx, executable, args...)
+			cmd.Stdout = os.Stdout
+			cmd.Stderr = os.Stderr
+
+			if err := cmd.Run(); err != nil {
+				return fmt.Errorf("sweeping region (%s): %w", region, err)
+			}
+
+			return nil
+		})
+	}
+
+	errs := g.Wait()
+
+	for region, path := range reports {
+		if err := dryRunResults.merge(path); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("reading sweeper dry run report for region (%s): %w", region, err))
+		}
+
+		os.Remove(path)
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// regionArgs returns the command line used to sweep a single region,
+// forwarding all other flags set on the current command line.
+func regionArgs(region, report string) []string {
+	var args []string
+
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case flagNameSweep, flagNameSweepDryRunReport, flagNameSweepParallel:
+			return
+		}
+
+		args = append(args, fmt.Sprintf("-%s=%s", f.Name, f.Value.String()))
+	})
+
+	args = append(args, fmt.Sprintf("-%s=%s", flagNameSweep, region))
+
+	if report != "" {
+		args = append(args, fmt.Sprintf("-%s=%s", flagNameSweepDryRunReport, report))
+	}
+
+	return args
+}
+
+func (r *dryRunReport) merge(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if len(b) == 0 {
+		return nil
+	}
+
+	var report dryRunReport
+	if err := json.Unmarshal(b, &report); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for region, sweeps := range report.Regions {
+		r.Regions[region] = append(r.Regions[region], sweeps...)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testConcurrentSweepable struct {
+	active, peak *atomic.Int32
+}
+
+func (s testConcurrentSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	n := s.active.Add(1)
+	defer s.active.Add(-1)
+
+	for {
+		peak := s.peak.Load()
+		if n <= peak || s.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	return nil
+}
+
+func TestSweepOrchestratorConcurrency(t *testing.T) {
+	defer func(v int) { *flagSweepConcurrency = v }(*flagSweepConcurrency)
+	*flagSweepConcurrency = 2
+
+	var active, peak atomic.Int32
+	var sweepables []Sweepable
+	for i := 0; i < 10; i++ {
+		sweepables = append(sweepables, testConcurrentSweepable{active: &active, peak: &peak})
+	}
+
+	if err := SweepOrchestrator(context.Background(), sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := peak.Load(), int32(2); got > expected {
+		t.Errorf("got %d concurrent deletes, expected at most %d", got, expected)
+	}
+}
+
+func TestRegionArgs(t *testing.T) {
+	args := regionArgs("tf-acc-test-region", "report.json")
+
+	if got, expected := args[len(args)-2:], []string{"-sweep=tf-acc-test-region", "-sweep-dry-run-report=report.json"}; !slices.Equal(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	for _, arg := range args[:len(args)-2] {
+		if strings.HasPrefix(arg, "-sweep=") || strings.HasPrefix(arg, "-sweep-parallel=") || strings.HasPrefix(arg, "-sweep-dry-run-report=") {
+			t.Errorf("unexpected argument %q", arg)
+		}
+	}
+}
+
+func TestDryRunReportMerge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := os.WriteFile(path, []byte(`{"regions":{"tf-acc-test-region":[{"resource_type":"aws_wafregional_rule","resources":[{"id":"abc123"}]}]}}`), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	report := &dryRunReport{
+		Regions: map[string][]dryRunSweep{
+			"tf-acc-test-region": {
+				{ResourceType: "aws_wafregional_web_acl"},
+			},
+		},
+	}
+
+	if err := report.merge(path); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sweeps := report.Regions["tf-acc-test-region"]
+	if got, expected := len(sweeps), 2; got != expected {
+		t.Fatalf("got %d sweeps, expected %d", got, expected)
+	}
+	if got, expected := sweeps[1].ResourceType, "aws_wafregional_rule"; got != expected {
+		t.Errorf("got resource type %q, expected %q", got, expected)
+	}
+}
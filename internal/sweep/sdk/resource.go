@@ -89,6 +89,12 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+func (sr *sweepResource) Identifiers() map[string]any {
+	return map[string]any{
+		"id": sr.d.Id(),
+	}
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -109,12 +109,26 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	if DryRun() {
+		return dryRunOrchestrator(ctx, sweepables)
+	}
+
 	var g multierror.Group
+	var sem chan struct{}
+
+	if n := *flagSweepConcurrency; n > 0 {
+		sem = make(chan struct{}, n)
+	}
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
 		g.Go(func() error {
+			if sem != nil {
+				sem <- struct{}{}
+				defer func() { <-sem }()
+			}
+
 			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
 		})
 	}
@@ -135,9 +149,33 @@ func PartitionDNSSuffix(region string) string {
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
+// AddTestSweepers registers a sweeper whose function deletes resources itself.
+// Such sweepers cannot be dry run, so with -sweep-dry-run they are skipped and
+// reported as not dry-run capable. Use Register for new sweepers.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if f := s.F; f != nil {
+		s.F = func(region string) error {
+			if DryRun() {
+				ctx := logWithResourceType(Context(region), name)
+
+				tflog.Warn(ctx, "Dry run, skipping sweeper that is not dry-run capable")
+
+				dryRunResults.recordNotCapable(ctx)
+
+				return nil
+			}
+
+			return f(region)
+		}
+	}
+
+	resource.AddTestSweepers(name, s)
+}
+
 func Register(name string, f SweeperFn, dependencies ...string) {
 	resource.AddTestSweepers(name, &resource.Sweeper{
-		Name: name,
+		Name:         name,
+		Dependencies: dependencies,
 		F: func(region string) error {
 			ctx := Context(region)
 			ctx = logWithResourceType(ctx, name)
@@ -5,6 +5,9 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -18,5 +21,19 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
-	resource.TestMain(m)
+	flag.Parse()
+
+	if sweep.ParallelRegions() {
+		if err := sweep.Parallel(ctx, sweep.Regions()...); err != nil {
+			fmt.Fprintf(os.Stderr, "sweeping regions in parallel: %s\n", err)
+			os.Exit(1)
+		}
+	} else {
+		resource.TestMain(m)
+	}
+
+	if err := sweep.WriteDryRunReport(); err != nil {
+		fmt.Fprintf(os.Stderr, "writing sweeper dry run report: %s\n", err)
+		os.Exit(1)
+	}
 }
@@ -41,6 +41,12 @@ type IgnoreConfig struct {
 	KeyPrefixes KeyValueTags
 }
 
+// DeleteProtectionConfig contains the resource tag that prevents the provider from deleting a resource.
+type DeleteProtectionConfig struct {
+	Key   string
+	Value *string
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
@@ -56,6 +62,22 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// Protects returns whether the specified tags mark a resource as protected from deletion.
+// If no tag value is configured, the presence of the tag key is sufficient.
+func (dpc *DeleteProtectionConfig) Protects(tags KeyValueTags) bool {
+	if dpc == nil || dpc.Key == "" || !tags.KeyExists(dpc.Key) {
+		return false
+	}
+
+	if dpc.Value == nil {
+		return true
+	}
+
+	v := tags.KeyValue(dpc.Key)
+
+	return v != nil && *v == *dpc.Value
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
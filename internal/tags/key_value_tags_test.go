@@ -317,6 +317,74 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDeleteProtectionConfigProtects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name   string
+		config *DeleteProtectionConfig
+		tags   KeyValueTags
+		want   bool
+	}{
+		{
+			name:   "nil config",
+			config: nil,
+			tags:   New(ctx, map[string]string{"tf-protected": "true"}),
+			want:   false,
+		},
+		{
+			name:   "empty key",
+			config: &DeleteProtectionConfig{},
+			tags:   New(ctx, map[string]string{"tf-protected": "true"}),
+			want:   false,
+		},
+		{
+			name:   "key only matching",
+			config: &DeleteProtectionConfig{Key: "tf-protected"},
+			tags:   New(ctx, map[string]string{"tf-protected": "anything"}),
+			want:   true,
+		},
+		{
+			name:   "key only non-matching",
+			config: &DeleteProtectionConfig{Key: "tf-protected"},
+			tags:   New(ctx, map[string]string{"key1": "value1"}),
+			want:   false,
+		},
+		{
+			name:   "key and value matching",
+			config: &DeleteProtectionConfig{Key: "tf-protected", Value: testStringPtr("true")},
+			tags:   New(ctx, map[string]string{"tf-protected": "true"}),
+			want:   true,
+		},
+		{
+			name:   "key and value non-matching value",
+			config: &DeleteProtectionConfig{Key: "tf-protected", Value: testStringPtr("true")},
+			tags:   New(ctx, map[string]string{"tf-protected": "false"}),
+			want:   false,
+		},
+		{
+			name:   "no tags",
+			config: &DeleteProtectionConfig{Key: "tf-protected", Value: testStringPtr("true")},
+			tags:   New(ctx, map[string]string{}),
+			want:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.Protects(testCase.tags)
+
+			if got != testCase.want {
+				t.Fatalf("expected: %t, got: %t", testCase.want, got)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -280,6 +280,7 @@ func RetryWhenNewResourceNotFound(ctx context.Context, timeout time.Duration, f
 
 type Options struct {
 	Delay                     time.Duration // Wait this time before starting checks
+	DelayJitter               time.Duration // Add a random duration up to this value to the delay
 	MinPollInterval           time.Duration // Smallest time to wait before refreshes (MinTimeout in retry.StateChangeConf)
 	PollInterval              time.Duration // Override MinPollInterval/backoff and only poll this often
 	NotFoundChecks            int           // Number of times to allow not found (nil result from Refresh)
@@ -291,6 +292,10 @@ func (o Options) Apply(c *retry.StateChangeConf) {
 		c.Delay = o.Delay
 	}
 
+	if ms := o.DelayJitter.Milliseconds(); ms > 0 {
+		c.Delay += time.Duration(rand.Int63n(ms)) * time.Millisecond
+	}
+
 	if o.MinPollInterval > 0 {
 		c.MinTimeout = o.MinPollInterval
 	}
@@ -323,6 +328,13 @@ func WithDelayRand(delayRand time.Duration) OptionsFunc {
 	}
 }
 
+// WithDelayJitter adds a value between 0s and the passed duration to the waiter's delay
+func WithDelayJitter(jitter time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.DelayJitter = jitter
+	}
+}
+
 func WithMinPollInterval(minPollInterval time.Duration) OptionsFunc {
 	return func(o *Options) {
 		o.MinPollInterval = minPollInterval
@@ -477,3 +477,46 @@ func TestOptionsApply(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionsApplyDelayJitter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		options  tfresource.Options
+		minDelay time.Duration
+		maxDelay time.Duration
+	}{
+		"DelayJitter": {
+			options: tfresource.Options{
+				DelayJitter: 10 * time.Second,
+			},
+			minDelay: 30 * time.Second,
+			maxDelay: 40 * time.Second,
+		},
+		"Delay and DelayJitter": {
+			options: tfresource.Options{
+				Delay:       1 * time.Minute,
+				DelayJitter: 10 * time.Second,
+			},
+			minDelay: 1 * time.Minute,
+			maxDelay: 1*time.Minute + 10*time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conf := retry.StateChangeConf{
+				Delay: 30 * time.Second,
+			}
+
+			testCase.options.Apply(&conf)
+
+			if a := conf.Delay; a < testCase.minDelay || a >= testCase.maxDelay {
+				t.Errorf("Delay: expected between %s and %s, got %s", testCase.minDelay, testCase.maxDelay, a)
+			}
+		})
+	}
+}
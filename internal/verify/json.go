@@ -155,6 +155,34 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 	return new, nil
 }
 
+// MinifyJSONString removes insignificant whitespace from a JSON string.
+// Unlike structure.NormalizeJsonString, key order is preserved and HTML characters
+// are not escaped, so the result is never longer than the input.
+func MinifyJSONString(s string) (string, error) {
+	if strings.TrimSpace(s) == "" {
+		return "", nil
+	}
+
+	var b bytes.Buffer
+	if err := json.Compact(&b, []byte(s)); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// NormalizePolicyString returns a policy document ready to be sent to AWS.
+// By default the document is normalized with structure.NormalizeJsonString.
+// If minify is true, only insignificant whitespace is removed (see MinifyJSONString),
+// keeping the document as small as possible for services that limit policy size.
+func NormalizePolicyString(policy string, minify bool) (string, error) {
+	if minify {
+		return MinifyJSONString(policy)
+	}
+
+	return structure.NormalizeJsonString(policy)
+}
+
 // PolicyToSet returns the existing policy if the new policy is equivalent.
 // Otherwise, it returns the new policy. Either policy is normalized.
 func PolicyToSet(exist, new string) (string, error) {
@@ -406,6 +406,112 @@ func TestNormalizeJSONOrYAMLString(t *testing.T) {
 	}
 }
 
+func TestMinifyJSONString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input     string
+		want      string
+		wantError bool
+	}{
+		"empty": {
+			input: "",
+			want:  "",
+		},
+		"whitespace only": {
+			input: "  \n",
+			want:  "",
+		},
+		"already minified": {
+			input: `{"b":1,"a":[1,2]}`,
+			want:  `{"b":1,"a":[1,2]}`,
+		},
+		"key order preserved": {
+			input: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [ "s3:GetObject" ]
+    }
+  ]
+}`,
+			want: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"]}]}`,
+		},
+		"whitespace in strings preserved": {
+			input: `{ "Sid" : "a b" }`,
+			want:  `{"Sid":"a b"}`,
+		},
+		"HTML characters not escaped": {
+			input: `{ "Condition" : "<&>" }`,
+			want:  `{"Condition":"<&>"}`,
+		},
+		"invalid": {
+			input:     `{"a":`,
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := MinifyJSONString(testCase.input)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("MinifyJSONString(%q) err %t, want %t", testCase.input, got, want)
+			}
+
+			if err == nil && got != testCase.want {
+				t.Errorf("MinifyJSONString(%q) = %q, want %q", testCase.input, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestNormalizePolicyString(t *testing.T) {
+	t.Parallel()
+
+	policy := `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Condition": { "StringLike": { "aws:Referer": "<&>" } }
+  }
+}`
+
+	testCases := map[string]struct {
+		minify bool
+		want   string
+	}{
+		"normalized": {
+			want: `{"Statement":{"Condition":{"StringLike":{"aws:Referer":"\u003c\u0026\u003e"}},"Effect":"Allow"},"Version":"2012-10-17"}`,
+		},
+		"minified": {
+			minify: true,
+			want:   `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Condition":{"StringLike":{"aws:Referer":"<&>"}}}}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizePolicyString(policy, testCase.minify)
+
+			if err != nil {
+				t.Fatalf("NormalizePolicyString(%t) err %s", testCase.minify, err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("NormalizePolicyString(%t) = %q, want %q", testCase.minify, got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestSuppressEquivalentJSONDiffsWhitespaceAndNoWhitespace(t *testing.T) {
 	t.Parallel()
 
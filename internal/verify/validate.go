@@ -402,6 +402,50 @@ func ValidStringIsJSONOrYAML(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// ValidStringIsJSONWithMinifiedLengthAtMost returns a SchemaValidateFunc which tests if the provided value
+// is valid JSON no longer than max bytes once insignificant whitespace is removed (see MinifyJSONString).
+// This catches documents, such as resource policies, that exceed a service-side size limit at plan time.
+func ValidStringIsJSONWithMinifiedLengthAtMost(max int) schema.SchemaValidateFunc {
+	return validStringIsJSONWithLengthAtMost(max, true)
+}
+
+// ValidStringIsJSONWithNormalizedLengthAtMost returns a SchemaValidateFunc which tests if the provided value
+// is valid JSON no longer than max bytes once normalized (see structure.NormalizeJsonString).
+// Use it for documents that are sent normalized rather than minified.
+func ValidStringIsJSONWithNormalizedLengthAtMost(max int) schema.SchemaValidateFunc {
+	return validStringIsJSONWithLengthAtMost(max, false)
+}
+
+func validStringIsJSONWithLengthAtMost(max int, minify bool) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if v == "" {
+			return
+		}
+
+		normalized, err := NormalizePolicyString(v, minify)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+			return
+		}
+
+		if n := len(normalized); n > max {
+			if minify {
+				errors = append(errors, fmt.Errorf("%q is %d bytes after removing whitespace, which exceeds the maximum of %d bytes", k, n, max))
+			} else {
+				errors = append(errors, fmt.Errorf("%q is %d bytes once normalized, which exceeds the maximum of %d bytes", k, n, max))
+			}
+		}
+
+		return
+	}
+}
+
 // ValidTypeStringNullableFloat provides custom error messaging for TypeString floats
 // Some arguments require a floating point value or an unspecified, empty field.
 func ValidTypeStringNullableFloat(v interface{}, k string) (ws []string, es []error) {
@@ -740,6 +740,93 @@ func TestFloatGreaterThan(t *testing.T) {
 	}
 }
 
+func TestValidStringIsJSONWithMinifiedLengthAtMost(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Value                  interface{}
+		ExpectValidationErrors bool
+	}{
+		"accept empty": {
+			Value: "",
+		},
+		"accept minified within limit": {
+			Value: `{"a":"b"}`,
+		},
+		"accept whitespace that fits once removed": {
+			Value: `{
+  "a"  :  "b"
+}`,
+		},
+		"accept characters escaped only when normalized": {
+			Value: `{"a":"<>"}`,
+		},
+		"reject too long": {
+			Value:                  `{"abc":"defghijklmnop"}`,
+			ExpectValidationErrors: true,
+		},
+		"reject invalid JSON": {
+			Value:                  `{"a":`,
+			ExpectValidationErrors: true,
+		},
+		"reject non-string": {
+			Value:                  1,
+			ExpectValidationErrors: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		_, errors := ValidStringIsJSONWithMinifiedLengthAtMost(12)(tc.Value, tn)
+		if len(errors) > 0 && !tc.ExpectValidationErrors {
+			t.Errorf("%s: unexpected errors %s", tn, errors)
+		} else if len(errors) == 0 && tc.ExpectValidationErrors {
+			t.Errorf("%s: expected errors but got none", tn)
+		}
+	}
+}
+
+func TestValidStringIsJSONWithNormalizedLengthAtMost(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Value                  interface{}
+		ExpectValidationErrors bool
+	}{
+		"accept empty": {
+			Value: "",
+		},
+		"accept within limit": {
+			Value: `{"a":"b"}`,
+		},
+		"accept whitespace that fits once normalized": {
+			Value: `{
+  "a"  :  "b"
+}`,
+		},
+		"reject too long once escaped": {
+			Value:                  `{"a":"<>"}`,
+			ExpectValidationErrors: true,
+		},
+		"reject invalid JSON": {
+			Value:                  `{"a":`,
+			ExpectValidationErrors: true,
+		},
+		"reject non-string": {
+			Value:                  1,
+			ExpectValidationErrors: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		_, errors := ValidStringIsJSONWithNormalizedLengthAtMost(12)(tc.Value, tn)
+		if len(errors) > 0 && !tc.ExpectValidationErrors {
+			t.Errorf("%s: unexpected errors %s", tn, errors)
+		} else if len(errors) == 0 && tc.ExpectValidationErrors {
+			t.Errorf("%s: expected errors but got none", tn)
+		}
+	}
+}
+
 func TestValidServicePrincipal(t *testing.T) {
 	t.Parallel()
 
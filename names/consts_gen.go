@@ -97,6 +97,7 @@ const (
 	ElasticBeanstalk             = "elasticbeanstalk"
 	ElasticTranscoder            = "elastictranscoder"
 	Elasticsearch                = "elasticsearch"
+	EntityResolution             = "entityresolution"
 	Events                       = "events"
 	Evidently                    = "evidently"
 	FIS                          = "fis"
@@ -104,6 +105,7 @@ const (
 	FSx                          = "fsx"
 	FinSpace                     = "finspace"
 	Firehose                     = "firehose"
+	FraudDetector                = "frauddetector"
 	GameLift                     = "gamelift"
 	Glacier                      = "glacier"
 	GlobalAccelerator            = "globalaccelerator"
@@ -338,6 +340,7 @@ const (
 	ElasticBeanstalkServiceID             = "Elastic Beanstalk"
 	ElasticTranscoderServiceID            = "Elastic Transcoder"
 	ElasticsearchServiceID                = "Elasticsearch Service"
+	EntityResolutionServiceID             = "EntityResolution"
 	EventsServiceID                       = "EventBridge"
 	EvidentlyServiceID                    = "Evidently"
 	FISServiceID                          = "fis"
@@ -345,6 +348,7 @@ const (
 	FSxServiceID                          = "FSx"
 	FinSpaceServiceID                     = "finspace"
 	FirehoseServiceID                     = "Firehose"
+	FraudDetectorServiceID                = "FraudDetector"
 	GameLiftServiceID                     = "GameLift"
 	GlacierServiceID                      = "Glacier"
 	GlobalAcceleratorServiceID            = "Global Accelerator"